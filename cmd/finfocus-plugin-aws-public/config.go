@@ -2,14 +2,189 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 )
 
+// envSlowLookupThresholdMs overrides the pricing lookup latency warning threshold.
+const envSlowLookupThresholdMs = "FINFOCUS_PRICING_SLOW_LOOKUP_MS"
+
+// envMetricsPort enables the Prometheus /metrics endpoint on the given port. Unset
+// leaves the plugin uninstrumented.
+const envMetricsPort = "FINFOCUS_PLUGIN_METRICS_PORT"
+
+// envPricingWarmUp builds the lazily initialized lookup indexes (the pricing data itself
+// is always parsed at startup) before serving instead of on the first request. Unset leaves them lazy.
+const envPricingWarmUp = "FINFOCUS_PRICING_WARMUP"
+
+// envLogFormatFallback is the generic log format variable, checked after the SDK's
+// FINFOCUS_LOG_FORMAT > PULUMICOST_LOG_FORMAT chain, mirroring LOG_LEVEL.
+const envLogFormatFallback = "LOG_FORMAT"
+
+// Log output formats. JSON is the default and what Core expects in production;
+// console is human-readable output for local development ("text" is accepted as an
+// alias, matching the SDK's documented values).
+const (
+	logFormatJSON    = "json"
+	logFormatConsole = "console"
+	logFormatText    = "text"
+)
+
+// resolveLogFormat returns the configured log format
+// (FINFOCUS_LOG_FORMAT > PULUMICOST_LOG_FORMAT > LOG_FORMAT), or "" when unset.
+func resolveLogFormat() string {
+	if format := pluginsdk.GetLogFormat(); format != "" {
+		return format
+	}
+	return os.Getenv(envLogFormatFallback)
+}
+
+// newLogger builds the plugin logger writing to w in the given format. Unset selects
+// JSON. ok is false for an unknown format, in which case the JSON logger is returned
+// so the caller can warn through it.
+func newLogger(format string, level zerolog.Level, w io.Writer) (logger zerolog.Logger, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", logFormatJSON:
+		return pluginsdk.NewPluginLogger("aws-public", version, level, w), true
+	case logFormatConsole, logFormatText:
+		console := zerolog.ConsoleWriter{Out: w, TimeFormat: time.RFC3339}
+		return pluginsdk.NewPluginLogger("aws-public", version, level, console), true
+	default:
+		return pluginsdk.NewPluginLogger("aws-public", version, level, w), false
+	}
+}
+
+// envLegacyPort is the generic PORT variable kept for backward compatibility with
+// earlier plugin versions and container platforms that inject it. It is deprecated
+// since v0.0.8; remove it from resolvePort in portRemovalVersion.
+const (
+	envLegacyPort         = "PORT"
+	portSourceEphemeral   = "ephemeral"
+	portDeprecatedVersion = "v0.0.8"
+	portRemovalVersion    = "v0.1.0"
+)
+
+// resolvePort determines the gRPC listen port from env, checking
+// FINFOCUS_PLUGIN_PORT > PULUMICOST_PLUGIN_PORT > PORT and falling back to an
+// ephemeral port (0). It returns the port and the variable it came from, or
+// "ephemeral". Invalid values are skipped, except that a set PORT is always
+// reported as the source (with port 0 if invalid) so its deprecation is surfaced.
+func resolvePort(env func(string) string) (int, string) {
+	for _, name := range []string{pluginsdk.EnvPort, pluginsdk.EnvPortFallback} {
+		if port, ok := parsePort(env(name)); ok {
+			return port, name
+		}
+	}
+
+	if val := env(envLegacyPort); val != "" {
+		port, _ := parsePort(val)
+		return port, envLegacyPort
+	}
+
+	return 0, portSourceEphemeral
+}
+
+// parsePort parses a TCP port, accepting only values in 1-65535.
+func parsePort(val string) (int, bool) {
+	port, err := strconv.Atoi(val)
+	if err != nil || port <= 0 || port > 65535 {
+		return 0, false
+	}
+	return port, true
+}
+
+// logPortResolution logs the port chosen by resolvePort, warning when it came from
+// a deprecated variable.
+func logPortResolution(logger zerolog.Logger, port int, source string) {
+	switch source {
+	case envLegacyPort:
+		logger.Warn().
+			Str("env_var", envLegacyPort).
+			Str("replacement", pluginsdk.EnvPort).
+			Str("deprecated_since", portDeprecatedVersion).
+			Str("removal_version", portRemovalVersion).
+			Msg("PORT environment variable is deprecated since v0.0.8 and will be removed in v0.1.0. Please use FINFOCUS_PLUGIN_PORT instead.")
+		if port == 0 {
+			logger.Warn().
+				Msg("PORT environment variable value is invalid or out of valid range (1-65535), ignoring")
+		}
+	case pluginsdk.EnvPortFallback:
+		logger.Warn().
+			Str("env_var", pluginsdk.EnvPortFallback).
+			Str("replacement", pluginsdk.EnvPort).
+			Msg("legacy port environment variable in use, please migrate to FINFOCUS_PLUGIN_PORT")
+	}
+
+	if port > 0 {
+		logger.Debug().Int("port", port).Str("source", source).Msg("using configured port")
+	} else {
+		logger.Debug().Str("source", source).Msg("using ephemeral port")
+	}
+}
+
+// parsePricingClientOptions reads pricing client tuning from the environment.
+// Invalid values are logged and the client defaults are kept.
+func parsePricingClientOptions(logger zerolog.Logger) pricing.ClientOptions {
+	var opts pricing.ClientOptions
+
+	if val := os.Getenv(envSlowLookupThresholdMs); val != "" {
+		if ms, err := strconv.Atoi(val); err == nil && ms > 0 {
+			opts.SlowLookupThreshold = time.Duration(ms) * time.Millisecond
+		} else {
+			logger.Warn().
+				Str("variable", envSlowLookupThresholdMs).
+				Str("value", val).
+				Dur("default", pricing.DefaultSlowLookupThreshold).
+				Msg("invalid slow lookup threshold, using default")
+		}
+	}
+
+	return opts
+}
+
+// parseMetricsPort returns the port for the Prometheus metrics endpoint, or 0 when
+// metrics are disabled. Invalid values are logged and disable metrics.
+func parseMetricsPort(logger zerolog.Logger) int {
+	val := os.Getenv(envMetricsPort)
+	if val == "" {
+		return 0
+	}
+	port, ok := parsePort(val)
+	if !ok {
+		logger.Warn().
+			Str("variable", envMetricsPort).
+			Str("value", val).
+			Msg("invalid metrics port, metrics endpoint disabled")
+		return 0
+	}
+	return port
+}
+
+// parseWarmUp reports whether startup warm-up is enabled. Invalid values are logged
+// and leave it disabled.
+func parseWarmUp(logger zerolog.Logger) bool {
+	val := os.Getenv(envPricingWarmUp)
+	if val == "" {
+		return false
+	}
+	enabled, err := strconv.ParseBool(val)
+	if err != nil {
+		logger.Warn().
+			Str("variable", envPricingWarmUp).
+			Str("value", val).
+			Msg("invalid pricing warm-up value, indexes stay lazy")
+		return false
+	}
+	return enabled
+}
+
 // parseWebConfig parses environment variables to configure the web server.
 // It returns a WebConfig struct and an error if the configuration is invalid.
 func parseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
@@ -1,9 +1,13 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -184,3 +188,193 @@ func TestParseWebConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePricingClientOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "unset keeps default", value: "", want: 0},
+		{name: "custom threshold", value: "250", want: 250 * time.Millisecond},
+		{name: "invalid value keeps default", value: "slow", want: 0},
+		{name: "zero keeps default", value: "0", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envSlowLookupThresholdMs, tt.value)
+			opts := parsePricingClientOptions(zerolog.Nop())
+			assert.Equal(t, tt.want, opts.SlowLookupThreshold)
+		})
+	}
+}
+
+func TestParseWarmUp(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  bool
+	}{
+		{name: "unset stays lazy", value: "", want: false},
+		{name: "enabled", value: "true", want: true},
+		{name: "numeric enabled", value: "1", want: true},
+		{name: "disabled", value: "false", want: false},
+		{name: "invalid stays lazy", value: "eager", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envPricingWarmUp, tt.value)
+			assert.Equal(t, tt.want, parseWarmUp(zerolog.Nop()))
+		})
+	}
+}
+
+// TestWarmUp verifies warm-up succeeds on the embedded data and logs the pricing
+// parse duration at info level.
+func TestWarmUp(t *testing.T) {
+	pricingClient, err := pricing.NewClient(zerolog.Nop())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, warmUp(zerolog.New(&buf).Level(zerolog.InfoLevel), pricingClient))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "lazy lookup indexes warmed", entry["message"])
+	assert.Contains(t, entry, "pricing_init_duration_ms")
+	assert.Contains(t, entry, "warmup_duration_ms")
+}
+
+func TestParseMetricsPort(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "unset disables metrics", value: "", want: 0},
+		{name: "valid port", value: "9101", want: 9101},
+		{name: "non-numeric disables metrics", value: "metrics", want: 0},
+		{name: "out of range disables metrics", value: "70000", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envMetricsPort, tt.value)
+			assert.Equal(t, tt.want, parseMetricsPort(zerolog.Nop()))
+		})
+	}
+}
+
+func TestResolvePort(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		wantPort   int
+		wantSource string
+	}{
+		{name: "nothing set", env: nil, wantPort: 0, wantSource: portSourceEphemeral},
+		{name: "canonical", env: map[string]string{pluginsdk.EnvPort: "9000"}, wantPort: 9000, wantSource: pluginsdk.EnvPort},
+		{name: "legacy sdk variable", env: map[string]string{pluginsdk.EnvPortFallback: "9001"}, wantPort: 9001, wantSource: pluginsdk.EnvPortFallback},
+		{name: "deprecated PORT", env: map[string]string{envLegacyPort: "9002"}, wantPort: 9002, wantSource: envLegacyPort},
+		{
+			name:       "canonical wins over PORT",
+			env:        map[string]string{pluginsdk.EnvPort: "9000", envLegacyPort: "9002"},
+			wantPort:   9000,
+			wantSource: pluginsdk.EnvPort,
+		},
+		{
+			name:       "invalid canonical falls through to PORT",
+			env:        map[string]string{pluginsdk.EnvPort: "abc", envLegacyPort: "9002"},
+			wantPort:   9002,
+			wantSource: envLegacyPort,
+		},
+		{name: "out of range PORT", env: map[string]string{envLegacyPort: "70000"}, wantPort: 0, wantSource: envLegacyPort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, source := resolvePort(func(key string) string { return tt.env[key] })
+			assert.Equal(t, tt.wantPort, port)
+			assert.Equal(t, tt.wantSource, source)
+		})
+	}
+}
+
+func TestLogPortResolution_DeprecationWarning(t *testing.T) {
+	tests := []struct {
+		name     string
+		port     int
+		source   string
+		wantWarn bool
+	}{
+		{name: "canonical", port: 9000, source: pluginsdk.EnvPort, wantWarn: false},
+		{name: "ephemeral", port: 0, source: portSourceEphemeral, wantWarn: false},
+		{name: "deprecated PORT", port: 9002, source: envLegacyPort, wantWarn: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logPortResolution(zerolog.New(&buf), tt.port, tt.source)
+			assert.Equal(t, tt.wantWarn, bytes.Contains(buf.Bytes(), []byte(portRemovalVersion)))
+		})
+	}
+}
+
+func TestResolveLogFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{name: "unset", env: nil, want: ""},
+		{name: "generic variable", env: map[string]string{envLogFormatFallback: "console"}, want: "console"},
+		{
+			name: "canonical wins over generic",
+			env:  map[string]string{pluginsdk.EnvLogFormat: "json", envLogFormatFallback: "console"},
+			want: "json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{pluginsdk.EnvLogFormat, pluginsdk.EnvLogFormatFallback, envLogFormatFallback} {
+				t.Setenv(key, tt.env[key])
+			}
+			assert.Equal(t, tt.want, resolveLogFormat())
+		})
+	}
+}
+
+func TestNewLogger(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		wantOK   bool
+		wantJSON bool
+	}{
+		{name: "unset defaults to JSON", format: "", wantOK: true, wantJSON: true},
+		{name: "json", format: "json", wantOK: true, wantJSON: true},
+		{name: "console", format: "console", wantOK: true, wantJSON: false},
+		{name: "text alias, case-insensitive", format: " TEXT ", wantOK: true, wantJSON: false},
+		{name: "unknown falls back to JSON", format: "yaml", wantOK: false, wantJSON: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger, ok := newLogger(tt.format, zerolog.InfoLevel, &buf)
+			assert.Equal(t, tt.wantOK, ok)
+
+			logger.Debug().Msg("suppressed")
+			logger.Info().Str("aws_region", "us-east-1").Msg("plugin started")
+			out := buf.String()
+			assert.NotContains(t, out, "suppressed", "level must apply in every format")
+			assert.Contains(t, out, "plugin started")
+			assert.Contains(t, out, "aws-public")
+			assert.Equal(t, tt.wantJSON, json.Valid(bytes.TrimSpace(buf.Bytes())), "output: %s", out)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// estimateCommand is the subcommand that prices one resource in-process and exits,
+// instead of serving gRPC.
+const estimateCommand = "estimate"
+
+// Output formats for the estimate subcommand.
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// tagFlags collects repeated --tag key=value flags.
+type tagFlags map[string]string
+
+func (t tagFlags) String() string {
+	pairs := make([]string, 0, len(t))
+	for k, v := range t {
+		pairs = append(pairs, k+"="+v)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (t tagFlags) Set(val string) error {
+	k, v, ok := strings.Cut(val, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("tag %q must be key=value", val)
+	}
+	t[k] = v
+	return nil
+}
+
+// runEstimate implements "finfocus-plugin-aws-public estimate": it parses args, prices
+// the described resource with the plugin's own GetProjectedCost, and writes the result
+// to stdout as a table (default) or as the GetProjectedCostResponse in JSON.
+// Usage errors and logs go to stderr.
+func runEstimate(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet(estimateCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	resourceType := fs.String("resource-type", "", "resource type, e.g. ec2 or aws:ec2/instance:Instance (required)")
+	sku := fs.String("sku", "", "SKU, e.g. t3.micro")
+	region := fs.String("region", "", "AWS region (defaults to --default-region, then the binary's region)")
+	defaultRegion := fs.String("default-region", "",
+		"region a fallback build answers for, overriding "+plugin.EnvDefaultRegion)
+	format := fs.String("format", formatTable, "output format: table or json")
+	tags := tagFlags{}
+	fs.Var(tags, "tag", "resource tag as key=value (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *resourceType == "" {
+		return errors.New("--resource-type is required")
+	}
+	if *format != formatTable && *format != formatJSON {
+		return fmt.Errorf("unknown --format %q (want %s or %s)", *format, formatTable, formatJSON)
+	}
+
+	awsPlugin, pricingClient, err := newCommandPlugin(stderr)
+	if err != nil {
+		return err
+	}
+	if *defaultRegion != "" {
+		if err := awsPlugin.SetDefaultRegion(*defaultRegion); err != nil {
+			return err
+		}
+	}
+	if *region == "" {
+		*region = awsPlugin.DefaultRegion()
+	}
+	if *region == "" {
+		*region = pricingClient.Region()
+	}
+	resource := &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: *resourceType,
+		Sku:          *sku,
+		Region:       *region,
+		Tags:         tags,
+	}
+	resp, err := awsPlugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: resource})
+	if err != nil {
+		return err
+	}
+
+	if *format == formatJSON {
+		return writeEstimateJSON(stdout, resp)
+	}
+	return writeEstimateTable(stdout, resource, resp)
+}
+
+// newCommandPlugin builds the plugin in-process for a one-shot subcommand, logging
+// only warnings and errors to stderr unless a log level is configured, so they do not
+// drown the output.
+func newCommandPlugin(stderr io.Writer) (*plugin.AWSPublicPlugin, pricing.PricingClient, error) {
+	level := zerolog.WarnLevel
+	if lvl := pluginsdk.GetLogLevel(); lvl != "" {
+		if parsed, err := zerolog.ParseLevel(lvl); err == nil {
+			level = parsed
+		}
+	}
+	logger := zerolog.New(stderr).Level(level).With().Timestamp().Logger()
+
+	pricingClient, err := pricing.NewClientWithOptions(logger, parsePricingClientOptions(logger))
+	if err != nil {
+		return nil, nil, fmt.Errorf("initialize pricing client: %w", err)
+	}
+	return plugin.NewAWSPublicPlugin(pricingClient.Region(), version, pricingClient, logger), pricingClient, nil
+}
+
+// writeEstimateJSON writes resp in protojson form, indented for readability.
+func writeEstimateJSON(w io.Writer, resp *pbc.GetProjectedCostResponse) error {
+	out, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// writeEstimateTable writes resp as aligned label/value rows, with one row per impact
+// metric (e.g. "Carbon footprint  3507.60 gCO2e/month").
+func writeEstimateTable(w io.Writer, resource *pbc.ResourceDescriptor, resp *pbc.GetProjectedCostResponse) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	name := resource.GetResourceType()
+	if resource.GetSku() != "" {
+		name += " " + resource.GetSku()
+	}
+	fmt.Fprintf(tw, "Resource\t%s (%s)\n", name, resource.GetRegion())
+	fmt.Fprintf(tw, "Cost per month\t%.2f %s\n", resp.GetCostPerMonth(), resp.GetCurrency())
+	fmt.Fprintf(tw, "Unit price\t%g %s\n", resp.GetUnitPrice(), resp.GetCurrency())
+	for _, m := range resp.GetImpactMetrics() {
+		fmt.Fprintf(tw, "%s\t%.2f %s/month\n", metricKindLabel(m.GetKind()), m.GetValue(), m.GetUnit())
+	}
+	fmt.Fprintf(tw, "Billing detail\t%s\n", resp.GetBillingDetail())
+
+	return tw.Flush()
+}
+
+// metricKindLabel turns METRIC_KIND_CARBON_FOOTPRINT into "Carbon footprint".
+func metricKindLabel(kind pbc.MetricKind) string {
+	label := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(kind.String(), "METRIC_KIND_"), "_", " "))
+	if label == "" {
+		return label
+	}
+	return strings.ToUpper(label[:1]) + label[1:]
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEstimate_Formats(t *testing.T) {
+	args := []string{"--resource-type", "ec2", "--sku", "t3.micro", "--tag", "count=2"}
+
+	var jsonOut, stderr bytes.Buffer
+	require.NoError(t, runEstimate(append(args, "--format", "json"), &jsonOut, &stderr))
+
+	var resp struct {
+		CostPerMonth  float64 `json:"cost_per_month"`
+		BillingDetail string  `json:"billing_detail"`
+		ImpactMetrics []struct {
+			Kind  string  `json:"kind"`
+			Value float64 `json:"value"`
+		} `json:"impact_metrics"`
+	}
+	require.NoError(t, json.Unmarshal(jsonOut.Bytes(), &resp), "output: %s", jsonOut.String())
+	assert.Greater(t, resp.CostPerMonth, 0.0)
+	require.Len(t, resp.ImpactMetrics, 1)
+	assert.Equal(t, "METRIC_KIND_CARBON_FOOTPRINT", resp.ImpactMetrics[0].Kind)
+
+	var tableOut bytes.Buffer
+	require.NoError(t, runEstimate(args, &tableOut, &stderr))
+	table := tableOut.String()
+	assert.Contains(t, table, "ec2 t3.micro")
+	assert.Contains(t, table, "Cost per month")
+	assert.Contains(t, table, "Carbon footprint")
+	assert.Contains(t, table, resp.BillingDetail)
+}
+
+func TestRunEstimate_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "missing resource type", args: []string{"--sku", "t3.micro"}, wantErr: "--resource-type is required"},
+		{name: "unknown format", args: []string{"--resource-type", "ec2", "--format", "yaml"}, wantErr: `unknown --format "yaml"`},
+		{name: "malformed tag", args: []string{"--resource-type", "ec2", "--tag", "count"}, wantErr: "must be key=value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			err := runEstimate(tt.args, &stdout, &stderr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error()+stderr.String(), tt.wantErr)
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
+
+func TestRunEstimate_DefaultRegion(t *testing.T) {
+	base := []string{"--resource-type", "ec2", "--sku", "t3.micro", "--format", "json"}
+
+	var refOut, stderr bytes.Buffer
+	require.NoError(t, runEstimate(base, &refOut, &stderr))
+	var ref struct {
+		CostPerMonth float64 `json:"cost_per_month"`
+	}
+	require.NoError(t, json.Unmarshal(refOut.Bytes(), &ref), "output: %s", refOut.String())
+
+	var out bytes.Buffer
+	require.NoError(t, runEstimate(append(base, "--default-region", "eu-west-1"), &out, &stderr))
+	var resp struct {
+		CostPerMonth  float64 `json:"cost_per_month"`
+		BillingDetail string  `json:"billing_detail"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &resp), "output: %s", out.String())
+	assert.InDelta(t, ref.CostPerMonth*1.11, resp.CostPerMonth, 1e-9)
+	assert.Contains(t, resp.BillingDetail, "regional uplift for eu-west-1")
+
+	t.Setenv("FINFOCUS_DEFAULT_REGION", "ap-southeast-1")
+	out.Reset()
+	require.NoError(t, runEstimate(base, &out, &stderr))
+	assert.Contains(t, out.String(), "regional uplift for ap-southeast-1")
+
+	err := runEstimate(append(base, "--default-region", "Europe"), &out, &stderr)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `invalid default region "Europe"`)
+}
+
+func TestWriteEstimateTable(t *testing.T) {
+	resource := &pbc.ResourceDescriptor{ResourceType: "ebs", Sku: "gp3", Region: "us-east-1"}
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  8,
+		UnitPrice:     0.08,
+		Currency:      "USD",
+		BillingDetail: "EBS gp3 storage, 100GB",
+		ImpactMetrics: []*pbc.ImpactMetric{
+			{Kind: pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT, Value: 123.456, Unit: "gCO2e"},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, writeEstimateTable(&out, resource, resp))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, []string{
+		"Resource          ebs gp3 (us-east-1)",
+		"Cost per month    8.00 USD",
+		"Unit price        0.08 USD",
+		"Carbon footprint  123.46 gCO2e/month",
+		"Billing detail    EBS gp3 storage, 100GB",
+	}, lines)
+}
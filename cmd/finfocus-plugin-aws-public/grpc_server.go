@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// envGRPCMaxMsgSizeMB overrides the maximum gRPC message size, in MiB, for both
+// received and sent messages. Large recommendation batches with rich tags can
+// exceed gRPC's 4 MiB default.
+const (
+	envGRPCMaxMsgSizeMB     = "FINFOCUS_GRPC_MAX_MSG_SIZE_MB"
+	defaultGRPCMaxMsgSizeMB = 16
+	maxGRPCMaxMsgSizeMB     = 256
+)
+
+// parseGRPCMaxMsgSize returns the maximum gRPC message size in bytes. Invalid values
+// are logged and the default is kept; values above maxGRPCMaxMsgSizeMB are capped.
+func parseGRPCMaxMsgSize(logger zerolog.Logger) int {
+	sizeMB := defaultGRPCMaxMsgSizeMB
+
+	if val := os.Getenv(envGRPCMaxMsgSizeMB); val != "" {
+		n, err := strconv.Atoi(val)
+		switch {
+		case err != nil || n <= 0:
+			logger.Warn().
+				Str("variable", envGRPCMaxMsgSizeMB).
+				Str("value", val).
+				Int("default_mb", defaultGRPCMaxMsgSizeMB).
+				Msg("invalid gRPC max message size, using default")
+		case n > maxGRPCMaxMsgSizeMB:
+			logger.Warn().
+				Str("variable", envGRPCMaxMsgSizeMB).
+				Int("requested", n).
+				Int("max_allowed", maxGRPCMaxMsgSizeMB).
+				Msg("requested gRPC max message size exceeds maximum, capping")
+			sizeMB = maxGRPCMaxMsgSizeMB
+		default:
+			sizeMB = n
+		}
+	}
+
+	return sizeMB << 20
+}
+
+// newGRPCServer builds the plugin's gRPC server with the given message size limit.
+// pluginsdk.ServeConfig has no gRPC server options, so this mirrors the SDK's
+// server setup (tracing interceptor followed by config.UnaryInterceptors, service
+// registration, reflection) with the raised MaxRecvMsgSize/MaxSendMsgSize.
+func newGRPCServer(config pluginsdk.ServeConfig, maxMsgSize int) *grpc.Server {
+	server := pluginsdk.NewServerWithOptions(config.Plugin, config.Registry, config.Logger, config.PluginInfo)
+
+	interceptors := make([]grpc.UnaryServerInterceptor, 0, 1+len(config.UnaryInterceptors))
+	interceptors = append(interceptors, pluginsdk.TracingUnaryServerInterceptor())
+	interceptors = append(interceptors, config.UnaryInterceptors...)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.MaxRecvMsgSize(maxMsgSize),
+		grpc.MaxSendMsgSize(maxMsgSize),
+	)
+	pbc.RegisterCostSourceServiceServer(grpcServer, server)
+	reflection.Register(grpcServer)
+	return grpcServer
+}
+
+// serveGRPC serves the plugin over gRPC with the given message size limit, matching
+// pluginsdk.Serve in plain gRPC mode: it serves on config.Listener when set, otherwise
+// on config.Port on the loopback interface, announces the port as PORT=<port> on
+// stdout, and when ctx is cancelled stops gracefully, returning ctx.Err() if serving
+// failed during shutdown and nil otherwise.
+func serveGRPC(ctx context.Context, config pluginsdk.ServeConfig, maxMsgSize int) error {
+	if config.PluginInfo != nil {
+		if err := config.PluginInfo.Validate(); err != nil {
+			return fmt.Errorf("invalid PluginInfo in ServeConfig: %w", err)
+		}
+	}
+
+	listener := config.Listener
+	if listener == nil {
+		var err error
+		listener, err = (&net.ListenConfig{}).Listen(ctx, "tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(config.Port)))
+		if err != nil {
+			return fmt.Errorf("failed to listen: %w", err)
+		}
+	}
+	tcpAddr, ok := listener.Addr().(*net.TCPAddr)
+	if !ok {
+		return errors.New("provided listener address is not TCP")
+	}
+	if _, err := fmt.Fprintf(os.Stdout, "PORT=%d\n", tcpAddr.Port); err != nil {
+		_ = listener.Close()
+		return fmt.Errorf("writing port: %w", err)
+	}
+
+	grpcServer := newGRPCServer(config, maxMsgSize)
+
+	serverDone := make(chan struct{})
+	shutdownComplete := make(chan struct{})
+	go func() {
+		defer close(shutdownComplete)
+		select {
+		case <-ctx.Done():
+			grpcServer.GracefulStop()
+		case <-serverDone:
+		}
+	}()
+
+	err := grpcServer.Serve(listener)
+	close(serverDone)
+	<-shutdownComplete
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// warnUnappliedMaxMsgSize warns when envGRPCMaxMsgSizeMB is set but the server runs in
+// web mode, where pluginsdk.Serve builds the server and the limit cannot be applied.
+func warnUnappliedMaxMsgSize(logger zerolog.Logger) {
+	if val := os.Getenv(envGRPCMaxMsgSizeMB); val != "" {
+		logger.Warn().
+			Str("variable", envGRPCMaxMsgSizeMB).
+			Str("value", val).
+			Msg("gRPC max message size is not applied in web mode; unset FINFOCUS_PLUGIN_WEB_ENABLED to use it")
+	}
+}
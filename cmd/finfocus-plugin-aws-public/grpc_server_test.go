@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestParseGRPCMaxMsgSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		wantMB   int
+		wantWarn string
+	}{
+		{name: "unset uses default", wantMB: defaultGRPCMaxMsgSizeMB},
+		{name: "valid", value: "64", wantMB: 64},
+		{name: "capped", value: "1024", wantMB: maxGRPCMaxMsgSizeMB, wantWarn: "exceeds maximum, capping"},
+		{name: "zero", value: "0", wantMB: defaultGRPCMaxMsgSizeMB, wantWarn: "invalid gRPC max message size"},
+		{name: "malformed", value: "big", wantMB: defaultGRPCMaxMsgSizeMB, wantWarn: "invalid gRPC max message size"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(envGRPCMaxMsgSizeMB, tt.value)
+			var logBuf bytes.Buffer
+
+			got := parseGRPCMaxMsgSize(zerolog.New(&logBuf))
+
+			assert.Equal(t, tt.wantMB<<20, got)
+			if tt.wantWarn != "" {
+				assert.Contains(t, logBuf.String(), tt.wantWarn)
+			} else {
+				assert.Empty(t, logBuf.String())
+			}
+		})
+	}
+}
+
+// echoPlugin is a minimal pluginsdk.Plugin that reports the number of tag bytes received.
+type echoPlugin struct{}
+
+func (echoPlugin) Name() string { return "echo" }
+
+func (echoPlugin) GetProjectedCost(_ context.Context, req *pbc.GetProjectedCostRequest) (*pbc.GetProjectedCostResponse, error) {
+	var size int
+	for k, v := range req.GetResource().GetTags() {
+		size += len(k) + len(v)
+	}
+	return &pbc.GetProjectedCostResponse{Currency: "USD", CostPerMonth: float64(size)}, nil
+}
+
+func (echoPlugin) GetActualCost(context.Context, *pbc.GetActualCostRequest) (*pbc.GetActualCostResponse, error) {
+	return &pbc.GetActualCostResponse{}, nil
+}
+
+func (echoPlugin) GetPricingSpec(context.Context, *pbc.GetPricingSpecRequest) (*pbc.GetPricingSpecResponse, error) {
+	return &pbc.GetPricingSpecResponse{}, nil
+}
+
+func (echoPlugin) EstimateCost(context.Context, *pbc.EstimateCostRequest) (*pbc.EstimateCostResponse, error) {
+	return &pbc.EstimateCostResponse{}, nil
+}
+
+// TestNewGRPCServer_MessageSizeLimit verifies requests above gRPC's 4 MiB default are
+// accepted by a server built with a raised limit and rejected at the default.
+func TestNewGRPCServer_MessageSizeLimit(t *testing.T) {
+	const payloadSize = 6 << 20
+	payload := strings.Repeat("x", payloadSize)
+
+	tests := []struct {
+		name       string
+		maxMsgSize int
+		wantCode   codes.Code
+	}{
+		{name: "raised limit accepts large request", maxMsgSize: defaultGRPCMaxMsgSizeMB << 20, wantCode: codes.OK},
+		{name: "grpc default rejects large request", maxMsgSize: 4 << 20, wantCode: codes.ResourceExhausted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listener := bufconn.Listen(1 << 20)
+			server := newGRPCServer(pluginsdk.ServeConfig{Plugin: echoPlugin{}}, tt.maxMsgSize)
+			go func() { _ = server.Serve(listener) }()
+			defer server.Stop()
+
+			conn, err := grpc.NewClient("passthrough:///bufnet",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+					return listener.DialContext(ctx)
+				}),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(32<<20), grpc.MaxCallRecvMsgSize(32<<20)),
+			)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			resp, err := pbc.NewCostSourceServiceClient(conn).GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+					Tags:         map[string]string{"k": payload},
+				},
+			})
+
+			require.Equal(t, tt.wantCode, status.Code(err), "GetProjectedCost() error = %v", err)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, float64(payloadSize+1), resp.GetCostPerMonth())
+			}
+		})
+	}
+}
+
+// TestNewGRPCServer_UnaryInterceptors verifies configured interceptors run after the
+// SDK's tracing interceptor, as with pluginsdk.Serve.
+func TestNewGRPCServer_UnaryInterceptors(t *testing.T) {
+	var called bool
+	interceptor := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		called = true
+		return handler(ctx, req)
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := newGRPCServer(pluginsdk.ServeConfig{
+		Plugin:            echoPlugin{},
+		UnaryInterceptors: []grpc.UnaryServerInterceptor{interceptor},
+	}, defaultGRPCMaxMsgSizeMB<<20)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = pbc.NewCostSourceServiceClient(conn).GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "t3.micro", Region: "us-east-1"},
+	})
+	require.NoError(t, err)
+	assert.True(t, called, "configured unary interceptor was not called")
+}
+
+// TestServeGRPC_ConfiguredListener verifies a configured listener is served instead of
+// a new loopback one, and cancellation stops the server without an error.
+func TestServeGRPC_ConfiguredListener(t *testing.T) {
+	listener, err := (&net.ListenConfig{}).Listen(context.Background(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serveGRPC(ctx, pluginsdk.ServeConfig{Plugin: echoPlugin{}, Listener: listener}, defaultGRPCMaxMsgSizeMB<<20)
+	}()
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = pbc.NewCostSourceServiceClient(conn).GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "t3.micro", Region: "us-east-1"},
+	})
+	require.NoError(t, err)
+
+	cancel()
+	assert.NoError(t, <-errCh)
+}
+
+func TestWarnUnappliedMaxMsgSize(t *testing.T) {
+	for _, value := range []string{"", "64"} {
+		t.Run("value="+value, func(t *testing.T) {
+			t.Setenv(envGRPCMaxMsgSizeMB, value)
+			var logBuf bytes.Buffer
+
+			warnUnappliedMaxMsgSize(zerolog.New(&logBuf))
+
+			if value == "" {
+				assert.Empty(t, logBuf.String())
+			} else {
+				assert.Contains(t, logBuf.String(), "not applied in web mode")
+			}
+		})
+	}
+}
@@ -2,13 +2,19 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/signal"
-	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
@@ -19,7 +25,31 @@ var version = "0.0.3"
 
 // main is the entry point that delegates to run() and handles exit codes.
 // This pattern ensures all defer statements execute properly before process exit.
+// "estimate" as the first argument prices one resource and exits instead of serving;
+// "selftest" prices a representative resource per service and exits non-zero on gaps;
+// "stack" prices every resource of a Pulumi stack export.
 func main() {
+	if len(os.Args) > 1 {
+		var command func([]string, io.Writer, io.Writer) error
+		switch os.Args[1] {
+		case estimateCommand:
+			command = runEstimate
+		case selftestCommand:
+			command = runSelftest
+		case stackCommand:
+			command = func(args []string, stdout, stderr io.Writer) error {
+				return runStack(args, os.Stdin, stdout, stderr)
+			}
+		}
+		if command != nil {
+			if err := command(os.Args[2:], os.Stdout, os.Stderr); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[1], err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	if err := run(); err != nil {
 		os.Exit(1)
 	}
@@ -28,7 +58,7 @@ func main() {
 // run contains the main application logic, returning an error on failure.
 // This function configures logging, initializes the pricing client and plugin instance,
 // and runs the plugin server until a shutdown signal is received.
-// It reads LOG_LEVEL and PORT from the environment, validates test-mode configuration,
+// It reads LOG_LEVEL, LOG_FORMAT and PORT from the environment, validates test-mode configuration,
 // logs the AWS region returned by the pricing client, and performs a graceful shutdown on
 // os.Interrupt or syscall.SIGTERM.
 func run() error {
@@ -40,14 +70,22 @@ func run() error {
 		}
 	}
 
-	// Create logger using SDK utility (outputs JSON to stderr)
-	logger := pluginsdk.NewPluginLogger("aws-public", version, level, nil)
+	// Create logger using SDK utility: JSON to stderr, or console output when
+	// FINFOCUS_LOG_FORMAT > PULUMICOST_LOG_FORMAT > LOG_FORMAT is "console"
+	format := resolveLogFormat()
+	logger, formatOK := newLogger(format, level, os.Stderr)
+	if !formatOK {
+		logger.Warn().
+			Str("value", format).
+			Str("default", logFormatJSON).
+			Msg("invalid log format, using default")
+	}
 
 	// Validate test mode env var at startup (logs warning for invalid values)
 	plugin.ValidateTestModeEnv(logger)
 
 	// Initialize pricing client
-	pricingClient, err := pricing.NewClient(logger)
+	pricingClient, err := pricing.NewClientWithOptions(logger, parsePricingClientOptions(logger))
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to initialize pricing client")
 		return err
@@ -59,42 +97,33 @@ func run() error {
 		Str("aws_region", region).
 		Msg("plugin started")
 
-	// Determine port with SDK fallback (FINFOCUS_PLUGIN_PORT > PORT > ephemeral)
-	// Note: pluginsdk.GetPort() only checks FINFOCUS_PLUGIN_PORT. We maintain
-	// backward compatibility with the generic PORT env var for earlier plugin
-	// versions and common deployment patterns (e.g., container platforms that
-	// inject PORT). This is intentional and should not be removed.
-	port := pluginsdk.GetPort()
-	if port == 0 {
-		// Backward compatibility: check generic PORT env var
-		if portStr := os.Getenv("PORT"); portStr != "" {
-			logger.Warn().
-				Str("env_var", "PORT").
-				Str("replacement", "FINFOCUS_PLUGIN_PORT").
-				Str("deprecated_since", "v0.0.8").
-				Str("removal_version", "v0.1.0").
-				Msg("PORT environment variable is deprecated since v0.0.8 and will be removed in v0.1.0. Please use FINFOCUS_PLUGIN_PORT instead.")
-			parsed, err := strconv.Atoi(portStr)
-			if err == nil && parsed > 0 && parsed <= 65535 {
-				port = parsed
-			} else if err == nil {
-				logger.Warn().
-					Int("port", parsed).
-					Msg("PORT environment variable value is out of valid range (1-65535), ignoring")
-			}
+	// Determine port (FINFOCUS_PLUGIN_PORT > PULUMICOST_PLUGIN_PORT > PORT > ephemeral).
+	// The deprecated PORT fallback is slated for removal in v0.1.0; see resolvePort.
+	port, portSource := resolvePort(os.Getenv)
+	logPortResolution(logger, port, portSource)
+
+	// Create plugin instance with logger
+	awsPlugin := plugin.NewAWSPublicPlugin(region, version, pricingClient, logger)
+
+	// Build lazily initialized indexes now when FINFOCUS_PRICING_WARMUP is set
+	if parseWarmUp(logger) {
+		if err := warmUp(logger, pricingClient); err != nil {
+			logger.Error().Err(err).Msg("failed to warm up pricing indexes")
+			return err
 		}
 	}
 
-	// Log port source for troubleshooting
-	if port > 0 {
-		logger.Debug().Int("port", port).Msg("using configured port")
-	} else {
-		logger.Debug().Msg("using ephemeral port")
+	// Serve Prometheus metrics on a separate port when FINFOCUS_PLUGIN_METRICS_PORT is set
+	if metricsPort := parseMetricsPort(logger); metricsPort > 0 {
+		metricsServer, err := startMetricsServer(awsPlugin, metricsPort)
+		if err != nil {
+			logger.Error().Err(err).Int("port", metricsPort).Msg("failed to start metrics server")
+			return err
+		}
+		defer func() { _ = metricsServer.Close() }()
+		logger.Info().Int("port", metricsPort).Msg("metrics endpoint enabled")
 	}
 
-	// Create plugin instance with logger
-	awsPlugin := plugin.NewAWSPublicPlugin(region, version, pricingClient, logger)
-
 	// Setup context for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -136,11 +165,57 @@ func run() error {
 	if webConfig.Enabled {
 		config.Web = webConfig
 		logger.Info().Msg("web serving enabled with multi-protocol support")
+		warnUnappliedMaxMsgSize(logger)
+		if err := pluginsdk.Serve(ctx, config); err != nil {
+			logger.Error().Err(err).Msg("server error")
+			return err
+		}
+		return nil
 	}
-	if err := pluginsdk.Serve(ctx, config); err != nil {
+
+	// Plain gRPC is served directly so large batches can use a raised message size limit
+	maxMsgSize := parseGRPCMaxMsgSize(logger)
+	logger.Debug().Int("max_msg_size_bytes", maxMsgSize).Msg("gRPC message size limit configured")
+	if err := serveGRPC(ctx, config, maxMsgSize); err != nil {
 		logger.Error().Err(err).Msg("server error")
 		return err
 	}
 
 	return nil
 }
+
+// startMetricsServer instruments awsPlugin and serves its metrics, along with Go
+// runtime and process metrics, at /metrics on port.
+func startMetricsServer(awsPlugin *plugin.AWSPublicPlugin, port int) (*http.Server, error) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	awsPlugin.SetMetrics(plugin.NewMetrics(registry))
+
+	return pluginsdk.StartMetricsServer(pluginsdk.MetricsServerConfig{
+		Port:     port,
+		Registry: registry,
+	})
+}
+
+// warmUp builds the lazily initialized pricing and carbon lookup indexes (the pricing
+// data itself is parsed when the client is created), so the first request after a cold
+// start is not slowed by them, and logs how long the earlier parse and the warm-up took.
+func warmUp(logger zerolog.Logger, pricingClient *pricing.Client) error {
+	start := time.Now()
+	initDuration, err := pricingClient.WarmUp()
+	if err != nil {
+		return err
+	}
+	carbon.InstanceSpecCount()
+	carbon.GPUSpecCount()
+	carbon.StorageSpecCount()
+
+	logger.Info().
+		Dur("pricing_init_duration_ms", initDuration).
+		Dur("warmup_duration_ms", time.Since(start)).
+		Msg("lazy lookup indexes warmed")
+	return nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"text/tabwriter"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// selftestCommand is the subcommand that prices a representative resource for every
+// estimator against the embedded region's data and reports a pass/fail coverage matrix.
+// A service whose pricing failed to parse only logs warnings at startup; selftest
+// turns that into a non-zero exit for CI.
+const selftestCommand = "selftest"
+
+// selftestMaxMonthlyCost is the highest monthly cost considered plausible for the
+// small representative resources; anything above points at a mis-parsed rate.
+const selftestMaxMonthlyCost = 10000.0
+
+// selftestCase is the representative resource priced for one service.
+type selftestCase struct {
+	Service      string
+	ResourceType string
+	Sku          string
+	Tags         map[string]string
+}
+
+// selftestCases covers every priced estimator, in GetProjectedCost routing order.
+var selftestCases = []selftestCase{
+	{Service: "ec2", ResourceType: "ec2", Sku: "t3.micro"},
+	{Service: "ebs", ResourceType: "ebs", Sku: "gp3", Tags: map[string]string{"size": "100"}},
+	{Service: "rds", ResourceType: "rds", Sku: "db.t3.micro", Tags: map[string]string{"engine": "mysql"}},
+	{Service: "eks", ResourceType: "eks", Sku: "cluster"},
+	{Service: "s3", ResourceType: "s3", Sku: "STANDARD", Tags: map[string]string{"size": "100"}},
+	{Service: "lambda", ResourceType: "lambda", Sku: "512", Tags: map[string]string{"requests_per_month": "1000000"}},
+	{Service: "dynamodb", ResourceType: "dynamodb", Sku: "on-demand", Tags: map[string]string{"storage_gb": "10"}},
+	{Service: "elb", ResourceType: "elb", Sku: "alb", Tags: map[string]string{"lcu_per_hour": "1"}},
+	{Service: "natgw", ResourceType: "natgw", Sku: "nat_gateway", Tags: map[string]string{"data_processed_gb": "100"}},
+	{Service: "cloudwatch", ResourceType: "cloudwatch", Sku: "logs", Tags: map[string]string{"log_ingestion_gb": "10"}},
+	{Service: "elasticache", ResourceType: "elasticache", Sku: "cache.t3.micro"},
+	{Service: "ecr", ResourceType: "ecr", Sku: "repository", Tags: map[string]string{"storage_gb": "10"}},
+	{Service: "secretsmanager", ResourceType: "secretsmanager", Sku: "secret"},
+	{Service: "kms", ResourceType: "kms", Sku: "key"},
+	{Service: "waf", ResourceType: "waf", Sku: "webacl"},
+	{Service: "athena", ResourceType: "athena", Sku: "workgroup", Tags: map[string]string{"data_scanned_tb": "1"}},
+	{Service: "glue", ResourceType: "glue", Sku: "standard", Tags: map[string]string{"dpu_hours": "10"}},
+	{Service: "ses", ResourceType: "ses", Sku: "email", Tags: map[string]string{"emails_per_month": "100000"}},
+	{Service: "stepfunctions", ResourceType: "stepfunctions", Sku: "stateMachine", Tags: map[string]string{"state_transitions_per_month": "1000000"}},
+}
+
+// selftestResult is one row of the coverage matrix.
+type selftestResult struct {
+	Service       string  `json:"service"`
+	Resource      string  `json:"resource"`
+	Pass          bool    `json:"pass"`
+	CostPerMonth  float64 `json:"cost_per_month"`
+	UnitPrice     float64 `json:"unit_price"`
+	BillingDetail string  `json:"billing_detail"`
+}
+
+// runSelftest implements "finfocus-plugin-aws-public selftest": it prices each
+// selftestCases resource in the binary's region with the plugin's own
+// GetProjectedCost and writes the coverage matrix to stdout as a table (default) or
+// JSON. It returns an error naming the count of failed services, so the process exits
+// non-zero when any estimator returns no plausible cost.
+func runSelftest(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet(selftestCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", formatTable, "output format: table or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *format != formatTable && *format != formatJSON {
+		return fmt.Errorf("unknown --format %q (want %s or %s)", *format, formatTable, formatJSON)
+	}
+
+	awsPlugin, pricingClient, err := newCommandPlugin(stderr)
+	if err != nil {
+		return err
+	}
+	region := pricingClient.Region()
+
+	results := make([]selftestResult, 0, len(selftestCases))
+	failed := 0
+	for _, tc := range selftestCases {
+		result := selftestResult{Service: tc.Service, Resource: tc.ResourceType}
+		if tc.Sku != "" {
+			result.Resource += " " + tc.Sku
+		}
+		resp, err := awsPlugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: tc.ResourceType,
+				Sku:          tc.Sku,
+				Region:       region,
+				Tags:         tc.Tags,
+			},
+		})
+		if err != nil {
+			result.BillingDetail = err.Error()
+		} else {
+			result.CostPerMonth = resp.GetCostPerMonth()
+			result.UnitPrice = resp.GetUnitPrice()
+			result.BillingDetail = resp.GetBillingDetail()
+			result.Pass = plausibleMonthlyCost(result.CostPerMonth)
+		}
+		if !result.Pass {
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	if *format == formatJSON {
+		err = writeSelftestJSON(stdout, region, results)
+	} else {
+		err = writeSelftestTable(stdout, region, results, failed)
+	}
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d services returned no plausible cost in %s", failed, len(results), region)
+	}
+	return nil
+}
+
+// plausibleMonthlyCost reports whether cost is a finite, non-zero amount no higher
+// than selftestMaxMonthlyCost.
+func plausibleMonthlyCost(cost float64) bool {
+	return cost > 0 && cost <= selftestMaxMonthlyCost && !math.IsNaN(cost)
+}
+
+// writeSelftestJSON writes the region and results as an indented JSON object.
+func writeSelftestJSON(w io.Writer, region string, results []selftestResult) error {
+	out, err := json.MarshalIndent(struct {
+		Region   string           `json:"region"`
+		Services []selftestResult `json:"services"`
+	}{region, results}, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// writeSelftestTable writes one aligned row per service followed by a summary line.
+func writeSelftestTable(w io.Writer, region string, results []selftestResult, failed int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SERVICE\tRESOURCE\tRESULT\tCOST/MONTH\tUNIT PRICE\tDETAIL")
+	for _, r := range results {
+		status := "PASS"
+		if !r.Pass {
+			status = "FAIL"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%g\t%s\n",
+			r.Service, r.Resource, status, r.CostPerMonth, r.UnitPrice, r.BillingDetail)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "\n%d/%d services priced in %s\n", len(results)-failed, len(results), region)
+	return err
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunSelftest_JSON(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	err := runSelftest([]string{"--format", "json"}, &stdout, &stderr)
+
+	var out struct {
+		Region   string           `json:"region"`
+		Services []selftestResult `json:"services"`
+	}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &out), "output: %s", stdout.String())
+	require.Len(t, out.Services, len(selftestCases))
+
+	failed := 0
+	for i, r := range out.Services {
+		assert.Equal(t, selftestCases[i].Service, r.Service)
+		assert.Equal(t, plausibleMonthlyCost(r.CostPerMonth), r.Pass, "%s: %+v", r.Service, r)
+		if !r.Pass {
+			failed++
+		}
+	}
+	// The EC2 test fixture is embedded in every build.
+	assert.True(t, out.Services[0].Pass, "ec2: %+v", out.Services[0])
+
+	if failed == 0 {
+		assert.NoError(t, err)
+	} else {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), fmt.Sprintf("%d of %d services", failed, len(selftestCases)))
+	}
+}
+
+func TestRunSelftest_Table(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	_ = runSelftest(nil, &stdout, &stderr)
+
+	table := stdout.String()
+	assert.Contains(t, table, "SERVICE")
+	assert.Contains(t, table, "ec2 t3.micro")
+	for _, tc := range selftestCases {
+		assert.Contains(t, table, "\n"+tc.Service+" ", "missing row for %s", tc.Service)
+	}
+	assert.Contains(t, table, "services priced in")
+}
+
+func TestRunSelftest_UnknownFormat(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	err := runSelftest([]string{"--format", "yaml"}, &stdout, &stderr)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown --format "yaml"`)
+	assert.Empty(t, stdout.String())
+}
+
+func TestPlausibleMonthlyCost(t *testing.T) {
+	assert.True(t, plausibleMonthlyCost(7.59))
+	assert.False(t, plausibleMonthlyCost(0))
+	assert.False(t, plausibleMonthlyCost(-1))
+	assert.False(t, plausibleMonthlyCost(selftestMaxMonthlyCost+1))
+	assert.False(t, plausibleMonthlyCost(math.NaN()))
+	assert.False(t, plausibleMonthlyCost(math.Inf(1)))
+}
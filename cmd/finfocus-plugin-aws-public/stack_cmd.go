@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// stackCommand is the subcommand that prices every AWS resource of a Pulumi stack
+// export (`pulumi stack export`) in-process and prints per-resource and total cost and
+// carbon.
+const stackCommand = "stack"
+
+// stackResult is one resource row of a stack estimate.
+type stackResult struct {
+	URN           string  `json:"urn"`
+	Type          string  `json:"type"`
+	Sku           string  `json:"sku,omitempty"`
+	Region        string  `json:"region,omitempty"`
+	CostPerMonth  float64 `json:"cost_per_month"`
+	CarbonGrams   float64 `json:"carbon_gco2e_per_month"`
+	BillingDetail string  `json:"billing_detail,omitempty"`
+	Skipped       string  `json:"skipped,omitempty"`
+	Error         string  `json:"error,omitempty"`
+}
+
+// stackEstimate is the JSON output of the stack subcommand.
+type stackEstimate struct {
+	Resources         []stackResult `json:"resources"`
+	TotalCostPerMonth float64       `json:"total_cost_per_month"`
+	TotalCarbonGrams  float64       `json:"total_carbon_gco2e_per_month"`
+	Currency          string        `json:"currency"`
+}
+
+// runStack implements "finfocus-plugin-aws-public stack <export.json|->": it maps each
+// resource of the stack export to a ResourceDescriptor with plugin.ParseStackExport,
+// prices it with the plugin's own GetProjectedCost, and writes the rows and totals to
+// stdout as a table (default) or JSON. Resources that cannot be mapped or priced are
+// listed with the reason and excluded from the totals.
+func runStack(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet(stackCommand, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	region := fs.String("region", "", "region for resources whose provider sets none (defaults to --default-region, then the binary's region)")
+	defaultRegion := fs.String("default-region", "",
+		"region a fallback build answers for, overriding "+plugin.EnvDefaultRegion)
+	format := fs.String("format", formatTable, "output format: table or json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("expected one stack export file (or - for stdin)")
+	}
+	if *format != formatTable && *format != formatJSON {
+		return fmt.Errorf("unknown --format %q (want %s or %s)", *format, formatTable, formatJSON)
+	}
+
+	var data []byte
+	var err error
+	if path := fs.Arg(0); path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("read stack export: %w", err)
+	}
+
+	awsPlugin, pricingClient, err := newCommandPlugin(stderr)
+	if err != nil {
+		return err
+	}
+	if *defaultRegion != "" {
+		if err := awsPlugin.SetDefaultRegion(*defaultRegion); err != nil {
+			return err
+		}
+	}
+	if *region == "" {
+		*region = awsPlugin.DefaultRegion()
+	}
+	if *region == "" {
+		*region = pricingClient.Region()
+	}
+
+	resources, err := plugin.ParseStackExport(data, *region)
+	if err != nil {
+		return err
+	}
+
+	estimate := stackEstimate{Resources: make([]stackResult, 0, len(resources)), Currency: pricingClient.Currency()}
+	for _, res := range resources {
+		result := stackResult{URN: res.URN, Type: res.Type, Skipped: res.Skipped}
+		if res.Descriptor != nil {
+			result.Sku = res.Descriptor.GetSku()
+			result.Region = res.Descriptor.GetRegion()
+			resp, err := awsPlugin.GetProjectedCost(context.Background(),
+				&pbc.GetProjectedCostRequest{Resource: res.Descriptor})
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.CostPerMonth = resp.GetCostPerMonth()
+				result.BillingDetail = resp.GetBillingDetail()
+				for _, m := range resp.GetImpactMetrics() {
+					if m.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+						result.CarbonGrams += m.GetValue()
+					}
+				}
+				estimate.TotalCostPerMonth += result.CostPerMonth
+				estimate.TotalCarbonGrams += result.CarbonGrams
+			}
+		}
+		estimate.Resources = append(estimate.Resources, result)
+	}
+
+	if *format == formatJSON {
+		out, err := json.MarshalIndent(estimate, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(stdout, string(out))
+		return err
+	}
+	return writeStackTable(stdout, estimate)
+}
+
+// writeStackTable writes one aligned row per resource followed by the totals. Skipped
+// and failed resources show the reason in place of the billing detail.
+func writeStackTable(w io.Writer, estimate stackEstimate) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RESOURCE\tTYPE\tSKU\tCOST/MONTH\tCARBON (gCO2e/month)\tDETAIL")
+	for _, r := range estimate.Resources {
+		detail := r.BillingDetail
+		switch {
+		case r.Skipped != "":
+			detail = "skipped: " + r.Skipped
+		case r.Error != "":
+			detail = "error: " + r.Error
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.2f\t%.2f\t%s\n",
+			plugin.StackResource{URN: r.URN}.Name(), r.Type, r.Sku, r.CostPerMonth, r.CarbonGrams, detail)
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t\t%.2f\t%.2f\t%d resources\n",
+		estimate.TotalCostPerMonth, estimate.TotalCarbonGrams, len(estimate.Resources))
+	return tw.Flush()
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStackExport = `{"version": 3, "deployment": {"resources": [
+	{"urn": "urn:pulumi:dev::app::aws:ec2/instance:Instance::web", "custom": true,
+		"type": "aws:ec2/instance:Instance", "inputs": {"instanceType": "t3.micro"}},
+	{"urn": "urn:pulumi:dev::app::aws:ec2/instance:Instance::worker", "custom": true,
+		"type": "aws:ec2/instance:Instance", "inputs": {"instanceType": "t3.micro"}},
+	{"urn": "urn:pulumi:dev::app::aws:ec2/vpc:Vpc::net", "custom": true, "type": "aws:ec2/vpc:Vpc", "inputs": {}},
+	{"urn": "urn:pulumi:dev::app::aws:sqs/queue:Queue::jobs", "custom": true, "type": "aws:sqs/queue:Queue", "inputs": {}}
+]}}`
+
+func TestRunStack_JSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stack.json")
+	require.NoError(t, os.WriteFile(path, []byte(testStackExport), 0o600))
+
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, runStack([]string{"--format", "json", path}, nil, &stdout, &stderr))
+
+	var estimate stackEstimate
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &estimate), "output: %s", stdout.String())
+	require.Len(t, estimate.Resources, 4)
+
+	web := estimate.Resources[0]
+	assert.Equal(t, "t3.micro", web.Sku)
+	assert.Greater(t, web.CostPerMonth, 0.0)
+	assert.Greater(t, web.CarbonGrams, 0.0)
+	assert.True(t, strings.HasSuffix(estimate.Resources[3].URN, "::jobs"))
+	assert.NotEmpty(t, estimate.Resources[3].Skipped)
+
+	var cost, carbon float64
+	for _, r := range estimate.Resources {
+		cost += r.CostPerMonth
+		carbon += r.CarbonGrams
+	}
+	assert.InDelta(t, 2*web.CostPerMonth, estimate.TotalCostPerMonth, 1e-9)
+	assert.InDelta(t, cost, estimate.TotalCostPerMonth, 1e-9)
+	assert.InDelta(t, carbon, estimate.TotalCarbonGrams, 1e-9)
+	assert.Equal(t, "USD", estimate.Currency, "currency comes from the pricing data")
+}
+
+func TestRunStack_TableFromStdin(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	require.NoError(t, runStack([]string{"-"}, strings.NewReader(testStackExport), &stdout, &stderr))
+
+	table := stdout.String()
+	assert.Contains(t, table, "web")
+	assert.Contains(t, table, "skipped: resource type not supported")
+	assert.Contains(t, table, "TOTAL")
+	assert.Contains(t, table, "4 resources")
+}
+
+func TestRunStack_UsageErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		stdin   string
+		wantErr string
+	}{
+		{name: "missing file", args: nil, wantErr: "expected one stack export file"},
+		{name: "unknown format", args: []string{"--format", "yaml", "-"}, wantErr: `unknown --format "yaml"`},
+		{name: "unreadable file", args: []string{filepath.Join(t.TempDir(), "missing.json")}, wantErr: "read stack export"},
+		{name: "invalid json", args: []string{"-"}, stdin: "{", wantErr: "parse stack export"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			err := runStack(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Empty(t, stdout.String())
+		})
+	}
+}
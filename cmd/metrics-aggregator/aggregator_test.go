@@ -1,8 +1,10 @@
 package main
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -73,3 +75,100 @@ func TestAggregatedMetricsHandler(t *testing.T) {
 		t.Fatalf("Expected empty body or metrics content, got: %s", body)
 	}
 }
+
+func TestAggregatedMetricsHandler_Gzip(t *testing.T) {
+	const metrics = "# HELP test_metric Test metric\n# TYPE test_metric gauge\ntest_metric 1\n"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(metrics)); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	port := 0
+	if _, err := fmt.Sscanf(strings.TrimPrefix(server.URL, "http://127.0.0.1:"), "%d", &port); err != nil {
+		t.Fatalf("Failed to parse port: %v", err)
+	}
+	httpClient := &http.Client{Timeout: 1 * time.Second}
+
+	// serve runs the handler and returns the decoded body and response
+	serve := func(t *testing.T, config *Config, acceptEncoding string) (string, *http.Response) {
+		t.Helper()
+		req := httptest.NewRequest("GET", "/metrics/aggregated", nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		aggregatedMetricsHandler(w, req, config, httpClient)
+
+		resp := w.Result()
+		if got := resp.Header.Get("Content-Type"); got != "text/plain; charset=utf-8" {
+			t.Errorf("Content-Type = %q, want text/plain; charset=utf-8", got)
+		}
+		if resp.Header.Get("Content-Encoding") != "gzip" {
+			return w.Body.String(), resp
+		}
+		gz, err := gzip.NewReader(w.Body)
+		if err != nil {
+			t.Fatalf("Failed to open gzip body: %v", err)
+		}
+		body, err := io.ReadAll(gz)
+		if err != nil {
+			t.Fatalf("Failed to decompress body: %v", err)
+		}
+		return string(body), resp
+	}
+
+	t.Run("compressed matches uncompressed", func(t *testing.T) {
+		config := &Config{StartPort: port, EndPort: port, Timeout: 1 * time.Second}
+
+		plain, plainResp := serve(t, config, "")
+		compressed, gzResp := serve(t, config, "br, gzip;q=0.8")
+
+		if plainResp.Header.Get("Content-Encoding") != "" {
+			t.Errorf("uncompressed Content-Encoding = %q, want none", plainResp.Header.Get("Content-Encoding"))
+		}
+		if gzResp.Header.Get("Content-Encoding") != "gzip" {
+			t.Fatalf("Content-Encoding = %q, want gzip", gzResp.Header.Get("Content-Encoding"))
+		}
+		if plainResp.StatusCode != http.StatusOK || gzResp.StatusCode != http.StatusOK {
+			t.Errorf("status = (%d, %d), want 200", plainResp.StatusCode, gzResp.StatusCode)
+		}
+		if compressed != plain {
+			t.Errorf("decompressed body = %q, want %q", compressed, plain)
+		}
+		if !strings.Contains(plain, "test_metric 1") {
+			t.Errorf("body = %q, want it to contain test_metric", plain)
+		}
+	})
+
+	t.Run("refused gzip is not compressed", func(t *testing.T) {
+		config := &Config{StartPort: port, EndPort: port, Timeout: 1 * time.Second}
+		_, resp := serve(t, config, "gzip;q=0")
+		if resp.Header.Get("Content-Encoding") != "" {
+			t.Errorf("Content-Encoding = %q, want none", resp.Header.Get("Content-Encoding"))
+		}
+	})
+
+	t.Run("degraded still returns 503", func(t *testing.T) {
+		// A closed server leaves a port with no listener, so every region fails
+		closed := httptest.NewServer(http.NotFoundHandler())
+		closedPort := 0
+		if _, err := fmt.Sscanf(strings.TrimPrefix(closed.URL, "http://127.0.0.1:"), "%d", &closedPort); err != nil {
+			t.Fatalf("Failed to parse port: %v", err)
+		}
+		closed.Close()
+
+		config := &Config{StartPort: closedPort, EndPort: closedPort, Timeout: 1 * time.Second}
+		body, resp := serve(t, config, "gzip")
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			t.Errorf("status = %d, want 503", resp.StatusCode)
+		}
+		if resp.Header.Get("Content-Encoding") != "gzip" {
+			t.Errorf("Content-Encoding = %q, want gzip", resp.Header.Get("Content-Encoding"))
+		}
+		if strings.Contains(body, "test_metric") {
+			t.Errorf("body = %q, want no metrics", body)
+		}
+	})
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -76,9 +78,13 @@ func main() {
 // the handler returns HTTP 503 (Service Unavailable) to alert monitoring systems of degraded state.
 // Partial metrics are still returned in this case so operators can investigate.
 //
+// Compression:
+// When the request's Accept-Encoding includes gzip, the body is gzip-compressed and
+// Content-Encoding is set; the status code and Content-Type are unchanged.
+//
 // Parameters:
 //  - w: the http.ResponseWriter used to write the aggregated metrics response.
-//  - r: the incoming HTTP request (unused except for context lifecycle).
+//  - r: the incoming HTTP request (used for context lifecycle and Accept-Encoding).
 //  - config: configuration specifying StartPort, EndPort, and Timeout used for collection.
 //  - httpClient: HTTP client with configured timeout for making requests.
 func aggregatedMetricsHandler(w http.ResponseWriter, r *http.Request, config *Config, httpClient *http.Client) {
@@ -101,6 +107,20 @@ func aggregatedMetricsHandler(w http.ResponseWriter, r *http.Request, config *Co
 	}
 
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	// Headers must be set before WriteHeader, so the encoding is decided up front
+	var body io.Writer = w
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		defer func() {
+			if err := gz.Close(); err != nil {
+				log.Error().Err(err).Msg("Failed to flush compressed response")
+			}
+		}()
+		body = gz
+	}
 
 	// Return HTTP 503 if more than 50% of regions failed
 	if successCount*2 < totalRegions {
@@ -108,12 +128,32 @@ func aggregatedMetricsHandler(w http.ResponseWriter, r *http.Request, config *Co
 		log.Warn().Int("success", successCount).Int("total", totalRegions).Msg("Metrics aggregation degraded: >50% of regions failed")
 	}
 
-	if _, err := w.Write([]byte(allMetrics.String())); err != nil {
+	if _, err := io.WriteString(body, allMetrics.String()); err != nil {
 		log.Error().Err(err).Msg("Failed to write response")
 		return
 	}
 }
 
+// acceptsGzip reports whether the request's Accept-Encoding header lists gzip,
+// ignoring an explicit "gzip;q=0" refusal.
+func acceptsGzip(r *http.Request) bool {
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, part := range strings.Split(header, ",") {
+			coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+			if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+				continue
+			}
+			if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+				if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+					return false
+				}
+			}
+			return true
+		}
+	}
+	return false
+}
+
 // fetchMetrics fetches the Prometheus metrics text from the local /metrics endpoint on the given port.
 //
 // The ctx controls the request lifetime. The port selects the localhost TCP port to query.
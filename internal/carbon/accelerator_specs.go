@@ -0,0 +1,105 @@
+package carbon
+
+import (
+	"strconv"
+	"strings"
+)
+
+// acceleratorFamily describes the AWS ML accelerators (Inferentia, Trainium) of an EC2
+// family, so sizes without an exact gpu_specs.csv or CCF row still carry their
+// accelerator and host power. These are among the most power-hungry instances, and
+// dropping their carbon would understate an ML fleet's footprint the most.
+type acceleratorFamily struct {
+	// Model is the accelerator chip (e.g., "Inferentia2").
+	Model string
+
+	// TDPPerAccelerator is the Thermal Design Power per chip in watts.
+	TDPPerAccelerator float64
+
+	// VCPUsPerAccelerator is the vCPU-to-chip ratio of the family's largest size,
+	// used to size the accelerator count of other sizes.
+	VCPUsPerAccelerator int
+
+	// CPUFamily is the CCF family whose per-vCPU host power stands in for sizes
+	// missing from the CCF data (newer families share their predecessor's hosts).
+	CPUFamily string
+}
+
+// acceleratorFamilies maps ML instance families to their accelerator specs.
+var acceleratorFamilies = map[string]acceleratorFamily{
+	"inf1":  {Model: "Inferentia", TDPPerAccelerator: 75, VCPUsPerAccelerator: 6, CPUFamily: "inf1"},    // inf1.24xlarge: 16 chips
+	"inf2":  {Model: "Inferentia2", TDPPerAccelerator: 175, VCPUsPerAccelerator: 16, CPUFamily: "inf2"}, // inf2.48xlarge: 12 chips
+	"trn1":  {Model: "Trainium", TDPPerAccelerator: 175, VCPUsPerAccelerator: 8, CPUFamily: "trn1"},     // trn1.32xlarge: 16 chips
+	"trn1n": {Model: "Trainium", TDPPerAccelerator: 175, VCPUsPerAccelerator: 8, CPUFamily: "trn1"},     // trn1n.32xlarge: 16 chips
+	"trn2":  {Model: "Trainium2", TDPPerAccelerator: 500, VCPUsPerAccelerator: 12, CPUFamily: "trn1"},   // trn2.48xlarge: 16 chips
+}
+
+// acceleratorGPUSpec derives an accelerator spec for an ML instance type that has no
+// gpu_specs.csv row, sizing the chip count from the instance's vCPUs (at least one).
+func acceleratorGPUSpec(instanceType string) (GPUSpec, bool) {
+	family, ok := acceleratorFamilies[parseInstanceFamily(instanceType)]
+	if !ok {
+		return GPUSpec{}, false
+	}
+	spec, ok := GetInstanceSpec(instanceType)
+	if !ok {
+		return GPUSpec{}, false
+	}
+
+	logger.Debug().
+		Str("instance_type", instanceType).
+		Str("accelerator", family.Model).
+		Msg("ML instance type not in GPU specs, using family-level accelerator power")
+	return GPUSpec{
+		InstanceType: instanceType,
+		GPUModel:     family.Model,
+		GPUCount:     max(1, spec.VCPUCount/family.VCPUsPerAccelerator),
+		TDPPerGPU:    family.TDPPerAccelerator,
+	}, true
+}
+
+// acceleratorFallbackSpec derives a host spec for an ML instance type that has no CCF
+// row: vCPUs from the size name ("Nxlarge" has 4×N) and per-vCPU power from the
+// family's CPUFamily. The returned spec carries the requested type.
+func acceleratorFallbackSpec(instanceType string) (InstanceSpec, bool) {
+	familyName, size, _ := strings.Cut(instanceType, ".")
+	family, ok := acceleratorFamilies[familyName]
+	if !ok {
+		return InstanceSpec{}, false
+	}
+	vcpus, ok := sizeVCPUs(size)
+	if !ok {
+		return InstanceSpec{}, false
+	}
+	host, ok := largestFamilySpec[family.CPUFamily]
+	if !ok {
+		return InstanceSpec{}, false
+	}
+
+	logger.Debug().
+		Str("instance_type", instanceType).
+		Str("fallback_type", host.InstanceType).
+		Msg("ML instance type not in CCF data, using family-level power spec")
+	return InstanceSpec{
+		InstanceType: instanceType,
+		VCPUCount:    vcpus,
+		MinWatts:     host.MinWatts,
+		MaxWatts:     host.MaxWatts,
+	}, true
+}
+
+// sizeVCPUs returns the vCPU count implied by an "xlarge" or "Nxlarge" size name.
+func sizeVCPUs(size string) (int, bool) {
+	if size == "xlarge" {
+		return 4, true
+	}
+	multiple, found := strings.CutSuffix(size, "xlarge")
+	if !found {
+		return 0, false
+	}
+	n, err := strconv.Atoi(multiple)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return 4 * n, true
+}
@@ -0,0 +1,78 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetInstanceSpec_AcceleratorFallback verifies ML sizes without a CCF row are sized
+// from their name and carry their family's host power.
+func TestGetInstanceSpec_AcceleratorFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		instanceType string
+		wantFound    bool
+		wantVCPUs    int
+		wantSpecFrom string // type whose power values the result should carry
+	}{
+		{name: "inf1.6xlarge has no CCF row", instanceType: "inf1.6xlarge", wantFound: true, wantVCPUs: 24, wantSpecFrom: "inf1.48xlarge"},
+		{name: "trn1n uses trn1 hosts", instanceType: "trn1n.32xlarge", wantFound: true, wantVCPUs: 128, wantSpecFrom: "trn1.48xlarge"},
+		{name: "trn2 uses trn1 hosts", instanceType: "trn2.48xlarge", wantFound: true, wantVCPUs: 192, wantSpecFrom: "trn1.48xlarge"},
+		{name: "non-xlarge size", instanceType: "trn2.metal", wantFound: false},
+		{name: "non-accelerator family", instanceType: "zz9.8xlarge", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, found := GetInstanceSpec(tt.instanceType)
+			require.Equal(t, tt.wantFound, found)
+			if !tt.wantFound {
+				return
+			}
+
+			source, ok := GetInstanceSpec(tt.wantSpecFrom)
+			require.True(t, ok, "source type %s should exist", tt.wantSpecFrom)
+			assert.Equal(t, tt.instanceType, spec.InstanceType)
+			assert.Equal(t, tt.wantVCPUs, spec.VCPUCount)
+			assert.Equal(t, source.MinWatts, spec.MinWatts)
+			assert.Equal(t, source.MaxWatts, spec.MaxWatts)
+		})
+	}
+}
+
+// TestGetGPUSpec_AcceleratorFallback verifies Inferentia and Trainium sizes without a
+// gpu_specs.csv row get family-level accelerator power sized by vCPUs.
+func TestGetGPUSpec_AcceleratorFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		instanceType string
+		wantFound    bool
+		wantModel    string
+		wantCount    int
+		wantTDP      float64
+	}{
+		{name: "exact row wins", instanceType: "inf2.24xlarge", wantFound: true, wantModel: "Inferentia2", wantCount: 6, wantTDP: 175},
+		{name: "inf2 size without a row", instanceType: "inf2.16xlarge", wantFound: true, wantModel: "Inferentia2", wantCount: 4, wantTDP: 175},
+		{name: "small size gets one chip", instanceType: "inf2.2xlarge", wantFound: true, wantModel: "Inferentia2", wantCount: 1, wantTDP: 175},
+		{name: "trn1 size without a row", instanceType: "trn1.16xlarge", wantFound: true, wantModel: "Trainium", wantCount: 8, wantTDP: 175},
+		{name: "CPU-only family", instanceType: "m5.16xlarge", wantFound: false},
+		{name: "unknown accelerator size", instanceType: "trn2.huge", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, found := GetGPUSpec(tt.instanceType)
+			require.Equal(t, tt.wantFound, found)
+			if !tt.wantFound {
+				assert.Equal(t, GPUSpec{}, spec)
+				return
+			}
+			assert.Equal(t, tt.wantModel, spec.GPUModel)
+			assert.Equal(t, tt.wantCount, spec.GPUCount)
+			assert.Equal(t, tt.wantTDP, spec.TDPPerGPU)
+			assert.True(t, HasGPU(tt.instanceType))
+		})
+	}
+}
@@ -48,4 +48,9 @@ const (
 	// LambdaMaxWattsPerVCPU is the peak power for Lambda functions at 100% utilization.
 	// Source: CCF methodology typical values for modern x86 processors.
 	LambdaMaxWattsPerVCPU = 4.5
+
+	// MinGridFactor and MaxGridFactor bound a grid emission factor in metric tons
+	// CO2e per kWh, the same range tools/update-grid-factors validates against.
+	MinGridFactor = 0.0
+	MaxGridFactor = 2.0
 )
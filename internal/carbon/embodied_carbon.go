@@ -198,7 +198,9 @@ func GetMaxFamilyVCPUs(instanceType string) float64 {
 		"inf2": 192, // inf2.48xlarge
 
 		// Training instances
-		"trn1": 128, // trn1.32xlarge
+		"trn1":  128, // trn1.32xlarge
+		"trn1n": 128, // trn1n.32xlarge
+		"trn2":  192, // trn2.48xlarge
 	}
 
 	if max, ok := maxVCPUs[family]; ok {
@@ -32,26 +32,34 @@ func NewEstimator() *Estimator {
 //  5. Energy with PUE = Energy × AWS_PUE (1.135)
 //  6. Carbon (gCO2e) = Energy with PUE × grid intensity × 1,000,000
 //
+// Storage-optimized families with bundled instance store (i3, i4i, d3, ...) also
+// include the local storage carbon from CalculateInstanceStoreCarbonGrams.
+//
 // Returns (0, false) if the instance type is not found in CCF data.
 func (e *Estimator) EstimateCarbonGrams(instanceType, region string, utilization, hours float64) (float64, bool) {
-	cpuCarbon, gpuCarbon, ok := e.EstimateCarbonGramsWithBreakdown(instanceType, region, utilization, hours)
+	cpuCarbon, gpuCarbon, storageCarbon, ok := e.EstimateCarbonGramsWithBreakdown(instanceType, region, utilization, hours)
 	if !ok {
 		return 0, false
 	}
-	return cpuCarbon + gpuCarbon, true
+	return cpuCarbon + gpuCarbon + storageCarbon, true
 }
 
 // EstimateCarbonGramsWithBreakdown calculates carbon emissions for an EC2 instance
-// and returns a breakdown of CPU and GPU contributions.
+// and returns a breakdown of CPU, GPU and instance store contributions, which sum to
+// EstimateCarbonGrams.
 //
 // Returns:
 //   - cpuCarbon: Carbon from CPU power consumption (gCO2e)
 //   - gpuCarbon: Carbon from GPU power consumption (gCO2e)
+//   - storageCarbon: Carbon from bundled local instance storage (gCO2e)
 //   - ok: Whether the calculation succeeded
-func (e *Estimator) EstimateCarbonGramsWithBreakdown(instanceType, region string, utilization, hours float64) (cpuCarbon, gpuCarbon float64, ok bool) {
+func (e *Estimator) EstimateCarbonGramsWithBreakdown(
+	instanceType, region string,
+	utilization, hours float64,
+) (cpuCarbon, gpuCarbon, storageCarbon float64, ok bool) {
 	spec, found := GetInstanceSpec(instanceType)
 	if !found {
-		return 0, 0, false
+		return 0, 0, 0, false
 	}
 
 	gridFactor := GetGridFactor(region)
@@ -76,7 +84,9 @@ func (e *Estimator) EstimateCarbonGramsWithBreakdown(instanceType, region string
 		}
 	}
 
-	return cpuCarbon, gpuCarbon, true
+	storageCarbon = CalculateInstanceStoreCarbonGrams(instanceType, region, hours)
+
+	return cpuCarbon, gpuCarbon, storageCarbon, true
 }
 
 // CalculateCarbonGrams applies the CCF formula to calculate carbon emissions.
@@ -285,7 +285,7 @@ func TestEstimator_EstimateCarbonGramsWithBreakdown(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := NewEstimator()
-			cpuCarbon, gpuCarbon, ok := e.EstimateCarbonGramsWithBreakdown(
+			cpuCarbon, gpuCarbon, storageCarbon, ok := e.EstimateCarbonGramsWithBreakdown(
 				tt.instanceType, "us-east-1", 0.5, 730)
 
 			require.True(t, ok, "instance should be found")
@@ -299,6 +299,7 @@ func TestEstimator_EstimateCarbonGramsWithBreakdown(t *testing.T) {
 			} else {
 				assert.Equal(t, 0.0, gpuCarbon, "non-GPU instance should have zero GPU carbon")
 			}
+			assert.Equal(t, 0.0, storageCarbon, "instance without instance store should have zero storage carbon")
 
 			// Verify total range
 			totalCarbon := cpuCarbon + gpuCarbon
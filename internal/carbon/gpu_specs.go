@@ -99,11 +99,14 @@ func parseGPUSpecs() {
 
 // GetGPUSpec retrieves the GPUSpec for the given EC2 instance type.
 // Returns the GPUSpec and true if found, or an empty GPUSpec and false otherwise.
-// Non-GPU instances will return (empty, false).
+// Non-GPU instances will return (empty, false). Inferentia and Trainium sizes
+// without a row fall back to family-level accelerator power (see acceleratorGPUSpec).
 func GetGPUSpec(instanceType string) (GPUSpec, bool) {
 	gpuSpecsOnce.Do(parseGPUSpecs)
-	spec, ok := gpuSpecs[instanceType]
-	return spec, ok
+	if spec, ok := gpuSpecs[instanceType]; ok {
+		return spec, true
+	}
+	return acceleratorGPUSpec(instanceType)
 }
 
 // HasGPU returns true if the instance type has GPU accelerators.
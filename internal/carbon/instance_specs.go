@@ -23,16 +23,24 @@ const (
 //go:embed data/ccf_instance_specs.csv
 var instanceSpecsCSV string
 
+// instanceMemoryCSV holds the memory of each instance type from the EC2 price list's
+// "memory" attribute; the CCF data has no usable memory column.
+//
+//go:embed data/instance_memory.csv
+var instanceMemoryCSV string
+
 // InstanceSpec contains power consumption characteristics for an EC2 instance type.
 type InstanceSpec struct {
 	InstanceType string
 	VCPUCount    int
+	MemoryGB     float64 // Instance memory in GiB from the EC2 price list (0 if unknown)
 	MinWatts     float64 // Power consumption at idle (watts per vCPU)
 	MaxWatts     float64 // Power consumption at 100% utilization (watts per vCPU)
 }
 
 var (
 	instanceSpecs     map[string]InstanceSpec
+	largestFamilySpec map[string]InstanceSpec // family (e.g. "m7i") -> spec with the most vCPUs
 	instanceSpecsOnce sync.Once
 	logger            zerolog.Logger = zerolog.Nop()
 	loggerOnce        sync.Once
@@ -79,15 +87,44 @@ func init() {
 // Malformed or incomplete rows are skipped. This function should be invoked
 // once via sync.Once to populate the lookup map.
 func parseInstanceSpecs() {
-	instanceSpecs = make(map[string]InstanceSpec)
+	instanceSpecs = parseInstanceSpecsCSV(instanceSpecsCSV)
+	for instanceType, memoryGB := range parseInstanceMemoryCSV(instanceMemoryCSV) {
+		if spec, ok := instanceSpecs[instanceType]; ok {
+			spec.MemoryGB = memoryGB
+			instanceSpecs[instanceType] = spec
+		}
+	}
+	largestFamilySpec = largestSpecPerFamily(instanceSpecs)
+}
+
+// largestSpecPerFamily returns the spec with the most vCPUs for each instance family,
+// breaking ties by instance type name so the result is deterministic.
+func largestSpecPerFamily(specs map[string]InstanceSpec) map[string]InstanceSpec {
+	largest := make(map[string]InstanceSpec)
+	for instanceType, spec := range specs {
+		family, _, _ := strings.Cut(instanceType, ".")
+		current, ok := largest[family]
+		if !ok || spec.VCPUCount > current.VCPUCount ||
+			(spec.VCPUCount == current.VCPUCount && instanceType < current.InstanceType) {
+			largest[family] = spec
+		}
+	}
+	return largest
+}
 
-	reader := csv.NewReader(strings.NewReader(instanceSpecsCSV))
+// parseInstanceSpecsCSV parses CCF instance specs CSV data into a map keyed by
+// instance type, applying the row filters described on parseInstanceSpecs.
+// MemoryGB is left at 0; it is filled from the EC2 price list by parseInstanceSpecs.
+func parseInstanceSpecsCSV(data string) map[string]InstanceSpec {
+	specs := make(map[string]InstanceSpec)
+
+	reader := csv.NewReader(strings.NewReader(data))
 
 	// Skip header row
 	_, err := reader.Read()
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to read CCF instance specs CSV header")
-		return
+		return specs
 	}
 
 	for {
@@ -126,13 +163,44 @@ func parseInstanceSpecs() {
 			continue
 		}
 
-		instanceSpecs[instanceType] = InstanceSpec{
+		specs[instanceType] = InstanceSpec{
 			InstanceType: instanceType,
 			VCPUCount:    vcpuCount,
 			MinWatts:     minWatts,
 			MaxWatts:     maxWatts,
 		}
 	}
+	return specs
+}
+
+// parseInstanceMemoryCSV parses the instance_type,memory_gib CSV written by
+// tools/generate-carbon-data into a map of memory in GiB keyed by instance type.
+// Rows without a positive memory value are skipped.
+func parseInstanceMemoryCSV(data string) map[string]float64 {
+	memory := make(map[string]float64)
+
+	reader := csv.NewReader(strings.NewReader(data))
+	if _, err := reader.Read(); err != nil {
+		logger.Error().Err(err).Msg("failed to read instance memory CSV header")
+		return memory
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil || len(record) < 2 {
+			logger.Warn().Err(err).Msg("skipping malformed instance memory CSV row")
+			continue
+		}
+		memoryGB, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil || memoryGB <= 0 {
+			continue
+		}
+		memory[strings.TrimSpace(record[0])] = memoryGB
+	}
+	return memory
 }
 
 // parseEuropeanFloat parses a decimal number that may use a comma as the decimal
@@ -155,10 +223,48 @@ func parseEuropeanFloat(s string) float64 {
 // on first use (lazy initialization) and looks up the instanceType in the
 // internal registry. Returns the InstanceSpec and true if found, or an empty
 // InstanceSpec and false otherwise.
+//
+// Bare-metal and ML accelerator types missing from the CCF data fall back to a
+// family-level spec (see metalFallbackSpec and acceleratorFallbackSpec) so the
+// highest-power instances are not silently left without a carbon estimate.
 func GetInstanceSpec(instanceType string) (InstanceSpec, bool) {
 	instanceSpecsOnce.Do(parseInstanceSpecs)
-	spec, ok := instanceSpecs[instanceType]
-	return spec, ok
+	if spec, ok := instanceSpecs[instanceType]; ok {
+		return spec, true
+	}
+	if spec, ok := metalFallbackSpec(instanceType); ok {
+		return spec, true
+	}
+	return acceleratorFallbackSpec(instanceType)
+}
+
+// metalFallbackSpec derives a spec for a bare-metal type that has no CCF row.
+// Sized variants such as "m7i.metal-48xl" use the matching virtualized size
+// ("m7i.48xlarge"); otherwise the family's largest type is used, since a metal
+// instance exposes the whole host. The returned spec carries the requested type.
+func metalFallbackSpec(instanceType string) (InstanceSpec, bool) {
+	family, size, _ := strings.Cut(instanceType, ".")
+	if !strings.HasPrefix(size, "metal") {
+		return InstanceSpec{}, false
+	}
+
+	spec, ok := InstanceSpec{}, false
+	if n, sized := strings.CutPrefix(size, "metal-"); sized && strings.HasSuffix(n, "xl") {
+		spec, ok = instanceSpecs[family+"."+strings.TrimSuffix(n, "xl")+"xlarge"]
+	}
+	if !ok {
+		spec, ok = largestFamilySpec[family]
+	}
+	if !ok {
+		return InstanceSpec{}, false
+	}
+
+	logger.Debug().
+		Str("instance_type", instanceType).
+		Str("fallback_type", spec.InstanceType).
+		Msg("bare-metal instance type not in CCF data, using family-level power spec")
+	spec.InstanceType = instanceType
+	return spec, true
 }
 
 // InstanceSpecCount reports the number of loaded instance specifications.
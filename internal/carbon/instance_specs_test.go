@@ -66,6 +66,42 @@ func TestGetInstanceSpec_EmptyType(t *testing.T) {
 	assert.Equal(t, InstanceSpec{}, spec)
 }
 
+// TestGetInstanceSpec_BareMetal verifies .metal types resolve from the CCF data and that
+// metal types without a row fall back to a family-level spec.
+func TestGetInstanceSpec_BareMetal(t *testing.T) {
+	tests := []struct {
+		name         string
+		instanceType string
+		wantFound    bool
+		wantSpecFrom string // type whose power values the result should carry
+	}{
+		{name: "c5.metal has a CCF row", instanceType: "c5.metal", wantFound: true, wantSpecFrom: "c5.metal"},
+		{name: "m5.metal has a CCF row", instanceType: "m5.metal", wantFound: true, wantSpecFrom: "m5.metal"},
+		{name: "sized variant uses matching size", instanceType: "m7i.metal-24xl", wantFound: true, wantSpecFrom: "m7i.24xlarge"},
+		{name: "unmatched sized variant uses largest in family", instanceType: "m7i.metal-64xl", wantFound: true, wantSpecFrom: "m7i.48xlarge"},
+		{name: "unknown family", instanceType: "zz9.metal", wantFound: false},
+		{name: "non-metal unknown size", instanceType: "m5.huge", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, found := GetInstanceSpec(tt.instanceType)
+			require.Equal(t, tt.wantFound, found)
+			if !tt.wantFound {
+				assert.Equal(t, InstanceSpec{}, spec)
+				return
+			}
+
+			source, ok := GetInstanceSpec(tt.wantSpecFrom)
+			require.True(t, ok, "source type %s should exist", tt.wantSpecFrom)
+			assert.Equal(t, tt.instanceType, spec.InstanceType)
+			assert.Equal(t, source.VCPUCount, spec.VCPUCount)
+			assert.Equal(t, source.MinWatts, spec.MinWatts)
+			assert.Equal(t, source.MaxWatts, spec.MaxWatts)
+		})
+	}
+}
+
 func TestInstanceSpecCount_HasEntries(t *testing.T) {
 	count := InstanceSpecCount()
 	// CCF data has 500+ instance types
@@ -83,3 +119,31 @@ func TestGetInstanceSpec_PowerValues(t *testing.T) {
 	assert.Greater(t, spec.MaxWatts, spec.MinWatts, "MaxWatts should be > MinWatts")
 	assert.Less(t, spec.MaxWatts, 100.0, "MaxWatts should be reasonable")
 }
+
+func TestParseInstanceMemoryCSV(t *testing.T) {
+	data := "instance_type,memory_gib\n" +
+		"m5.large,8\n" +
+		"t3.nano,0.5\n" +
+		"x9.large,n/a\n"
+
+	memory := parseInstanceMemoryCSV(data)
+	require.Len(t, memory, 2, "unparseable memory is skipped")
+	assert.Equal(t, 8.0, memory["m5.large"])
+	assert.Equal(t, 0.5, memory["t3.nano"])
+}
+
+// TestGetInstanceSpec_EmbeddedMemory verifies the embedded data gives instance memory,
+// which the CCF specs alone do not.
+func TestGetInstanceSpec_EmbeddedMemory(t *testing.T) {
+	tests := map[string]float64{
+		"t3.micro":   1,
+		"m5.large":   8,
+		"c5.xlarge":  8,
+		"r5.2xlarge": 64,
+	}
+	for instanceType, want := range tests {
+		spec, found := GetInstanceSpec(instanceType)
+		require.True(t, found, "%s should exist in CCF data", instanceType)
+		assert.Equal(t, want, spec.MemoryGB, "%s memory", instanceType)
+	}
+}
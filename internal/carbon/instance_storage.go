@@ -0,0 +1,65 @@
+package carbon
+
+import "strings"
+
+// InstanceStoreSpec describes the local instance storage bundled with an EC2 family.
+type InstanceStoreSpec struct {
+	// GBPerVCPU is the local storage capacity per vCPU. AWS sizes instance store
+	// linearly with vCPU count within these families (e.g. i3.large: 2 vCPU, 475 GB).
+	GBPerVCPU float64
+
+	// Technology is the storage technology (SSD or HDD).
+	Technology string
+}
+
+// instanceStoreFamilies lists storage-optimized families whose price includes local
+// instance storage. CCF instance data does not break out storage power, so it is
+// added separately using the CCF storage coefficients.
+var instanceStoreFamilies = map[string]InstanceStoreSpec{
+	"i3":   {GBPerVCPU: 237.5, Technology: "SSD"},
+	"i3en": {GBPerVCPU: 625, Technology: "SSD"},
+	"i4i":  {GBPerVCPU: 234.375, Technology: "SSD"},
+	"d2":   {GBPerVCPU: 1536, Technology: "HDD"},
+	"d3":   {GBPerVCPU: 1500, Technology: "HDD"},
+}
+
+// GetInstanceStoreSpec returns the instance store characteristics for an EC2 instance type.
+// Returns false if the instance type's family has no bundled instance storage.
+func GetInstanceStoreSpec(instanceType string) (InstanceStoreSpec, bool) {
+	family, _, _ := strings.Cut(strings.ToLower(instanceType), ".")
+	spec, ok := instanceStoreFamilies[family]
+	return spec, ok
+}
+
+// InstanceStoreSizeGB returns the total local storage for an instance type, derived from
+// its vCPU count. Returns 0 if the type has no instance storage or is not in CCF data.
+func InstanceStoreSizeGB(instanceType string) float64 {
+	storeSpec, ok := GetInstanceStoreSpec(instanceType)
+	if !ok {
+		return 0
+	}
+	spec, ok := GetInstanceSpec(instanceType)
+	if !ok {
+		return 0
+	}
+	return storeSpec.GBPerVCPU * float64(spec.VCPUCount)
+}
+
+// CalculateInstanceStoreCarbonGrams calculates carbon emissions for the local instance
+// storage of an EC2 instance. Instance store is not replicated, so the replication
+// factor is 1. Returns 0 for instance types without instance storage.
+func CalculateInstanceStoreCarbonGrams(instanceType, region string, hours float64) float64 {
+	sizeGB := InstanceStoreSizeGB(instanceType)
+	if sizeGB == 0 {
+		return 0
+	}
+
+	storeSpec, _ := GetInstanceStoreSpec(instanceType)
+	powerCoefficient := SSDPowerCoefficient
+	if storeSpec.Technology == "HDD" {
+		powerCoefficient = HDDPowerCoefficient
+	}
+
+	energyKWh := (sizeGB / 1024.0) * hours * powerCoefficient / 1000.0
+	return energyKWh * AWSPUE * GetGridFactor(region) * 1_000_000
+}
@@ -0,0 +1,55 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetInstanceStoreSpec verifies instance store family lookup.
+func TestGetInstanceStoreSpec(t *testing.T) {
+	tests := []struct {
+		instanceType   string
+		wantOK         bool
+		wantTechnology string
+	}{
+		{instanceType: "i3.large", wantOK: true, wantTechnology: "SSD"},
+		{instanceType: "I4I.xlarge", wantOK: true, wantTechnology: "SSD"},
+		{instanceType: "d3.2xlarge", wantOK: true, wantTechnology: "HDD"},
+		{instanceType: "m5.large", wantOK: false},
+		{instanceType: "t3.micro", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.instanceType, func(t *testing.T) {
+			spec, ok := GetInstanceStoreSpec(tt.instanceType)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantTechnology, spec.Technology)
+		})
+	}
+}
+
+// TestInstanceStoreSizeGB verifies storage capacity scales with vCPU count.
+func TestInstanceStoreSizeGB(t *testing.T) {
+	assert.InDelta(t, 475.0, InstanceStoreSizeGB("i3.large"), 0.01)
+	assert.InDelta(t, 950.0, InstanceStoreSizeGB("i3.xlarge"), 0.01)
+	assert.InDelta(t, 6000.0, InstanceStoreSizeGB("d3.xlarge"), 0.01, "d3.xlarge: 3 x 2 TB HDD")
+	assert.Equal(t, 0.0, InstanceStoreSizeGB("m5.large"))
+}
+
+// TestEstimator_EstimateCarbonGrams_InstanceStore verifies instance store carbon is added.
+func TestEstimator_EstimateCarbonGrams_InstanceStore(t *testing.T) {
+	e := NewEstimator()
+
+	total, ok := e.EstimateCarbonGrams("i3.large", "us-east-1", 0.5, 730)
+	require.True(t, ok, "i3.large should be found")
+
+	cpuCarbon, gpuCarbon, storageCarbon, ok := e.EstimateCarbonGramsWithBreakdown("i3.large", "us-east-1", 0.5, 730)
+	require.True(t, ok)
+
+	assert.Greater(t, storageCarbon, 0.0)
+	assert.InDelta(t, CalculateInstanceStoreCarbonGrams("i3.large", "us-east-1", 730), storageCarbon, 0.0001)
+	assert.InDelta(t, cpuCarbon+gpuCarbon+storageCarbon, total, 0.0001)
+	assert.Equal(t, 0.0, CalculateInstanceStoreCarbonGrams("m5.large", "us-east-1", 730))
+}
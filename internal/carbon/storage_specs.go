@@ -184,3 +184,25 @@ func CalculateStorageCarbonGrams(sizeGB, hours float64, serviceType, storageClas
 
 	return carbonGrams
 }
+
+// CalculateReplicatedStorageCarbonGrams calculates the carbon emissions for storage held
+// in several regions, each copy at its own region's grid factor.
+// Parameters:
+//   - copies: Size and region of each copy
+//   - hours: Duration in hours
+//   - serviceType: AWS service (s3, dynamodb)
+//   - storageClass: Storage class or volume type
+//
+// Returns the summed carbon in gCO2e and each copy's contribution in input order.
+func CalculateReplicatedStorageCarbonGrams(
+	copies []RegionalStorageConfig, hours float64, serviceType, storageClass string,
+) (float64, []RegionalCarbon) {
+	var total float64
+	perRegion := make([]RegionalCarbon, 0, len(copies))
+	for _, c := range copies {
+		grams := CalculateStorageCarbonGrams(c.SizeGB, hours, serviceType, storageClass, c.Region)
+		total += grams
+		perRegion = append(perRegion, RegionalCarbon{Region: c.Region, CarbonGrams: grams})
+	}
+	return total, perRegion
+}
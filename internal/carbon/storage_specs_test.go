@@ -245,3 +245,31 @@ func TestCalculateStorageCarbonGrams(t *testing.T) {
 		})
 	}
 }
+
+// TestCalculateReplicatedStorageCarbonGrams verifies each copy is priced at its own
+// region's grid factor and the total is their sum.
+func TestCalculateReplicatedStorageCarbonGrams(t *testing.T) {
+	copies := []RegionalStorageConfig{
+		{Region: "us-east-1", SizeGB: 500},
+		{Region: "eu-north-1", SizeGB: 500},
+		{Region: "ap-southeast-2", SizeGB: 500},
+	}
+
+	total, perRegion := CalculateReplicatedStorageCarbonGrams(copies, HoursPerMonth, "dynamodb", "DYNAMODB")
+	require.Len(t, perRegion, len(copies))
+
+	var sum float64
+	for i, c := range copies {
+		want := CalculateStorageCarbonGrams(c.SizeGB, HoursPerMonth, "dynamodb", "DYNAMODB", c.Region)
+		assert.Equal(t, c.Region, perRegion[i].Region)
+		assert.InDelta(t, want, perRegion[i].CarbonGrams, 1e-9)
+		sum += want
+	}
+	assert.InDelta(t, sum, total, 1e-9)
+	assert.Greater(t, perRegion[2].CarbonGrams, perRegion[0].CarbonGrams, "Sydney grid is dirtier than Virginia")
+	assert.Less(t, perRegion[1].CarbonGrams, perRegion[0].CarbonGrams, "Sweden grid is cleaner than Virginia")
+
+	total, perRegion = CalculateReplicatedStorageCarbonGrams(nil, HoursPerMonth, "s3", "STANDARD")
+	assert.Zero(t, total)
+	assert.Empty(t, perRegion)
+}
@@ -102,6 +102,25 @@ type S3StorageConfig struct {
 	Hours float64
 }
 
+// RegionalStorageConfig is one region's copy of replicated storage, e.g. a DynamoDB
+// global table replica or an S3 Cross-Region Replication destination.
+type RegionalStorageConfig struct {
+	// Region is the AWS region holding the copy.
+	Region string
+
+	// SizeGB is the size of the copy in gigabytes.
+	SizeGB float64
+}
+
+// RegionalCarbon is one region's contribution to a replicated storage estimate.
+type RegionalCarbon struct {
+	// Region is the AWS region.
+	Region string `json:"region"`
+
+	// CarbonGrams is the region's carbon footprint in gCO2e.
+	CarbonGrams float64 `json:"gco2e"`
+}
+
 // LambdaFunctionConfig contains configuration for Lambda function carbon estimation.
 type LambdaFunctionConfig struct {
 	// MemoryMB is the allocated memory in megabytes.
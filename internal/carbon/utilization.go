@@ -8,6 +8,13 @@ package carbon
 //
 // The returned value is a float64 in the range [0.0, 1.0].
 func GetUtilization(requestUtil float64, perResourceUtil *float64) float64 {
+	return GetUtilizationWithDefault(requestUtil, perResourceUtil, DefaultUtilization)
+}
+
+// GetUtilizationWithDefault is GetUtilization with a caller-supplied fallback in place of
+// DefaultUtilization, for deployments that know their baseline utilization. A defaultUtil
+// that is not positive falls back to DefaultUtilization; larger values are clamped to 1.0.
+func GetUtilizationWithDefault(requestUtil float64, perResourceUtil *float64, defaultUtil float64) float64 {
 	// Priority 1: Per-resource override
 	if perResourceUtil != nil && *perResourceUtil > 0 {
 		return Clamp(*perResourceUtil, 0.0, 1.0)
@@ -19,7 +26,10 @@ func GetUtilization(requestUtil float64, perResourceUtil *float64) float64 {
 	}
 
 	// Priority 3: Default
-	return DefaultUtilization
+	if defaultUtil <= 0 {
+		return DefaultUtilization
+	}
+	return Clamp(defaultUtil, 0.0, 1.0)
 }
 
 // Clamp returns v constrained to the inclusive range [min, max]. If v is less than min, Clamp returns min; if v is greater than max, Clamp returns max; otherwise it returns v.
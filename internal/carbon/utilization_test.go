@@ -121,6 +121,54 @@ func TestGetUtilization_Clamping(t *testing.T) {
 	}
 }
 
+// TestGetUtilizationWithDefault tests that a configured default replaces the 50% fallback
+// while per-resource and request-level values still take priority.
+func TestGetUtilizationWithDefault(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestUtil    float64
+		perResourceVal *float64
+		defaultUtil    float64
+		want           float64
+	}{
+		{
+			name:        "configured default used when nothing explicit",
+			defaultUtil: 0.3,
+			want:        0.3,
+		},
+		{
+			name:        "request-level wins over configured default",
+			requestUtil: 0.7,
+			defaultUtil: 0.3,
+			want:        0.7,
+		},
+		{
+			name:           "perResource wins over configured default",
+			requestUtil:    0.7,
+			perResourceVal: ptr(0.9),
+			defaultUtil:    0.3,
+			want:           0.9,
+		},
+		{
+			name:        "zero default falls back to DefaultUtilization",
+			defaultUtil: 0,
+			want:        DefaultUtilization,
+		},
+		{
+			name:        "default clamped to max",
+			defaultUtil: 1.5,
+			want:        1.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetUtilizationWithDefault(tt.requestUtil, tt.perResourceVal, tt.defaultUtil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 // ptr returns a pointer to the given float64 value.
 func ptr(f float64) *float64 {
 	return &f
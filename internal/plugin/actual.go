@@ -1,11 +1,13 @@
 package plugin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
 
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
 )
 
 // Standard tag keys for Pulumi state metadata.
@@ -206,15 +208,24 @@ func determineConfidence(resolution *TimestampResolution) ConfidenceLevel {
 	return ConfidenceHigh
 }
 
+// hoursBetween returns the elapsed hours from start to end, negative if end is first.
+// It subtracts absolute instants rather than wall-clock readings, so a window that
+// crosses a DST change counts the hours that actually passed (23 or 25 for the
+// transition day) and calendar months count their real length, matching how AWS
+// bills hourly usage.
+func hoursBetween(start, end time.Time) float64 {
+	return end.UTC().Sub(start.UTC()).Hours()
+}
+
 // calculateRuntimeHours computes the duration between two timestamps in hours.
 // Returns a float64 for precise fractional hour calculations.
 // Returns an error if from > to (negative duration).
 func calculateRuntimeHours(from, to time.Time) (float64, error) {
-	duration := to.Sub(from)
-	if duration < 0 {
+	hours := hoursBetween(from, to)
+	if hours < 0 {
 		return 0, fmt.Errorf("invalid time range: from (%v) is after to (%v)", from, to)
 	}
-	return duration.Hours(), nil
+	return hours, nil
 }
 
 // getProjectedForResource retrieves the projected monthly cost for a resource
@@ -227,7 +238,7 @@ func calculateRuntimeHours(from, to time.Time) (float64, error) {
 //
 // The resolver parameter is optional. If provided, it's used to get the cached
 // service type. If nil, a new resolver is created internally (for backward compatibility).
-func (p *AWSPublicPlugin) getProjectedForResource(traceID string, resource *pbc.ResourceDescriptor, resolver *serviceResolver) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) getProjectedForResource(ctx context.Context, traceID string, resource *pbc.ResourceDescriptor, resolver *serviceResolver) (*pbc.GetProjectedCostResponse, error) {
 	// Defensive nil check - callers should validate, but be safe
 	if resource == nil {
 		return nil, fmt.Errorf("resource descriptor is nil (caller must validate)")
@@ -238,28 +249,53 @@ func (p *AWSPublicPlugin) getProjectedForResource(traceID string, resource *pbc.
 		resolver = newServiceResolver(resource.ResourceType)
 	}
 	serviceType := resolver.ServiceType()
+	if p.serviceDisabled(serviceType) {
+		return nil, p.newErrorWithID(traceID, codes.Unimplemented,
+			fmt.Sprintf("service %q is disabled in this deployment", serviceType),
+			pbc.ErrorCode_ERROR_CODE_UNSPECIFIED)
+	}
 
 	// Route to appropriate estimator based on normalized resource type.
 	// For GetActualCost, we construct a minimal request with just the resource.
 	// This means UtilizationPercentage is 0, which falls through to default (50%).
 	switch serviceType {
 	case "ec2":
-		return p.estimateEC2(traceID, resource, &pbc.GetProjectedCostRequest{Resource: resource})
+		return p.estimateEC2(traceID, resource, &pbc.GetProjectedCostRequest{Resource: resource}, nil, nil)
 	case "ebs":
-		return p.estimateEBS(traceID, resource)
+		return p.estimateEBS(traceID, resource, nil, nil)
 	case "eks":
-		return p.estimateEKS(traceID, resource)
+		return p.estimateEKS(traceID, resource, nil)
 	case "elb":
-		return p.estimateELB(traceID, resource)
+		return p.estimateELB(traceID, resource, nil)
 	case "natgw":
-		return p.estimateNATGateway(traceID, resource)
+		return p.estimateNATGateway(traceID, resource, nil)
 	case "cloudwatch":
-		return p.estimateCloudWatch(traceID, resource)
+		return p.estimateCloudWatch(traceID, resource, nil)
 	case "elasticache":
-		return p.estimateElastiCache(traceID, resource)
+		return p.estimateElastiCache(traceID, resource, nil)
+	case "ecr":
+		return p.estimateECR(traceID, resource, nil, nil)
+	case "secretsmanager":
+		return p.estimateSecretsManager(traceID, resource, nil)
+	case "kms":
+		return p.estimateKMS(traceID, resource, nil, nil)
+	case "waf":
+		return p.estimateWAF(traceID, resource, nil, nil)
+	case "athena":
+		return p.estimateAthena(traceID, resource, nil, nil)
+	case "glue":
+		return p.estimateGlue(traceID, resource, nil, nil)
+	case "ses":
+		return p.estimateSES(traceID, resource, nil, nil)
+	case "stepfunctions":
+		return p.estimateStepFunctions(traceID, resource, nil, nil)
 	case "s3", "lambda", "rds", "dynamodb":
-		return p.estimateStub(resource)
+		// Usage-based actual costs are not derived yet; keep the $0 placeholder
+		return p.estimateStub(ctx, traceID, resource)
 	default:
+		if p.supportLevel(serviceType) == SupportLevelStub {
+			return p.estimateStub(ctx, traceID, resource)
+		}
 		// Unknown resource type - return $0 with explanation
 		return &pbc.GetProjectedCostResponse{
 			CostPerMonth:  0,
@@ -3,6 +3,7 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -60,6 +61,11 @@ func (m *mockPricingClientActual) LambdaPricePerGBSecond(arch string) (float64,
 	return price, ok
 }
 
+func (m *mockPricingClientActual) LambdaHasARMPricing() bool {
+	_, found := m.lambdaPrices["gb-second-arm64"]
+	return found
+}
+
 func (m *mockPricingClientActual) DynamoDBOnDemandReadPrice() (float64, bool) {
 	return 0.25 / 1_000_000, true
 }
@@ -85,16 +91,68 @@ func (m *mockPricingClientActual) EC2OnDemandPricePerHour(instanceType, _, _ str
 	return price, ok
 }
 
+func (m *mockPricingClientActual) EC2NetworkPerformance(_ string) (string, bool) {
+	return "", false
+}
+
+func (m *mockPricingClientActual) EC2InstanceTypes() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) EBSVolumeTypes() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) S3StorageClasses() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) RDSInstanceTypes() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) ElastiCacheNodeTypes() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) EC2InterAZDataTransferPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) EBSSnapshotPricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
 func (m *mockPricingClientActual) EBSPricePerGBMonth(volumeType string) (float64, bool) {
 	price, ok := m.ebsPrices[volumeType]
 	return price, ok
 }
 
+func (m *mockPricingClientActual) EBSIOPSPricePerMonth(_ string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) EBSIOPSTiers(_ string) ([]pricing.TierRate, bool) {
+	return nil, false
+}
+
+func (m *mockPricingClientActual) EBSThroughputPricePerMonth(_ string) (float64, bool) {
+	return 0, false
+}
+
 func (m *mockPricingClientActual) S3PricePerGBMonth(storageClass string) (float64, bool) {
 	price, ok := m.s3Prices[storageClass]
 	return price, ok
 }
 
+func (m *mockPricingClientActual) S3IntelligentTieringMonitoringPricePerObject() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) S3RetrievalPricePerGB(_ string) (float64, bool) {
+	return 0, false
+}
+
 func (m *mockPricingClientActual) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
 	if m.rdsInstancePrices == nil {
 		return 0, false
@@ -112,6 +170,18 @@ func (m *mockPricingClientActual) RDSStoragePricePerGBMonth(volumeType string) (
 	return price, ok
 }
 
+func (m *mockPricingClientActual) RDSReservedPricePerHour(instanceType, engine string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) RDSAuroraIOOptimizedPricePerHour(instanceType, engine string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) RDSAuroraIORequestPrice() (float64, bool) {
+	return 0, false
+}
+
 func (m *mockPricingClientActual) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
 	if extendedSupport {
 		return 0.50, true // Extended EKS rate
@@ -119,6 +189,22 @@ func (m *mockPricingClientActual) EKSClusterPricePerHour(extendedSupport bool) (
 	return 0.10, true // Standard EKS rate
 }
 
+func (m *mockPricingClientActual) PricingMetadata(offerCode string) (string, string, bool) {
+	return "", "", false
+}
+
+func (m *mockPricingClientActual) SchemaDrift() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) PriceInconsistencies() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) EKSFargatePricePerHour() (float64, float64, bool) {
+	return 0, 0, false
+}
+
 func (m *mockPricingClientActual) ALBPricePerHour() (float64, bool) {
 	return 0.0225, true
 }
@@ -135,6 +221,14 @@ func (m *mockPricingClientActual) NLBPricePerNLCU() (float64, bool) {
 	return 0.006, true
 }
 
+func (m *mockPricingClientActual) ELBOutpostsPricePerHour(lbType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) ELBOutpostsPricePerCapacityUnit(lbType string) (float64, bool) {
+	return 0, false
+}
+
 func (m *mockPricingClientActual) NATGatewayPrice() (*pricing.NATGatewayPrice, bool) {
 	if m.natgwHourlyPrice > 0 {
 		return &pricing.NATGatewayPrice{
@@ -163,6 +257,74 @@ func (m *mockPricingClientActual) ElastiCacheOnDemandPricePerHour(instanceType,
 	return 0.156, true // Default cache.m5.large pricing
 }
 
+func (m *mockPricingClientActual) ECRStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) ECRDataTransferOutPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SecretsManagerPricePerSecret() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SecretsManagerPricePerAPICall() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) KMSPricePerKey() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) KMSPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerWebACL() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerRule() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) AthenaPricePerTBScanned() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) GluePricePerDPUHour(jobType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SESPricePerEmail() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SESPricePerAttachmentGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) StepFunctionsPricePerTransition() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) StepFunctionsExpressPricing() (float64, float64, bool) {
+	return 0, 0, false
+}
+
+func (m *mockPricingClientActual) Vintages() []string {
+	return nil
+}
+
+func (m *mockPricingClientActual) ForVintage(vintage string) (pricing.PricingClient, bool) {
+	return m, vintage == "" || vintage == pricing.LatestVintage
+}
+
 func newTestPluginForActual() *AWSPublicPlugin {
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
 	return NewAWSPublicPlugin("us-east-1", "test-version", &mockPricingClientActual{
@@ -366,6 +528,118 @@ func TestCalculateRuntimeHours(t *testing.T) {
 	}
 }
 
+// TestHoursBetween verifies elapsed hours follow real time across DST changes and
+// calendar months rather than wall-clock differences or a fixed month length.
+func TestHoursBetween(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	edt := time.FixedZone("EDT", -4*60*60)
+
+	tests := []struct {
+		name      string
+		start     time.Time
+		end       time.Time
+		wantHours float64
+	}{
+		{
+			name:      "spring forward day is 23 hours",
+			start:     time.Date(2025, 3, 9, 0, 0, 0, 0, est),
+			end:       time.Date(2025, 3, 10, 0, 0, 0, 0, edt),
+			wantHours: 23,
+		},
+		{
+			name:      "fall back day is 25 hours",
+			start:     time.Date(2025, 11, 2, 0, 0, 0, 0, edt),
+			end:       time.Date(2025, 11, 3, 0, 0, 0, 0, est),
+			wantHours: 25,
+		},
+		{
+			name:      "partial month",
+			start:     time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC),
+			end:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 444,
+		},
+		{
+			name:      "full February is 672 hours, not 730",
+			start:     time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 672,
+		},
+		{
+			name:      "mixed zones",
+			start:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2025, 1, 1, 0, 0, 0, 0, est),
+			wantHours: 5,
+		},
+		{
+			name:      "reversed range is negative",
+			start:     time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			end:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: -24,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hoursBetween(tt.start, tt.end); got != tt.wantHours {
+				t.Errorf("hoursBetween() = %v, want %v", got, tt.wantHours)
+			}
+		})
+	}
+}
+
+// TestGetActualCost_DSTAndPartialMonth verifies prorated actual cost uses the elapsed
+// hours of windows that cross a DST change or cover part of a month.
+func TestGetActualCost_DSTAndPartialMonth(t *testing.T) {
+	plugin := newTestPluginForActual()
+	est := time.FixedZone("EST", -5*60*60)
+	edt := time.FixedZone("EDT", -4*60*60)
+
+	tests := []struct {
+		name      string
+		from      time.Time
+		to        time.Time
+		wantHours float64
+	}{
+		{
+			name:      "week crossing spring forward",
+			from:      time.Date(2025, 3, 6, 0, 0, 0, 0, est),
+			to:        time.Date(2025, 3, 13, 0, 0, 0, 0, edt),
+			wantHours: 167,
+		},
+		{
+			name:      "partial month",
+			from:      time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC),
+			to:        time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantHours: 444,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetActualCost(context.Background(), &pbc.GetActualCostRequest{
+				ResourceId: makeResourceJSON("aws", "ec2", "t3.micro", "us-east-1", nil),
+				Start:      timestamppb.New(tt.from),
+				End:        timestamppb.New(tt.to),
+			})
+			if err != nil {
+				t.Fatalf("GetActualCost() unexpected error: %v", err)
+			}
+			if len(resp.Results) == 0 {
+				t.Fatal("GetActualCost() returned empty results")
+			}
+
+			// $0.0104/hr * 730 hrs = $7.592/month, prorated by elapsed hours
+			wantCost := 7.592 * tt.wantHours / 730
+			if got := resp.Results[0].Cost; math.Abs(got-wantCost) > 0.0001 {
+				t.Errorf("cost = %v, want %v (%v hours)", got, wantCost, tt.wantHours)
+			}
+			if got := resp.Results[0].UsageAmount; got != tt.wantHours {
+				t.Errorf("usage amount = %v, want %v", got, tt.wantHours)
+			}
+		})
+	}
+}
+
 // TestGetActualCostEC2 tests actual cost calculation for EC2 instances.
 func TestGetActualCostEC2(t *testing.T) {
 	plugin := newTestPluginForActual()
@@ -0,0 +1,76 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// assumptionsHeaderKey lists the inputs an estimate defaulted instead of reading from the
+// request. GetProjectedCostResponse has no assumptions field, so each Assumption is sent
+// as one JSON-encoded value of this response header, mirroring the batch warnings trailer.
+const assumptionsHeaderKey = "finfocus-assumptions"
+
+// Reasons reported with an Assumption.
+const (
+	assumptionNotSet  = "not set"
+	assumptionInvalid = "invalid or unsupported value"
+)
+
+// Assumption is one input an estimator defaulted. Key names the tag (or "sku") that
+// would have supplied it, Value is the value used, and Reason says why it was defaulted.
+// The same assumptions stay in billing_detail as prose for humans.
+type Assumption struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// assumptions collects an estimate's defaulted inputs. A nil collector discards them,
+// so callers that do not report assumptions (e.g. GetActualCost) can pass nil.
+type assumptions struct {
+	items []Assumption
+}
+
+// add records a defaulted input. It is a no-op on a nil collector.
+func (a *assumptions) add(key, value, reason string) {
+	if a == nil {
+		return
+	}
+	a.items = append(a.items, Assumption{Key: key, Value: value, Reason: reason})
+}
+
+// setAssumptionsHeader sends the collected assumptions as response headers. It is a
+// no-op outside a gRPC server stream or when nothing was defaulted.
+func (p *AWSPublicPlugin) setAssumptionsHeader(ctx context.Context, traceID string, a *assumptions) {
+	if a == nil || len(a.items) == 0 || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+
+	md := metadata.MD{}
+	for _, item := range a.items {
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		md.Append(assumptionsHeaderKey, string(encoded))
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set assumptions header")
+	}
+}
+
+// tagAssumptionReason reports why a tag-driven input was defaulted: the tag was
+// either absent or present with a value the estimator could not use.
+func tagAssumptionReason(tags map[string]string, key string) string {
+	if _, ok := tags[key]; ok {
+		return assumptionInvalid
+	}
+	return assumptionNotSet
+}
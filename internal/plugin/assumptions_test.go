@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_AssumptionsHeader verifies defaulted inputs are reported as
+// structured assumptions alongside the billing detail prose.
+func TestGetProjectedCost_AssumptionsHeader(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		want         []Assumption
+	}{
+		{
+			name:         "ebs size not set",
+			resourceType: "ebs",
+			sku:          "gp2",
+			want:         []Assumption{{Key: "size", Value: "8", Reason: assumptionNotSet}},
+		},
+		{
+			name:         "ebs size invalid",
+			resourceType: "ebs",
+			sku:          "gp2",
+			tags:         map[string]string{"size": "large"},
+			want:         []Assumption{{Key: "size", Value: "8", Reason: assumptionInvalid}},
+		},
+		{
+			name:         "ebs all inputs set",
+			resourceType: "ebs",
+			sku:          "gp2",
+			tags:         map[string]string{"size": "100"},
+		},
+		{
+			name:         "lambda defaults",
+			resourceType: "lambda",
+			sku:          "512",
+			tags:         map[string]string{"requests_per_month": "1000", "avg_duration_ms": "-5"},
+			want: []Assumption{
+				{Key: "avg_duration_ms", Value: "100", Reason: assumptionInvalid},
+				{Key: "arch", Value: "x86_64", Reason: assumptionNotSet},
+			},
+		},
+		{
+			name:         "glue execution class invalid",
+			resourceType: "glue",
+			sku:          "turbo",
+			tags:         map[string]string{"dpu_hours": "10"},
+			want:         []Assumption{{Key: "sku", Value: "standard", Reason: assumptionInvalid}},
+		},
+		{
+			name:         "zero-cost resource",
+			resourceType: "vpc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp2"] = 0.10
+			mock.lambdaPrices["request"] = 0.0000002
+			mock.lambdaPrices["gb-second"] = 0.0000166667
+			mock.glueDPUHourPrices["etl"] = 0.44
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			_, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			values := stream.header.Get(assumptionsHeaderKey)
+			if len(values) != len(tt.want) {
+				t.Fatalf("header %s = %v, want %d values", assumptionsHeaderKey, values, len(tt.want))
+			}
+			for i, v := range values {
+				var got Assumption
+				if err := json.Unmarshal([]byte(v), &got); err != nil {
+					t.Fatalf("header value %q is not JSON: %v", v, err)
+				}
+				if got != tt.want[i] {
+					t.Errorf("assumption[%d] = %+v, want %+v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSetAssumptionsHeader_NoStream verifies the header is skipped outside a gRPC stream.
+func TestSetAssumptionsHeader_NoStream(t *testing.T) {
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+	a := &assumptions{}
+	a.add("size", "8", assumptionNotSet)
+	plugin.setAssumptionsHeader(context.Background(), "trace", a)
+
+	var nilCollector *assumptions
+	nilCollector.add("size", "8", assumptionNotSet)
+}
@@ -0,0 +1,78 @@
+package plugin
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// carbonCoverageMetadataKey is the GetPluginInfo metadata key carrying the JSON-encoded
+// carbonCoverage of the embedded EC2 pricing.
+const carbonCoverageMetadataKey = "carbon_coverage"
+
+// maxLoggedCarbonGaps caps how many uncovered instance types the init check logs.
+const maxLoggedCarbonGaps = 20
+
+// carbonCoverage summarizes how many pricing-indexed EC2 instance types have a CCF
+// instance spec, so gaps (e.g. families newer than the embedded CSV) show when the
+// carbon data needs regenerating. Types without a spec still get a cost estimate, but
+// no carbon footprint.
+type carbonCoverage struct {
+	InstanceTypes   int     `json:"instance_types"`
+	Missing         int     `json:"missing"`
+	CoveragePercent float64 `json:"coverage_percent"`
+	missingTypes    []string
+}
+
+// checkCarbonCoverage compares the EC2 instance types in the pricing data against the
+// embedded CCF instance specs and logs the result. A nil client or empty pricing yields
+// the zero value.
+func checkCarbonCoverage(pricingClient pricing.PricingClient, logger zerolog.Logger) carbonCoverage {
+	var cov carbonCoverage
+	if pricingClient == nil {
+		return cov
+	}
+	for _, instanceType := range pricingClient.EC2InstanceTypes() {
+		cov.InstanceTypes++
+		if _, ok := carbon.GetInstanceSpec(instanceType); !ok {
+			cov.missingTypes = append(cov.missingTypes, instanceType)
+		}
+	}
+	if cov.InstanceTypes == 0 {
+		return cov
+	}
+	cov.Missing = len(cov.missingTypes)
+	covered := float64(cov.InstanceTypes-cov.Missing) / float64(cov.InstanceTypes) * 100
+	cov.CoveragePercent = math.Round(covered*10) / 10
+
+	if cov.Missing == 0 {
+		logger.Debug().
+			Int("instance_types", cov.InstanceTypes).
+			Msg("carbon instance specs cover every priced EC2 instance type")
+		return cov
+	}
+	logger.Warn().
+		Int("instance_types", cov.InstanceTypes).
+		Int("missing", cov.Missing).
+		Float64("coverage_percent", cov.CoveragePercent).
+		Strs("missing_sample", cov.missingTypes[:min(cov.Missing, maxLoggedCarbonGaps)]).
+		Msg("priced EC2 instance types without CCF carbon specs; regenerate carbon data")
+	return cov
+}
+
+// json returns the coverage encoded for GetPluginInfo metadata, or "" when no instance
+// types were checked.
+func (c carbonCoverage) json() string {
+	if c.InstanceTypes == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
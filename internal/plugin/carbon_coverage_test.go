@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// TestCheckCarbonCoverage verifies priced instance types without a CCF spec are counted
+// and reported in GetPluginInfo metadata.
+func TestCheckCarbonCoverage(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	mock.ec2Prices["t3.micro/Windows/Shared"] = 0.0196
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["zz9.large/Linux/Shared"] = 0.5 // family newer than the CCF data
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	want := carbonCoverage{InstanceTypes: 3, Missing: 1, CoveragePercent: 66.7}
+	got := plugin.carbonCoverage
+	if got.InstanceTypes != want.InstanceTypes || got.Missing != want.Missing ||
+		got.CoveragePercent != want.CoveragePercent {
+		t.Errorf("carbonCoverage = %+v, want %+v", got, want)
+	}
+	if len(got.missingTypes) != 1 || got.missingTypes[0] != "zz9.large" {
+		t.Errorf("missing types = %v, want [zz9.large]", got.missingTypes)
+	}
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() error: %v", err)
+	}
+	var reported carbonCoverage
+	if err := json.Unmarshal([]byte(resp.Metadata[carbonCoverageMetadataKey]), &reported); err != nil {
+		t.Fatalf("metadata %s = %q, not JSON: %v", carbonCoverageMetadataKey, resp.Metadata[carbonCoverageMetadataKey], err)
+	}
+	if reported.InstanceTypes != 3 || reported.Missing != 1 || reported.CoveragePercent != 66.7 {
+		t.Errorf("reported coverage = %+v, want %+v", reported, want)
+	}
+}
+
+// TestCheckCarbonCoverage_NoPricing verifies the check is skipped without EC2 pricing.
+func TestCheckCarbonCoverage_NoPricing(t *testing.T) {
+	if got := checkCarbonCoverage(nil, zerolog.Nop()); got.InstanceTypes != 0 || got.json() != "" {
+		t.Errorf("checkCarbonCoverage(nil) = %+v, want the zero value", got)
+	}
+
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() error: %v", err)
+	}
+	if val, ok := resp.Metadata[carbonCoverageMetadataKey]; ok {
+		t.Errorf("metadata %s = %q, want omitted", carbonCoverageMetadataKey, val)
+	}
+}
+
+// TestCheckCarbonCoverage_EmbeddedData runs the check against the binary's embedded
+// pricing and CCF data and expects most priced instance types to have carbon specs.
+func TestCheckCarbonCoverage_EmbeddedData(t *testing.T) {
+	client, err := pricing.NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("pricing.NewClient() error: %v", err)
+	}
+
+	cov := checkCarbonCoverage(client, zerolog.Nop())
+	if cov.InstanceTypes == 0 {
+		t.Fatal("no EC2 instance types checked, want the embedded pricing's types")
+	}
+	if cov.CoveragePercent < 50 || cov.CoveragePercent > 100 {
+		t.Errorf("coverage = %.1f%%, want a plausible 50-100%% (missing %d of %d: %v)",
+			cov.CoveragePercent, cov.Missing, cov.InstanceTypes, cov.missingTypes)
+	}
+	t.Logf("carbon coverage: %d/%d instance types (%.1f%%)",
+		cov.InstanceTypes-cov.Missing, cov.InstanceTypes, cov.CoveragePercent)
+}
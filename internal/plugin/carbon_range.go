@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// EnvEmitCarbonRange enables low/high bounds for EC2 carbon estimates, derived from
+	// the CCF idle and full-load power of the instance type.
+	EnvEmitCarbonRange = "FINFOCUS_EMIT_CARBON_RANGE"
+
+	// carbonLowHeaderKey and carbonHighHeaderKey carry the carbon bounds in gCO2e as gRPC
+	// response headers. ImpactMetric has no range fields, and a second carbon metric
+	// would be summed by clients that total metrics, so the bounds are sent out-of-band.
+	carbonLowHeaderKey  = "finfocus-carbon-low-gco2e"
+	carbonHighHeaderKey = "finfocus-carbon-high-gco2e"
+)
+
+// carbonRange collects the bounds of an estimate's carbon footprint. Estimators record
+// them as fractions of the point estimate, so the grid_factor and resource_count scaling
+// later applied to the metric carries over to the bounds. A nil collector discards them,
+// like assumptions.
+type carbonRange struct {
+	lowRatio, highRatio float64
+	low, high           float64
+	ok                  bool
+}
+
+// set records the idle (low) and full-load (high) carbon of an estimate whose point
+// value is point, all in gCO2e. It is a no-op on a nil collector or a zero estimate.
+func (c *carbonRange) set(point, low, high float64) {
+	if c == nil || point <= 0 {
+		return
+	}
+	c.lowRatio = low / point
+	c.highRatio = high / point
+	c.ok = true
+}
+
+// resolve converts the recorded fractions to grams from resp's carbon metric. Call it
+// after every adjustment to the metric and before display rounding.
+func (c *carbonRange) resolve(resp *pbc.GetProjectedCostResponse) {
+	if c == nil || !c.ok {
+		return
+	}
+	for _, m := range resp.GetImpactMetrics() {
+		if m.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT && m.GetUnit() != carbonUnavailableUnit {
+			c.low = m.GetValue() * c.lowRatio
+			c.high = m.GetValue() * c.highRatio
+			return
+		}
+	}
+	c.ok = false
+}
+
+// setCarbonRangeHeader sends the resolved carbon bounds as response headers at full
+// precision when EnvEmitCarbonRange is enabled. It is a no-op outside a gRPC server
+// stream or when the estimate has no range.
+func (p *AWSPublicPlugin) setCarbonRangeHeader(ctx context.Context, traceID string, bounds *carbonRange) {
+	if !p.features.EmitCarbonRange || bounds == nil || !bounds.ok || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+
+	md := metadata.Pairs(
+		carbonLowHeaderKey, strconv.FormatFloat(bounds.low, 'f', -1, 64),
+		carbonHighHeaderKey, strconv.FormatFloat(bounds.high, 'f', -1, 64),
+	)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set carbon range header")
+	}
+}
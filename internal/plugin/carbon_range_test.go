@@ -0,0 +1,109 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_CarbonRangeHeader verifies the carbon bounds are the idle and
+// full-load footprints, bracket the point estimate, follow resource_count scaling, and
+// are only sent when enabled.
+func TestGetProjectedCost_CarbonRangeHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled string
+		tags    map[string]string
+		scale   float64
+	}{
+		{name: "disabled", enabled: ""},
+		{name: "enabled", enabled: "true", scale: 1},
+		{name: "resource count", enabled: "true", tags: map[string]string{"count": "3"}, scale: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvEmitCarbonRange, tt.enabled)
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "m5.large",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if len(resp.ImpactMetrics) != 1 {
+				t.Fatalf("got %d impact metrics, want only the carbon point estimate", len(resp.ImpactMetrics))
+			}
+
+			lowValues := stream.header.Get(carbonLowHeaderKey)
+			highValues := stream.header.Get(carbonHighHeaderKey)
+			if tt.enabled == "" {
+				if len(lowValues) != 0 || len(highValues) != 0 {
+					t.Errorf("carbon range headers = %v, %v, want none when disabled", lowValues, highValues)
+				}
+				return
+			}
+			if len(lowValues) != 1 || len(highValues) != 1 {
+				t.Fatalf("carbon range headers = %v, %v, want one value each", lowValues, highValues)
+			}
+			low, err := strconv.ParseFloat(lowValues[0], 64)
+			if err != nil {
+				t.Fatalf("low bound is not a number: %q", lowValues[0])
+			}
+			high, err := strconv.ParseFloat(highValues[0], 64)
+			if err != nil {
+				t.Fatalf("high bound is not a number: %q", highValues[0])
+			}
+
+			estimator := carbon.NewEstimator()
+			idle, _ := estimator.EstimateCarbonGrams("m5.large", "us-east-1", 0, HoursPerMonthProd)
+			full, _ := estimator.EstimateCarbonGrams("m5.large", "us-east-1", 1, HoursPerMonthProd)
+			if math.Abs(low-idle*tt.scale) > 0.001 {
+				t.Errorf("low bound = %v, want %v", low, idle*tt.scale)
+			}
+			if math.Abs(high-full*tt.scale) > 0.001 {
+				t.Errorf("high bound = %v, want %v", high, full*tt.scale)
+			}
+			if point := resp.ImpactMetrics[0].Value; point < low || point > high {
+				t.Errorf("carbon estimate %v outside range [%v, %v]", point, low, high)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_CarbonRangeUnknownInstance verifies no range is sent when the
+// instance type has no carbon data.
+func TestGetProjectedCost_CarbonRangeUnknownInstance(t *testing.T) {
+	t.Setenv(EnvEmitCarbonRange, "true")
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["zz9.large/Linux/Shared"] = 0.1
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if _, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "zz9.large", Region: "us-east-1"},
+	}); err != nil {
+		t.Fatalf("GetProjectedCost() returned error: %v", err)
+	}
+	if values := stream.header.Get(carbonLowHeaderKey); len(values) != 0 {
+		t.Errorf("header %s = %v, want none without carbon data", carbonLowHeaderKey, values)
+	}
+}
@@ -80,6 +80,46 @@ var serviceClassifications = map[string]ServiceClassification{
 		ParentType:        "aws:ec2:vpc:Vpc",
 		Relationship:      RelationshipWithin,
 	},
+	"aws:ecr:repository": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_LINEAR,
+		AffectedByDevMode: false, // Storage is not time-based
+		ParentTagKeys:     nil,
+	},
+	"aws:secretsmanager:secret": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Flat monthly rate plus usage
+		ParentTagKeys:     nil,
+	},
+	"aws:kms:key": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Flat monthly rate plus usage
+		ParentTagKeys:     nil,
+	},
+	"aws:wafv2:webacl": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Flat monthly rates plus usage
+		ParentTagKeys:     nil,
+	},
+	"aws:athena:workgroup": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Billed per TB scanned, not by the hour
+		ParentTagKeys:     nil,
+	},
+	"aws:glue:job": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Billed per DPU-hour consumed
+		ParentTagKeys:     nil,
+	},
+	"aws:ses:email": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Billed per email sent
+		ParentTagKeys:     nil,
+	},
+	"aws:sfn:statemachine": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Billed per state transition or request
+		ParentTagKeys:     nil,
+	},
 	"aws:rds:instance": {
 		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
 		AffectedByDevMode: true, // Instance hours
@@ -0,0 +1,22 @@
+package plugin
+
+import "time"
+
+// clock is the time source for request duration logging. The plugin uses wallClock;
+// tests substitute a fake to assert exact duration_ms values.
+type clock interface {
+	Now() time.Time
+}
+
+// wallClock reads the system clock.
+type wallClock struct{}
+
+// Now returns the current system time.
+func (wallClock) Now() time.Time {
+	return time.Now()
+}
+
+// since returns the time elapsed since start according to the plugin's clock.
+func (p *AWSPublicPlugin) since(start time.Time) time.Duration {
+	return p.clock.Now().Sub(start)
+}
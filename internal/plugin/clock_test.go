@@ -0,0 +1,34 @@
+package plugin
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock is a deterministic clock for tests. Each Now call returns the current
+// time and then advances it by step, so a start/end pair measures exactly step.
+type fakeClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+// newFakeClock returns a fakeClock starting at a fixed instant that advances by step.
+func newFakeClock(step time.Duration) *fakeClock {
+	return &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), step: step}
+}
+
+func (c *fakeClock) Now() time.Time {
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+// TestPluginSince verifies elapsed time is measured with the injected clock.
+func TestPluginSince(t *testing.T) {
+	plugin := &AWSPublicPlugin{clock: newFakeClock(250 * time.Millisecond)}
+
+	start := plugin.clock.Now()
+	if got := plugin.since(start); got != 250*time.Millisecond {
+		t.Errorf("since() = %v, want 250ms", got)
+	}
+}
@@ -0,0 +1,75 @@
+package plugin
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EnvSuggestClosestSKU enables naming the nearest priced size of the same family in the
+// billing detail when an EC2 instance type is not found, e.g.
+// `EC2 instance type "m5.20xlarge" not found in pricing data; did you mean m5.16xlarge?`.
+// The $0 response is otherwise unchanged.
+const EnvSuggestClosestSKU = "FINFOCUS_SUGGEST_CLOSEST_SKU"
+
+// baseSizeUnits gives the relative capacity of the named EC2 sizes, following AWS's
+// normalization factors. "Nxlarge" sizes are N times xlarge.
+var baseSizeUnits = map[string]float64{
+	"nano":   0.25,
+	"micro":  0.5,
+	"small":  1,
+	"medium": 2,
+	"large":  4,
+	"xlarge": 8,
+}
+
+// instanceSizeUnits returns the relative capacity of an EC2 size. ok is false for sizes
+// without a fixed capacity (e.g. "metal") and unrecognized ones.
+func instanceSizeUnits(size string) (units float64, ok bool) {
+	if units, ok := baseSizeUnits[size]; ok {
+		return units, true
+	}
+	multiple, found := strings.CutSuffix(size, "xlarge")
+	if !found {
+		return 0, false
+	}
+	n, err := strconv.Atoi(multiple)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return baseSizeUnits["xlarge"] * float64(n), true
+}
+
+// closestInstanceType returns the priced instance type in instanceType's family whose
+// size is nearest, on a log scale, to the requested one, for the given OS and tenancy.
+// Ties go to the smaller size. Returns "" when the family has no priced sizes or the
+// requested size has no fixed capacity.
+func (p *AWSPublicPlugin) closestInstanceType(instanceType, os, tenancy string) string {
+	family, size := parseInstanceType(instanceType)
+	target, ok := instanceSizeUnits(size)
+	if !ok {
+		return ""
+	}
+
+	best := ""
+	bestUnits, bestDistance := 0.0, math.Inf(1)
+	for _, candidate := range p.pricing.EC2InstanceTypes() {
+		candidateFamily, candidateSize := parseInstanceType(candidate)
+		if candidateFamily != family || candidate == instanceType {
+			continue
+		}
+		units, ok := instanceSizeUnits(candidateSize)
+		if !ok {
+			continue
+		}
+		distance := math.Abs(math.Log(units / target))
+		if distance > bestDistance || (distance == bestDistance && units >= bestUnits) {
+			continue
+		}
+		if _, found := p.pricing.EC2OnDemandPricePerHour(candidate, os, tenancy); !found {
+			continue
+		}
+		best, bestUnits, bestDistance = candidate, units, distance
+	}
+	return best
+}
@@ -0,0 +1,120 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestInstanceSizeUnits verifies EC2 sizes map to AWS normalization factors.
+func TestInstanceSizeUnits(t *testing.T) {
+	tests := []struct {
+		size   string
+		want   float64
+		wantOK bool
+	}{
+		{"nano", 0.25, true},
+		{"large", 4, true},
+		{"xlarge", 8, true},
+		{"24xlarge", 192, true},
+		{"metal", 0, false},
+		{"0xlarge", 0, false},
+		{"huge", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := instanceSizeUnits(tt.size)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("instanceSizeUnits(%q) = (%v, %v), want (%v, %v)", tt.size, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+// TestGetProjectedCost_EC2ClosestSKU verifies unknown instance types name the nearest
+// priced size of the same family only when FINFOCUS_SUGGEST_CLOSEST_SKU is enabled.
+func TestGetProjectedCost_EC2ClosestSKU(t *testing.T) {
+	tests := []struct {
+		name       string
+		flag       string
+		sku        string
+		tags       map[string]string
+		wantDetail string
+	}{
+		{
+			name:       "disabled by default",
+			sku:        "m5.32xlarge",
+			wantDetail: `EC2 instance type "m5.32xlarge" not found in pricing data`,
+		},
+		{
+			name:       "larger than any priced size",
+			flag:       "true",
+			sku:        "m5.32xlarge",
+			wantDetail: `EC2 instance type "m5.32xlarge" not found in pricing data; did you mean m5.16xlarge?`,
+		},
+		{
+			name:       "smaller than any priced size",
+			flag:       "true",
+			sku:        "m5.nano",
+			wantDetail: `EC2 instance type "m5.nano" not found in pricing data; did you mean m5.large?`,
+		},
+		{
+			name:       "equidistant sizes prefer the smaller",
+			flag:       "true",
+			sku:        "m5.2xlarge",
+			wantDetail: `EC2 instance type "m5.2xlarge" not found in pricing data; did you mean m5.xlarge?`,
+		},
+		{
+			name:       "only sizes priced for the OS are suggested",
+			flag:       "true",
+			sku:        "m5.12xlarge",
+			tags:       map[string]string{"platform": "windows"},
+			wantDetail: `EC2 instance type "m5.12xlarge" not found in pricing data; did you mean m5.xlarge?`,
+		},
+		{
+			name:       "unknown family",
+			flag:       "true",
+			sku:        "zz9.large",
+			wantDetail: `EC2 instance type "zz9.large" not found in pricing data`,
+		},
+		{
+			name:       "size without fixed capacity",
+			flag:       "true",
+			sku:        "m5.metal",
+			wantDetail: `EC2 instance type "m5.metal" not found in pricing data`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvSuggestClosestSKU, tt.flag)
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+			mock.ec2Prices["m5.xlarge/Linux/Shared"] = 0.192
+			mock.ec2Prices["m5.xlarge/Windows/Shared"] = 0.376
+			mock.ec2Prices["m5.4xlarge/Linux/Shared"] = 0.768
+			mock.ec2Prices["m5.16xlarge/Linux/Shared"] = 3.072
+			mock.ec2Prices["c5.32xlarge/Linux/Shared"] = 5.0 // other family, never suggested
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if resp.CostPerMonth != 0 {
+				t.Errorf("CostPerMonth = %v, want 0", resp.CostPerMonth)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
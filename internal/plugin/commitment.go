@@ -0,0 +1,173 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// committedCoverageMetadataKey is the gRPC request metadata key carrying the fraction
+	// (0 to 1) of the account's compute spend covered by a commitment such as an EDP or
+	// Savings Plan. GetRecommendationsRequest has no field for it. Defaults to 0 (all
+	// On-Demand).
+	committedCoverageMetadataKey = "finfocus-committed-coverage"
+
+	// committedDiscountMetadataKey is the gRPC request metadata key carrying the discount
+	// (0 to <1) off On-Demand for the covered portion. Defaults to defaultCommittedDiscount.
+	committedDiscountMetadataKey = "finfocus-committed-discount"
+
+	// defaultCommittedDiscount is the assumed discount on committed compute when the
+	// request does not state one, in line with typical 1-year no-upfront Savings Plans.
+	defaultCommittedDiscount = 0.20
+
+	// committedCoverageTrailerKey is the gRPC trailer key disclosing the coverage, discount
+	// and blended rate applied to a batch, with its compute totals. RecommendationSummary
+	// has no field for it.
+	committedCoverageTrailerKey = "finfocus-committed-coverage"
+)
+
+// commitmentCoverage describes the account-level commitment applied to a batch. The
+// zero value means no coverage: every rate stays On-Demand.
+type commitmentCoverage struct {
+	Coverage float64 // fraction of compute spend covered, 0 to 1
+	Discount float64 // discount off On-Demand for the covered fraction, 0 to <1
+}
+
+// blendedRate returns the blended compute rate as a fraction of On-Demand: the covered
+// fraction at the discounted rate plus the uncovered fraction at On-Demand.
+func (c commitmentCoverage) blendedRate() float64 {
+	return c.Coverage*(1-c.Discount) + (1 - c.Coverage)
+}
+
+// active reports whether the commitment changes any rate.
+func (c commitmentCoverage) active() bool {
+	return c.Coverage > 0 && c.Discount > 0
+}
+
+// commitmentFromContext reads the committed coverage and discount from the request
+// metadata. Missing or out-of-range values fall back to the defaults (no coverage,
+// defaultCommittedDiscount); invalid ones are returned as warnings.
+func commitmentFromContext(ctx context.Context) (commitmentCoverage, []string) {
+	c := commitmentCoverage{Discount: defaultCommittedDiscount}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return commitmentCoverage{}, nil
+	}
+
+	var warnings []string
+	if values := md.Get(committedCoverageMetadataKey); len(values) > 0 {
+		v, err := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+		if err == nil && v >= 0 && v <= 1 {
+			c.Coverage = v
+		} else {
+			warnings = append(warnings, fmt.Sprintf(
+				"invalid %s %q: must be between 0 and 1, assuming no coverage", committedCoverageMetadataKey, values[0]))
+		}
+	}
+	if values := md.Get(committedDiscountMetadataKey); len(values) > 0 {
+		v, err := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+		if err == nil && v >= 0 && v < 1 {
+			c.Discount = v
+		} else {
+			warnings = append(warnings, fmt.Sprintf("invalid %s %q: must be in [0, 1), using %.2f",
+				committedDiscountMetadataKey, values[0], defaultCommittedDiscount))
+		}
+	}
+	if c.Coverage == 0 {
+		return commitmentCoverage{}, warnings
+	}
+	return c, warnings
+}
+
+// isCommittedCompute reports whether a recommendation's costs are compute spend that the
+// commitment covers. Only EC2 instance recommendations qualify; cross-AZ transfer hints
+// price a separate charge.
+func isCommittedCompute(rec *pbc.Recommendation) bool {
+	return rec.GetImpact() != nil && rec.GetResource().GetResourceType() == "ec2" &&
+		rec.GetModify().GetModificationType() != modTypeCrossAZ
+}
+
+// apply reprices the compute recommendations of one resource at the blended rate and
+// returns the resource's On-Demand compute cost, counted once across its alternatives.
+// Spot targets are not covered by commitments, so their projected cost stays as is.
+// ok is false when no recommendation was repriced.
+func (c commitmentCoverage) apply(recs []*pbc.Recommendation) (onDemand float64, ok bool) {
+	if !c.active() {
+		return 0, false
+	}
+	rate := c.blendedRate()
+	for _, rec := range recs {
+		if !isCommittedCompute(rec) {
+			continue
+		}
+		impact := rec.Impact
+		onDemand = max(onDemand, impact.CurrentCost)
+		ok = true
+
+		modType := rec.GetModify().GetModificationType()
+		impact.CurrentCost *= rate
+		if modType != modTypeSpot && modType != modTypeGravitonSpot {
+			impact.ProjectedCost *= rate
+		}
+		impact.EstimatedSavings = max(impact.CurrentCost-impact.ProjectedCost, 0)
+		impact.SavingsPercentage = 0
+		if impact.CurrentCost > 0 {
+			impact.SavingsPercentage = impact.EstimatedSavings / impact.CurrentCost * 100
+		}
+
+		if rec.Metadata == nil {
+			rec.Metadata = make(map[string]string)
+		}
+		rec.Metadata["committed_coverage"] = strconv.FormatFloat(c.Coverage, 'f', -1, 64)
+		rec.Metadata["committed_blended_rate"] = fmt.Sprintf("%.4f", rate)
+	}
+	return onDemand, ok
+}
+
+// commitmentSummary is the JSON disclosed in the committedCoverageTrailerKey trailer.
+type commitmentSummary struct {
+	Coverage    float64 `json:"coverage"`
+	Discount    float64 `json:"discount"`
+	BlendedRate float64 `json:"blended_rate"`
+	// ComputeOnDemandCost and ComputeBlendedCost are the batch's monthly compute cost
+	// before and after blending, over resources with compute recommendations.
+	ComputeOnDemandCost float64 `json:"compute_on_demand_cost"`
+	ComputeBlendedCost  float64 `json:"compute_blended_cost"`
+	ComputeResources    int     `json:"compute_resources"`
+}
+
+// setCommitmentTrailer discloses the batch's commitment coverage and blended rate in the
+// gRPC response trailer. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setCommitmentTrailer(
+	ctx context.Context, traceID string, c commitmentCoverage, onDemand float64, resources int,
+) {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	encoded, err := json.Marshal(commitmentSummary{
+		Coverage:            c.Coverage,
+		Discount:            c.Discount,
+		BlendedRate:         c.blendedRate(),
+		ComputeOnDemandCost: onDemand,
+		ComputeBlendedCost:  onDemand * c.blendedRate(),
+		ComputeResources:    resources,
+	})
+	if err != nil {
+		p.logger.Warn().Str(pluginsdk.FieldTraceID, traceID).Err(err).Msg("failed to encode commitment summary")
+		return
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(committedCoverageTrailerKey, string(encoded))); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set committed coverage trailer")
+	}
+}
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestCommitmentCoverage_BlendedRate verifies the covered fraction is priced at the
+// discounted rate and the rest at On-Demand.
+func TestCommitmentCoverage_BlendedRate(t *testing.T) {
+	tests := []struct {
+		coverage, discount, want float64
+	}{
+		{0, 0.2, 1},
+		{1, 0.2, 0.8},
+		{0.5, 0.2, 0.9},
+		{0.75, 0.4, 0.7},
+	}
+	for _, tt := range tests {
+		c := commitmentCoverage{Coverage: tt.coverage, Discount: tt.discount}
+		if got := c.blendedRate(); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("blendedRate(coverage=%v, discount=%v) = %v, want %v", tt.coverage, tt.discount, got, tt.want)
+		}
+	}
+}
+
+// TestCommitmentFromContext verifies metadata parsing, defaults and rejection of
+// out-of-range values.
+func TestCommitmentFromContext(t *testing.T) {
+	tests := []struct {
+		name         string
+		md           metadata.MD
+		want         commitmentCoverage
+		wantWarnings int
+	}{
+		{name: "no metadata", want: commitmentCoverage{}},
+		{
+			name: "coverage with default discount",
+			md:   metadata.Pairs(committedCoverageMetadataKey, "0.6"),
+			want: commitmentCoverage{Coverage: 0.6, Discount: defaultCommittedDiscount},
+		},
+		{
+			name: "coverage and discount",
+			md:   metadata.Pairs(committedCoverageMetadataKey, "0.6", committedDiscountMetadataKey, "0.3"),
+			want: commitmentCoverage{Coverage: 0.6, Discount: 0.3},
+		},
+		{
+			name:         "coverage above 1 is ignored",
+			md:           metadata.Pairs(committedCoverageMetadataKey, "60"),
+			want:         commitmentCoverage{},
+			wantWarnings: 1,
+		},
+		{
+			name:         "invalid discount falls back to default",
+			md:           metadata.Pairs(committedCoverageMetadataKey, "1", committedDiscountMetadataKey, "1"),
+			want:         commitmentCoverage{Coverage: 1, Discount: defaultCommittedDiscount},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			got, warnings := commitmentFromContext(ctx)
+			if got != tt.want {
+				t.Errorf("commitmentFromContext() = %+v, want %+v", got, tt.want)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("got %d warnings %v, want %d", len(warnings), warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_CommittedCoverage verifies compute recommendations are repriced
+// at the blended rate, non-compute ones are untouched, and the trailer discloses the
+// coverage, blended rate and compute totals.
+func TestGetRecommendations_CommittedCoverage(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ec2Prices["c5.large/Linux/Shared"] = 0.085
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	req := &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2/instance:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+			{ResourceType: "aws:ec2/instance:Instance", Sku: "c5.large", Region: "us-east-1", Provider: "aws",
+				Tags: map[string]string{"interruptible": "true"}},
+			{ResourceType: "aws:ebs/volume:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws",
+				Tags: map[string]string{"size": "100"}},
+		},
+	}
+	const rate = 0.9 // 50% coverage at a 20% discount
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		committedCoverageMetadataKey, "0.5", committedDiscountMetadataKey, "0.2"))
+	resp, err := plugin.GetRecommendations(ctx, req)
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	byModType := make(map[string]*pbc.Recommendation)
+	for _, rec := range resp.Recommendations {
+		byModType[rec.GetModify().GetModificationType()] = rec
+	}
+
+	upgrade := byModType[modTypeGenUpgrade]
+	if upgrade == nil {
+		t.Fatal("missing generation upgrade recommendation")
+	}
+	if want := 0.0464 * 730 * rate; math.Abs(upgrade.Impact.CurrentCost-want) > 1e-9 {
+		t.Errorf("upgrade CurrentCost = %v, want %v", upgrade.Impact.CurrentCost, want)
+	}
+	if want := (0.0464 - 0.0416) * 730 * rate; math.Abs(upgrade.Impact.EstimatedSavings-want) > 1e-9 {
+		t.Errorf("upgrade EstimatedSavings = %v, want %v", upgrade.Impact.EstimatedSavings, want)
+	}
+	if upgrade.Metadata["committed_coverage"] != "0.5" || upgrade.Metadata["committed_blended_rate"] != "0.9000" {
+		t.Errorf("upgrade Metadata = %v, want committed_coverage 0.5 and committed_blended_rate 0.9000", upgrade.Metadata)
+	}
+
+	// Spot capacity is not covered by the commitment, so only the current cost blends
+	spot := byModType[modTypeSpot]
+	if spot == nil {
+		t.Fatal("missing spot recommendation")
+	}
+	spotMonthly := 0.085 * 730 * (1 - spotDiscountFactor)
+	if math.Abs(spot.Impact.ProjectedCost-spotMonthly) > 1e-9 {
+		t.Errorf("spot ProjectedCost = %v, want %v", spot.Impact.ProjectedCost, spotMonthly)
+	}
+	if want := 0.085*730*rate - spotMonthly; math.Abs(spot.Impact.EstimatedSavings-want) > 1e-9 {
+		t.Errorf("spot EstimatedSavings = %v, want %v", spot.Impact.EstimatedSavings, want)
+	}
+
+	ebs := byModType[modTypeVolumeUpgrade]
+	if ebs == nil {
+		t.Fatal("missing EBS recommendation")
+	}
+	if ebs.Impact.CurrentCost != 10 {
+		t.Errorf("EBS CurrentCost = %v, want 10 (not compute, never blended)", ebs.Impact.CurrentCost)
+	}
+	if _, ok := ebs.Metadata["committed_coverage"]; ok {
+		t.Errorf("EBS Metadata = %v, want no committed coverage", ebs.Metadata)
+	}
+
+	values := stream.trailer.Get(committedCoverageTrailerKey)
+	if len(values) != 1 {
+		t.Fatalf("got %d committed coverage trailer values, want 1", len(values))
+	}
+	var summary commitmentSummary
+	if err := json.Unmarshal([]byte(values[0]), &summary); err != nil {
+		t.Fatalf("committed coverage trailer is not JSON: %q", values[0])
+	}
+	wantOnDemand := (0.0464 + 0.085) * 730
+	if summary.Coverage != 0.5 || summary.Discount != 0.2 || math.Abs(summary.BlendedRate-rate) > 1e-12 ||
+		summary.ComputeResources != 2 || math.Abs(summary.ComputeOnDemandCost-wantOnDemand) > 1e-9 ||
+		math.Abs(summary.ComputeBlendedCost-wantOnDemand*rate) > 1e-9 {
+		t.Errorf("committed coverage summary = %+v, want coverage 0.5, discount 0.2, blended rate 0.9, "+
+			"2 resources costing %v On-Demand", summary, wantOnDemand)
+	}
+
+	// Without coverage metadata every rate stays On-Demand and nothing is disclosed
+	stream = &captureTransportStream{}
+	resp, err = plugin.GetRecommendations(grpc.NewContextWithServerTransportStream(context.Background(), stream), req)
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	for _, rec := range resp.Recommendations {
+		if rec.GetModify().GetModificationType() == modTypeGenUpgrade && rec.Impact.CurrentCost != 0.0464*730 {
+			t.Errorf("upgrade CurrentCost without coverage = %v, want %v", rec.Impact.CurrentCost, 0.0464*730)
+		}
+	}
+	if values := stream.trailer.Get(committedCoverageTrailerKey); len(values) != 0 {
+		t.Errorf("committed coverage trailer = %v, want none without coverage", values)
+	}
+}
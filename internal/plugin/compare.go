@@ -0,0 +1,81 @@
+package plugin
+
+import (
+	"context"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CostComparison is the result of CompareProjectedCost. Deltas are Proposed minus Base,
+// so a negative DeltaPerMonth means the proposed configuration is cheaper.
+type CostComparison struct {
+	Base     *pbc.GetProjectedCostResponse
+	Proposed *pbc.GetProjectedCostResponse
+	Currency string
+
+	DeltaPerMonth float64
+	// PercentChange is DeltaPerMonth as a percentage of the base cost; 0 when the base cost is 0.
+	PercentChange float64
+
+	// CarbonDeltaGCO2e is the monthly carbon footprint change. HasCarbonDelta is false
+	// unless both configurations report a carbon footprint.
+	CarbonDeltaGCO2e float64
+	HasCarbonDelta   bool
+}
+
+// CompareProjectedCost prices two configurations of a resource with GetProjectedCost and
+// returns both costs with the difference, answering "what if I change X" questions such
+// as whether gp3 is cheaper than gp2 at a given size.
+//
+// The CostSourceService proto has no comparison RPC, so this is available to Go callers
+// only; gRPC clients can call GetProjectedCost for each configuration.
+func (p *AWSPublicPlugin) CompareProjectedCost(
+	ctx context.Context, base, proposed *pbc.ResourceDescriptor,
+) (*CostComparison, error) {
+	if base == nil || proposed == nil {
+		return nil, status.Error(codes.InvalidArgument, "both base and proposed resources are required")
+	}
+
+	baseResp, err := p.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: base})
+	if err != nil {
+		st := status.Convert(err)
+		return nil, status.Errorf(st.Code(), "base resource: %s", st.Message())
+	}
+	proposedResp, err := p.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: proposed})
+	if err != nil {
+		st := status.Convert(err)
+		return nil, status.Errorf(st.Code(), "proposed resource: %s", st.Message())
+	}
+
+	cmp := &CostComparison{
+		Base:          baseResp,
+		Proposed:      proposedResp,
+		Currency:      baseResp.Currency,
+		DeltaPerMonth: proposedResp.CostPerMonth - baseResp.CostPerMonth,
+	}
+	if baseResp.CostPerMonth > 0 {
+		cmp.PercentChange = cmp.DeltaPerMonth / baseResp.CostPerMonth * 100
+	}
+
+	baseCarbon, baseOK := carbonFootprint(baseResp)
+	proposedCarbon, proposedOK := carbonFootprint(proposedResp)
+	if baseOK && proposedOK {
+		cmp.CarbonDeltaGCO2e = proposedCarbon - baseCarbon
+		cmp.HasCarbonDelta = true
+	}
+
+	return cmp, nil
+}
+
+// carbonFootprint returns the carbon footprint metric of resp, if present. Placeholder
+// metrics added by annotate_missing_carbon do not count.
+func carbonFootprint(resp *pbc.GetProjectedCostResponse) (float64, bool) {
+	for _, m := range resp.GetImpactMetrics() {
+		if m.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT && m.GetUnit() != carbonUnavailableUnit {
+			return m.GetValue(), true
+		}
+	}
+	return 0, false
+}
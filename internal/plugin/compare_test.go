@@ -0,0 +1,89 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestCompareProjectedCost verifies both configurations are priced and the cost and
+// carbon deltas are computed proposed minus base.
+func TestCompareProjectedCost(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	volume := func(volumeType string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ebs",
+			Sku:          volumeType,
+			Region:       "us-east-1",
+			Tags:         map[string]string{"size": "500"},
+		}
+	}
+
+	cmp, err := plugin.CompareProjectedCost(context.Background(), volume("gp2"), volume("gp3"))
+	if err != nil {
+		t.Fatalf("CompareProjectedCost() error: %v", err)
+	}
+
+	if math.Abs(cmp.Base.CostPerMonth-50) > 0.0001 || math.Abs(cmp.Proposed.CostPerMonth-40) > 0.0001 {
+		t.Errorf("costs = (%v, %v), want (50, 40)", cmp.Base.CostPerMonth, cmp.Proposed.CostPerMonth)
+	}
+	if math.Abs(cmp.DeltaPerMonth-(-10)) > 0.0001 {
+		t.Errorf("DeltaPerMonth = %v, want -10", cmp.DeltaPerMonth)
+	}
+	if math.Abs(cmp.PercentChange-(-20)) > 0.0001 {
+		t.Errorf("PercentChange = %v, want -20", cmp.PercentChange)
+	}
+	if cmp.Currency != "USD" {
+		t.Errorf("Currency = %q, want USD", cmp.Currency)
+	}
+
+	baseCarbon, _ := carbonFootprint(cmp.Base)
+	proposedCarbon, _ := carbonFootprint(cmp.Proposed)
+	if !cmp.HasCarbonDelta {
+		t.Fatal("HasCarbonDelta = false, want true for EBS volumes")
+	}
+	if math.Abs(cmp.CarbonDeltaGCO2e-(proposedCarbon-baseCarbon)) > 0.0001 {
+		t.Errorf("CarbonDeltaGCO2e = %v, want %v", cmp.CarbonDeltaGCO2e, proposedCarbon-baseCarbon)
+	}
+}
+
+// TestCompareProjectedCost_Invalid verifies missing or invalid descriptors are rejected
+// with InvalidArgument naming the offending side.
+func TestCompareProjectedCost_Invalid(t *testing.T) {
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+	valid := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ebs", Sku: "gp2", Region: "us-east-1"}
+
+	tests := []struct {
+		name     string
+		base     *pbc.ResourceDescriptor
+		proposed *pbc.ResourceDescriptor
+		wantMsg  string
+	}{
+		{name: "missing proposed", base: valid, wantMsg: "both base and proposed"},
+		{name: "invalid base", base: &pbc.ResourceDescriptor{ResourceType: "ebs"}, proposed: valid, wantMsg: "base resource:"},
+		{name: "invalid proposed", base: valid, proposed: &pbc.ResourceDescriptor{ResourceType: "ebs"}, wantMsg: "proposed resource:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := plugin.CompareProjectedCost(context.Background(), tt.base, tt.proposed)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("CompareProjectedCost() code = %v, want InvalidArgument (err: %v)", status.Code(err), err)
+			}
+			if !strings.Contains(status.Convert(err).Message(), tt.wantMsg) {
+				t.Errorf("error message = %q, want it to contain %q", status.Convert(err).Message(), tt.wantMsg)
+			}
+		})
+	}
+}
@@ -60,3 +60,81 @@ var ZeroCostPulumiPatterns = map[string]string{
 func IsZeroCostService(service string) bool {
 	return ZeroCostServices[service]
 }
+
+// SupportLevel describes how mature the cost estimation for a service is.
+type SupportLevel string
+
+const (
+	// SupportLevelFull indicates estimates are derived directly from published pricing
+	// and the resource's own configuration (EC2, EBS, RDS, zero-cost resources).
+	SupportLevelFull SupportLevel = "full"
+
+	// SupportLevelPartial indicates the service is priced but depends on usage
+	// assumptions or omits components, so estimates are approximations.
+	SupportLevelPartial SupportLevel = "partial"
+
+	// SupportLevelStub indicates the service is recognized but its estimator is a
+	// placeholder that returns $0 with an explanation. Clients can send the
+	// finfocus-stub-unimplemented request metadata to get codes.Unimplemented instead.
+	SupportLevelStub SupportLevel = "stub"
+
+	// SupportLevelUnsupported indicates the service cannot be estimated.
+	SupportLevelUnsupported SupportLevel = "unsupported"
+)
+
+// supportLevelCapabilityPrefix prefixes the SupportsResponse.Capabilities key that
+// carries the support level (e.g. "support_level_full").
+const supportLevelCapabilityPrefix = "support_level_"
+
+// ServiceSupportLevels is the single source of truth for the implementation maturity
+// of each canonical service type. Supports() consults this map after the provider
+// and region checks. Zero-cost services are treated as fully supported.
+//
+// When adding a new service, register it here with its maturity level.
+var ServiceSupportLevels = map[string]SupportLevel{
+	"ec2":            SupportLevelFull,
+	"ebs":            SupportLevelFull,
+	"rds":            SupportLevelFull,
+	"s3":             SupportLevelPartial,
+	"lambda":         SupportLevelPartial,
+	"dynamodb":       SupportLevelPartial,
+	"eks":            SupportLevelPartial,
+	"elasticache":    SupportLevelPartial,
+	"ecr":            SupportLevelPartial,
+	"secretsmanager": SupportLevelPartial,
+	"kms":            SupportLevelPartial,
+	"waf":            SupportLevelPartial,
+	"athena":         SupportLevelPartial,
+	"glue":           SupportLevelPartial,
+	"ses":            SupportLevelPartial,
+	"stepfunctions":  SupportLevelPartial,
+	"elb":            SupportLevelPartial,
+	"natgw":          SupportLevelPartial,
+	"cloudwatch":     SupportLevelPartial,
+}
+
+// GetSupportLevel returns the implementation maturity for a canonical service type.
+// Unknown services return SupportLevelUnsupported.
+func GetSupportLevel(service string) SupportLevel {
+	if IsZeroCostService(service) {
+		return SupportLevelFull
+	}
+	if level, ok := ServiceSupportLevels[service]; ok {
+		return level
+	}
+	return SupportLevelUnsupported
+}
+
+// supportLevel returns the implementation maturity for a canonical service type,
+// preferring the plugin's supportLevels overrides over ServiceSupportLevels.
+func (p *AWSPublicPlugin) supportLevel(service string) SupportLevel {
+	if level, ok := p.supportLevels[service]; ok {
+		return level
+	}
+	return GetSupportLevel(service)
+}
+
+// supportLevelCapabilities returns the Capabilities map entry advertising the given level.
+func supportLevelCapabilities(level SupportLevel) map[string]bool {
+	return map[string]bool{supportLevelCapabilityPrefix + string(level): true}
+}
@@ -80,3 +80,30 @@ func TestPricingUnavailableTemplate(t *testing.T) {
 		})
 	}
 }
+
+// TestGetSupportLevel verifies the maturity lookup for known, zero-cost, and unknown services.
+func TestGetSupportLevel(t *testing.T) {
+	tests := []struct {
+		service string
+		want    SupportLevel
+	}{
+		{"ec2", SupportLevelFull},
+		{"ebs", SupportLevelFull},
+		{"rds", SupportLevelFull},
+		{"iam", SupportLevelFull},
+		{"subnet", SupportLevelFull},
+		{"s3", SupportLevelPartial},
+		{"dynamodb", SupportLevelPartial},
+		{"elb", SupportLevelPartial},
+		{"sqs", SupportLevelUnsupported},
+		{"", SupportLevelUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.service, func(t *testing.T) {
+			if got := GetSupportLevel(tt.service); got != tt.want {
+				t.Errorf("GetSupportLevel(%q) = %q, want %q", tt.service, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	// tagCrossAZDataGB is the monthly volume, in GB, a resource exchanges with other
+	// Availability Zones. Any resource carrying it gets an inter-AZ transfer cost hint.
+	tagCrossAZDataGB = "cross_az_data_gb"
+
+	// modTypeCrossAZ is the modification type for inter-AZ data transfer hints.
+	modTypeCrossAZ = "cross_az_colocation"
+
+	// interAZBilledDirections reflects that AWS bills inter-AZ traffic on both the
+	// sending and the receiving side.
+	interAZBilledDirections = 2
+)
+
+// getCrossAZRecommendation returns an advisory recommendation estimating the monthly
+// inter-AZ data transfer cost of resource from its cross_az_data_gb tag. It returns nil
+// when the tag is absent or zero, or when the region has no inter-AZ rate.
+//
+// The hint prices a separate charge rather than an alternative configuration, so
+// BatchStats.addCostRollup leaves it out of the cost rollup.
+func (p *AWSPublicPlugin) getCrossAZRecommendation(
+	traceID string, resource *pbc.ResourceDescriptor, service, region string,
+) *pbc.Recommendation {
+	val := resource.Tags[tagCrossAZDataGB]
+	if val == "" {
+		return nil
+	}
+	gb := p.validateNonNegativeFloat64(traceID, tagCrossAZDataGB, val)
+	if gb == 0 {
+		return nil
+	}
+
+	rate, found := p.pricing.EC2InterAZDataTransferPricePerGB()
+	if !found {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("region", region).
+			Msg("inter-AZ data transfer pricing unavailable, skipping cross-AZ hint")
+		return nil
+	}
+	monthly := gb * rate * interAZBilledDirections
+
+	confidence := confidenceLow
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: service,
+			Region:       region,
+			Sku:          resource.Sku,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modTypeCrossAZ,
+				CurrentConfig:     map[string]string{tagCrossAZDataGB: strconv.FormatFloat(gb, 'f', -1, 64)},
+				RecommendedConfig: map[string]string{tagCrossAZDataGB: "0"},
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  monthly,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       monthly,
+			ProjectedCost:     0,
+			SavingsPercentage: 100,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW,
+		ConfidenceScore: &confidence,
+		Description: fmt.Sprintf("Inter-AZ data transfer of %g GB/month costs ~$%.2f/month",
+			gb, monthly),
+		Reasoning: []string{
+			fmt.Sprintf("Inter-AZ traffic is billed at $%g/GB in each direction", rate),
+			"Co-locate chatty resources in the same Availability Zone where availability requirements allow",
+			"Use VPC endpoints for AWS service traffic so it does not cross Availability Zones",
+		},
+		Source: sourceAWSPublic,
+	}
+}
@@ -0,0 +1,56 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/currency"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	// tagCurrency requests a display currency for one resource in a batch, overriding the
+	// batch default (pricingCurrency). ResourceDescriptor has no currency field.
+	tagCurrency = "currency"
+
+	// pricingCurrency is the currency of the embedded AWS public pricing and the batch
+	// default.
+	pricingCurrency = "USD"
+)
+
+// supportedCurrencies lists the display currencies amounts can be reported in. The
+// embedded price lists carry no exchange rates, so only the pricing currency itself is
+// supported; other valid ISO 4217 codes fall back to it.
+var supportedCurrencies = map[string]bool{
+	pricingCurrency: true,
+}
+
+// resolveCurrency returns the display currency for a resource. Without a currency tag
+// the batch default applies. An unknown ISO 4217 code, or one the plugin cannot convert
+// to, falls back to pricingCurrency with a warning.
+func resolveCurrency(tags map[string]string) (code, warning string) {
+	raw, ok := tags[tagCurrency]
+	if !ok {
+		return pricingCurrency, ""
+	}
+	requested := strings.ToUpper(strings.TrimSpace(raw))
+	if !currency.IsValid(requested) {
+		return pricingCurrency, fmt.Sprintf(
+			"unknown currency %q: not an ISO 4217 code, using %s", raw, pricingCurrency)
+	}
+	if !supportedCurrencies[requested] {
+		return pricingCurrency, fmt.Sprintf(
+			"no exchange rate for currency %s, using %s", requested, pricingCurrency)
+	}
+	return requested, ""
+}
+
+// stampCurrency sets the currency of each recommendation's impact, so every batch item
+// carries its own currency.
+func stampCurrency(recs []*pbc.Recommendation, code string) {
+	for _, rec := range recs {
+		if impact := rec.GetImpact(); impact != nil {
+			impact.Currency = code
+		}
+	}
+}
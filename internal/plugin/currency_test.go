@@ -0,0 +1,108 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestResolveCurrency verifies the currency tag is validated against ISO 4217 and falls
+// back to USD when unknown or not convertible.
+func TestResolveCurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        map[string]string
+		wantCode    string
+		wantWarning string
+	}{
+		{name: "no tag uses batch default", tags: nil, wantCode: "USD"},
+		{name: "USD", tags: map[string]string{"currency": "USD"}, wantCode: "USD"},
+		{name: "case and whitespace normalized", tags: map[string]string{"currency": " usd "}, wantCode: "USD"},
+		{
+			name:        "unknown code",
+			tags:        map[string]string{"currency": "XYZ"},
+			wantCode:    "USD",
+			wantWarning: `unknown currency "XYZ": not an ISO 4217 code, using USD`,
+		},
+		{
+			name:        "valid code without exchange rate",
+			tags:        map[string]string{"currency": "eur"},
+			wantCode:    "USD",
+			wantWarning: "no exchange rate for currency EUR, using USD",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, warning := resolveCurrency(tt.tags)
+			if code != tt.wantCode || warning != tt.wantWarning {
+				t.Errorf("resolveCurrency(%v) = (%q, %q), want (%q, %q)",
+					tt.tags, code, warning, tt.wantCode, tt.wantWarning)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_PerResourceCurrency verifies each batch item is stamped with its
+// resource's currency and that unsupported codes are reported as batch warnings.
+func TestGetRecommendations_PerResourceCurrency(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	volume := func(id, currencyTag string) *pbc.ResourceDescriptor {
+		tags := map[string]string{"size": "100"}
+		if currencyTag != "" {
+			tags["currency"] = currencyTag
+		}
+		return &pbc.ResourceDescriptor{
+			Id: id, ResourceType: "aws:ebs/volume:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws", Tags: tags,
+		}
+	}
+	resp, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			volume("vol-default", ""),
+			volume("vol-usd", "usd"),
+			volume("vol-eur", "EUR"),
+			volume("vol-bad", "EURO"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	if len(resp.Recommendations) != 4 {
+		t.Fatalf("got %d recommendations, want 4", len(resp.Recommendations))
+	}
+	for _, rec := range resp.Recommendations {
+		if got := rec.GetImpact().GetCurrency(); got != "USD" {
+			t.Errorf("%s currency = %q, want USD", rec.GetResource().GetId(), got)
+		}
+	}
+
+	var warnings []BatchWarning
+	for _, value := range stream.trailer.Get(batchWarningsTrailerKey) {
+		var w BatchWarning
+		if err := json.Unmarshal([]byte(value), &w); err != nil {
+			t.Fatalf("batch warning %q is not JSON: %v", value, err)
+		}
+		warnings = append(warnings, w)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("got %d batch warnings %+v, want 2", len(warnings), warnings)
+	}
+	if warnings[0].Index != 2 || !strings.Contains(warnings[0].Reason, "no exchange rate for currency EUR") {
+		t.Errorf("warnings[0] = %+v, want index 2 with no exchange rate for EUR", warnings[0])
+	}
+	if warnings[1].Index != 3 || !strings.Contains(warnings[1].Reason, `unknown currency "EURO"`) {
+		t.Errorf("warnings[1] = %+v, want index 3 with unknown currency EURO", warnings[1])
+	}
+}
@@ -86,7 +86,7 @@ func TestEstimateELB_ALB(t *testing.T) {
 				},
 			}
 
-			resp, err := plugin.estimateELB("test-trace", resource)
+			resp, err := plugin.estimateELB("test-trace", resource, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -168,7 +168,7 @@ func TestEstimateELB_NLB(t *testing.T) {
 				},
 			}
 
-			resp, err := plugin.estimateELB("test-trace", resource)
+			resp, err := plugin.estimateELB("test-trace", resource, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -237,7 +237,7 @@ func TestEstimateELB_FallbackCapacityUnits(t *testing.T) {
 				},
 			}
 
-			resp, err := plugin.estimateELB("test-trace", resource)
+			resp, err := plugin.estimateELB("test-trace", resource, nil)
 
 			require.NoError(t, err)
 			assert.NotNil(t, resp)
@@ -271,7 +271,7 @@ func TestEstimateELB_InvalidTag(t *testing.T) {
 		},
 	}
 
-	resp, err := plugin.estimateELB("test-trace", resource)
+	resp, err := plugin.estimateELB("test-trace", resource, nil)
 
 	require.NoError(t, err)
 	assert.NotNil(t, resp)
@@ -337,3 +337,72 @@ func TestEstimateELB_RoundTripEstimate(t *testing.T) {
 		})
 	}
 }
+
+// TestEstimateELB_Outposts verifies the outposts tag resolves Outposts rates, labels the
+// billing detail, and falls back to regional rates for components without Outposts pricing.
+func TestEstimateELB_Outposts(t *testing.T) {
+	tests := []struct {
+		name           string
+		sku            string
+		tags           map[string]string
+		outpostsPrices map[string]float64
+		wantCost       float64
+		wantDetail     string
+	}{
+		{
+			name:       "default excludes Outposts",
+			sku:        "alb",
+			tags:       map[string]string{"lcu_per_hour": "2"},
+			wantCost:   730*0.0225 + 730*2*0.008,
+			wantDetail: "ALB, 730 hrs/month, 2.0 LCU avg/hr",
+		},
+		{
+			name:           "ALB on Outposts with LCU rate only",
+			sku:            "alb",
+			tags:           map[string]string{"lcu_per_hour": "2", tagOutposts: "true"},
+			outpostsPrices: map[string]float64{"alb/cu": 0.011},
+			wantCost:       730*0.0225 + 730*2*0.011,
+			wantDetail:     "ALB on Outposts, 730 hrs/month, 2.0 LCU avg/hr (no Outposts pricing for hourly, regional rate used)",
+		},
+		{
+			name:           "NLB on Outposts with both rates",
+			sku:            "nlb",
+			tags:           map[string]string{"nlcu_per_hour": "1", tagOutposts: "yes"},
+			outpostsPrices: map[string]float64{"nlb/hourly": 0.03, "nlb/cu": 0.009},
+			wantCost:       730*0.03 + 730*1*0.009,
+			wantDetail:     "NLB on Outposts, 730 hrs/month, 1.0 NLCU avg/hr",
+		},
+		{
+			name:       "Outposts without Outposts pricing",
+			sku:        "alb",
+			tags:       map[string]string{"lcu_per_hour": "1", tagOutposts: "true"},
+			wantCost:   730*0.0225 + 730*1*0.008,
+			wantDetail: "ALB on Outposts, 730 hrs/month, 1.0 LCU avg/hr (no Outposts pricing for hourly or LCU, regional rate used)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.albHourlyPrice = 0.0225
+			mock.albLCUPrice = 0.008
+			mock.nlbHourlyPrice = 0.0225
+			mock.nlbNLCUPrice = 0.006
+			mock.elbOutpostsPrices = tt.outpostsPrices
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "elb",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.CostPerMonth, 0.0001)
+			assert.Equal(t, tt.wantDetail, resp.BillingDetail)
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
@@ -17,8 +16,11 @@ import (
 // EstimateCost returns an estimated monthly cost for a resource based on its
 // type and configuration attributes. This is the preferred method for pre-deployment
 // cost estimation as it works with Pulumi resource types directly.
-func (p *AWSPublicPlugin) EstimateCost(ctx context.Context, req *pbc.EstimateCostRequest) (*pbc.EstimateCostResponse, error) {
-	start := time.Now()
+func (p *AWSPublicPlugin) EstimateCost(ctx context.Context, req *pbc.EstimateCostRequest) (_ *pbc.EstimateCostResponse, err error) {
+	start := p.clock.Now()
+	defer func() {
+		p.metrics.observe("EstimateCost", metricsResourceType(req.GetResourceType()), p.since(start), err)
+	}()
 	traceID := p.getTraceID(ctx)
 
 	if req == nil {
@@ -91,7 +93,7 @@ func (p *AWSPublicPlugin) EstimateCost(ctx context.Context, req *pbc.EstimateCos
 		Str("pulumi_type", req.ResourceType).
 		Str("aws_region", region).
 		Float64(pluginsdk.FieldCostMonthly, costMonthly).
-		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 		Msg("cost estimated")
 
 	return &pbc.EstimateCostResponse{
@@ -226,6 +228,9 @@ func (p *AWSPublicPlugin) estimateEBSFromAttrs(traceID, resourceName string, att
 	if size, ok := getNumberAttr(attrs, "size"); ok && size > 0 {
 		sizeGB = size
 	}
+	if minGB, ok := ebsMinimumSizeGB[volumeType]; ok {
+		sizeGB = max(sizeGB, float64(minGB))
+	}
 
 	ratePerGBMonth, found := p.pricing.EBSPricePerGBMonth(volumeType)
 	if !found {
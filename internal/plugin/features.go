@@ -0,0 +1,75 @@
+package plugin
+
+import (
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Features holds the plugin's on/off feature switches, read once from the environment
+// at startup by LoadFeatures. Estimators consult these fields instead of reading
+// environment variables themselves.
+type Features struct {
+	// StrictValidation fails recommendation batches on the first unsupported resource
+	// (FINFOCUS_STRICT_VALIDATION, or its deprecated names).
+	StrictValidation bool
+
+	// AllowRegionFallback estimates other regions from reference pricing in fallback
+	// builds (FINFOCUS_ALLOW_REGION_FALLBACK).
+	AllowRegionFallback bool
+
+	// EmitMinorUnits sends CostPerMonth in cents as a response header (FINFOCUS_EMIT_MINOR_UNITS).
+	EmitMinorUnits bool
+
+	// SuggestClosestSKU names the nearest priced size of the same family when an EC2
+	// instance type is not found (FINFOCUS_SUGGEST_CLOSEST_SKU).
+	SuggestClosestSKU bool
+
+	// EmitCarbonRange sends idle and full-load bounds of EC2 carbon estimates as response
+	// headers (FINFOCUS_EMIT_CARBON_RANGE).
+	EmitCarbonRange bool
+}
+
+// LoadFeatures reads and validates the feature flag environment variables.
+// Unset flags are disabled. Values other than true/1/yes/on or false/0/no/off
+// (case-insensitive) are logged and treated as disabled.
+func LoadFeatures(logger zerolog.Logger) Features {
+	var features Features
+
+	if val, varName, found := getEnvWithDeprecation(logger, EnvStrictValidation,
+		EnvStrictValidationDeprecated, EnvStrictValidationLegacy); found {
+		features.StrictValidation = parseFeatureFlag(logger, varName, val)
+	}
+	features.AllowRegionFallback = lookupFeatureFlag(logger, EnvAllowRegionFallback)
+	features.EmitMinorUnits = lookupFeatureFlag(logger, EnvEmitMinorUnits)
+	features.SuggestClosestSKU = lookupFeatureFlag(logger, EnvSuggestClosestSKU)
+	features.EmitCarbonRange = lookupFeatureFlag(logger, EnvEmitCarbonRange)
+
+	return features
+}
+
+// lookupFeatureFlag returns the validated value of the feature flag variable, false if unset.
+func lookupFeatureFlag(logger zerolog.Logger, variable string) bool {
+	val := os.Getenv(variable)
+	if val == "" {
+		return false
+	}
+	return parseFeatureFlag(logger, variable, val)
+}
+
+// parseFeatureFlag parses a feature flag value, logging a warning for unrecognized values.
+func parseFeatureFlag(logger zerolog.Logger, variable, val string) bool {
+	switch strings.ToLower(strings.TrimSpace(val)) {
+	case "true", "1", "yes", "on":
+		return true
+	case "false", "0", "no", "off":
+		return false
+	default:
+		logger.Warn().
+			Str("variable", variable).
+			Str("value", val).
+			Msg("invalid feature flag value, treating as disabled")
+		return false
+	}
+}
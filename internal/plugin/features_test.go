@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestLoadFeatures verifies feature flags accept the documented boolean spellings,
+// stay disabled when unset, and warn on unrecognized values.
+func TestLoadFeatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		want     Features
+		wantWarn string // variable named in the warning, "" for no warning
+	}{
+		{
+			name: "unset",
+			want: Features{},
+		},
+		{
+			name: "valid enabled",
+			env: map[string]string{
+				EnvStrictValidation:    "true",
+				EnvAllowRegionFallback: "YES",
+				EnvEmitMinorUnits:      "1",
+				EnvSuggestClosestSKU:   "on",
+				EnvEmitCarbonRange:     "TRUE",
+			},
+			want: Features{
+				StrictValidation: true, AllowRegionFallback: true, EmitMinorUnits: true, SuggestClosestSKU: true,
+				EmitCarbonRange: true,
+			},
+		},
+		{
+			name: "valid disabled",
+			env: map[string]string{
+				EnvStrictValidation:    "off",
+				EnvAllowRegionFallback: "false",
+				EnvEmitMinorUnits:      "0",
+				EnvSuggestClosestSKU:   "no",
+				EnvEmitCarbonRange:     "false",
+			},
+			want: Features{},
+		},
+		{
+			name:     "invalid value",
+			env:      map[string]string{EnvEmitMinorUnits: "maybe"},
+			want:     Features{},
+			wantWarn: EnvEmitMinorUnits,
+		},
+		{
+			name:     "invalid deprecated name",
+			env:      map[string]string{EnvStrictValidationDeprecated: "enabled"},
+			want:     Features{},
+			wantWarn: EnvStrictValidationDeprecated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{
+				EnvStrictValidation, EnvStrictValidationDeprecated, EnvStrictValidationLegacy,
+				EnvAllowRegionFallback, EnvEmitMinorUnits, EnvSuggestClosestSKU, EnvEmitCarbonRange,
+			} {
+				t.Setenv(name, tt.env[name])
+			}
+			var buf bytes.Buffer
+
+			got := LoadFeatures(zerolog.New(&buf))
+
+			if got != tt.want {
+				t.Errorf("LoadFeatures() = %+v, want %+v", got, tt.want)
+			}
+			hasWarn := strings.Contains(buf.String(), "invalid feature flag value")
+			if hasWarn != (tt.wantWarn != "") {
+				t.Errorf("invalid value warning logged = %v, want %v, output: %s", hasWarn, tt.wantWarn != "", buf.String())
+			}
+			if tt.wantWarn != "" && !strings.Contains(buf.String(), tt.wantWarn) {
+				t.Errorf("warning does not name %s, output: %s", tt.wantWarn, buf.String())
+			}
+		})
+	}
+}
@@ -12,16 +12,24 @@ import (
 // AWS service name mappings for FOCUS ServiceName field.
 // These follow AWS's official service naming conventions.
 var awsServiceNames = map[string]string{
-	"ec2":        "Amazon EC2",
-	"ebs":        "Amazon EBS",
-	"s3":         "Amazon S3",
-	"rds":        "Amazon RDS",
-	"lambda":     "AWS Lambda",
-	"dynamodb":   "Amazon DynamoDB",
-	"eks":        "Amazon EKS",
-	"elb":        "Elastic Load Balancing",
-	"natgw":      "Amazon VPC NAT Gateway",
-	"cloudwatch": "Amazon CloudWatch",
+	"ec2":            "Amazon EC2",
+	"ebs":            "Amazon EBS",
+	"s3":             "Amazon S3",
+	"rds":            "Amazon RDS",
+	"lambda":         "AWS Lambda",
+	"dynamodb":       "Amazon DynamoDB",
+	"eks":            "Amazon EKS",
+	"elb":            "Elastic Load Balancing",
+	"natgw":          "Amazon VPC NAT Gateway",
+	"cloudwatch":     "Amazon CloudWatch",
+	"ecr":            "Amazon ECR",
+	"secretsmanager": "AWS Secrets Manager",
+	"kms":            "AWS Key Management Service",
+	"waf":            "AWS WAF",
+	"athena":         "Amazon Athena",
+	"glue":           "AWS Glue",
+	"ses":            "Amazon Simple Email Service",
+	"stepfunctions":  "AWS Step Functions",
 }
 
 // buildFocusRecord creates a FocusCostRecord for public pricing estimates.
@@ -101,15 +109,17 @@ func buildFocusRecord(
 //
 // Categories are based on the primary function of each AWS service:
 //   - COMPUTE: Processing resources (EC2, Lambda, EKS worker nodes)
-//   - STORAGE: Data persistence (S3, EBS)
+//   - STORAGE: Data persistence (S3, EBS, ECR)
 //   - DATABASE: Managed database services (RDS, DynamoDB)
 //   - NETWORK: Networking infrastructure (ELB, NAT Gateway)
 //   - MANAGEMENT: Monitoring and operations (CloudWatch)
+//   - SECURITY: Secrets, key management and web filtering (Secrets Manager, KMS, WAF)
+//   - ANALYTICS: Query and data integration services (Athena, Glue)
 func mapServiceCategory(serviceType string) pbc.FocusServiceCategory {
 	switch serviceType {
 	case "ec2", "lambda":
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE
-	case "ebs", "s3":
+	case "ebs", "s3", "ecr":
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE
 	case "rds", "dynamodb":
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE
@@ -117,6 +127,10 @@ func mapServiceCategory(serviceType string) pbc.FocusServiceCategory {
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_NETWORK
 	case "cloudwatch":
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_MANAGEMENT
+	case "secretsmanager", "kms", "waf":
+		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_SECURITY
+	case "athena", "glue":
+		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_ANALYTICS
 	case "eks":
 		// EKS control plane is compute; worker nodes would be EC2
 		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE
@@ -141,7 +155,7 @@ func getPricingUnitForService(serviceType string) string {
 	switch serviceType {
 	case "ec2", "rds", "eks", "elb", "alb", "nlb", "natgw":
 		return "Hours"
-	case "ebs", "s3":
+	case "ebs", "s3", "ecr":
 		return "GB-Mo"
 	case "lambda":
 		return "GB-Seconds"
@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// tagGridFactor overrides the regional grid emission factor (metric tons CO2e per
+	// kWh) in the carbon estimate, e.g. for customers on renewable PPAs. Values outside
+	// carbon.MinGridFactor..carbon.MaxGridFactor are clamped.
+	tagGridFactor = "grid_factor"
+
+	// carbonGridFactorHeaderKey carries the grid_factor override (tCO2e/kWh) as a gRPC
+	// response header when it rescaled the carbon metric. ImpactMetric has no metadata
+	// fields and its unit stays "gCO2e" for clients that match on it, so the override is
+	// disclosed out-of-band and in the billing detail.
+	carbonGridFactorHeaderKey = "finfocus-carbon-grid-factor"
+)
+
+// applyCustomGridFactor rescales the response's carbon footprint from the regional grid
+// factor to the resource's grid_factor tag. Every estimator's carbon is energy times the
+// grid factor of resource.Region, so the rescale is exact. For a replicated estimate only
+// the source region's share is rescaled; replica_regions copies keep their own regions'
+// grid factors. Invalid values are logged and the regional factor is kept. It returns
+// the factor applied and whether any carbon metric was rescaled.
+func (p *AWSPublicPlugin) applyCustomGridFactor(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	resp *pbc.GetProjectedCostResponse,
+	regions *carbonRegions,
+) (float64, bool) {
+	val, ok := resource.GetTags()[tagGridFactor]
+	if !ok || resp == nil {
+		return 0, false
+	}
+
+	factor, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil || math.IsNaN(factor) {
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagGridFactor).
+			Str("value", val).
+			Msg("invalid grid_factor, must be a number in tCO2e/kWh, using regional grid factor")
+		return 0, false
+	}
+	if factor < carbon.MinGridFactor || factor > carbon.MaxGridFactor {
+		clamped := min(max(factor, carbon.MinGridFactor), carbon.MaxGridFactor)
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagGridFactor).
+			Float64("value", factor).
+			Float64("clamped", clamped).
+			Msg("grid_factor out of range, clamping")
+		factor = clamped
+	}
+
+	regional := carbon.GetGridFactor(resource.GetRegion())
+	if regional <= 0 {
+		return 0, false
+	}
+	scale := factor / regional
+	source, replicated := regions.source()
+	applied := false
+	for _, m := range resp.GetImpactMetrics() {
+		if m.GetKind() != pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT || m.GetUnit() == carbonUnavailableUnit {
+			continue
+		}
+		if replicated {
+			m.Value += source * (scale - 1)
+		} else {
+			m.Value *= scale
+		}
+		applied = true
+	}
+	if !applied {
+		return 0, false
+	}
+	if replicated {
+		regions.scaleSource(scale)
+		resp.BillingDetail += fmt.Sprintf(", %s carbon uses custom grid factor %g tCO2e/kWh", resource.GetRegion(), factor)
+		return factor, true
+	}
+	resp.BillingDetail += fmt.Sprintf(", carbon uses custom grid factor %g tCO2e/kWh", factor)
+	return factor, true
+}
+
+// setCarbonGridFactorHeader sends the applied grid_factor override as a response header.
+// It is a no-op outside a gRPC server stream or when no override was applied.
+func (p *AWSPublicPlugin) setCarbonGridFactorHeader(ctx context.Context, traceID string, factor float64, applied bool) {
+	if !applied || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	md := metadata.Pairs(carbonGridFactorHeaderKey, strconv.FormatFloat(factor, 'g', -1, 64))
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set carbon grid factor header")
+	}
+}
@@ -0,0 +1,92 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_GridFactor verifies grid_factor rescales the carbon footprint,
+// is clamped to the valid range, and is disclosed in the billing detail and a response
+// header while the metric keeps its gCO2e unit.
+func TestGetProjectedCost_GridFactor(t *testing.T) {
+	regional := carbon.GetGridFactor("us-east-1")
+
+	tests := []struct {
+		name       string
+		gridFactor string
+		wantScale  float64
+		wantHeader string
+	}{
+		{"unset uses regional factor", "", 1, ""},
+		{"renewable PPA", "0.0001", 0.0001 / regional, "0.0001"},
+		{"zero carbon grid", "0", 0, "0"},
+		{"above range clamped", "5", carbon.MaxGridFactor / regional, strconv.FormatFloat(carbon.MaxGridFactor, 'g', -1, 64)},
+		{"negative clamped to zero", "-0.1", 0, "0"},
+		{"invalid ignored", "green", 1, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resource := &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+			}
+			baseline, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: resource})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() baseline error: %v", err)
+			}
+			baseCarbon, ok := carbonFootprint(baseline)
+			if !ok || baseCarbon <= 0 {
+				t.Fatalf("baseline carbon = %v, %v; want positive", baseCarbon, ok)
+			}
+
+			if tt.gridFactor != "" {
+				resource.Tags = map[string]string{tagGridFactor: tt.gridFactor}
+			}
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() error: %v", err)
+			}
+
+			gotCarbon, ok := carbonFootprint(resp)
+			if !ok {
+				t.Fatal("carbon footprint missing")
+			}
+			if want := baseCarbon * tt.wantScale; math.Abs(gotCarbon-want) > 1e-6*math.Max(1, want) {
+				t.Errorf("carbon = %v, want %v", gotCarbon, want)
+			}
+
+			if unit := resp.GetImpactMetrics()[0].GetUnit(); unit != "gCO2e" {
+				t.Errorf("unit = %q, want gCO2e", unit)
+			}
+			hasNote := strings.Contains(resp.GetBillingDetail(), "custom grid factor")
+			if hasNote != (tt.wantHeader != "") {
+				t.Errorf("billing detail = %q, custom grid factor note = %v, want %v", resp.GetBillingDetail(), hasNote, tt.wantHeader != "")
+			}
+			got := stream.header.Get(carbonGridFactorHeaderKey)
+			if tt.wantHeader == "" {
+				if len(got) != 0 {
+					t.Errorf("header %s = %v, want none", carbonGridFactorHeaderKey, got)
+				}
+			} else if len(got) != 1 || got[0] != tt.wantHeader {
+				t.Errorf("header %s = %v, want [%s]", carbonGridFactorHeaderKey, got, tt.wantHeader)
+			}
+		})
+	}
+}
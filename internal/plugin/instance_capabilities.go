@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Recommendation metadata keys describing the current and recommended instance so
+// users can check that an EC2 instance swap is equivalent. Unknown values are omitted.
+const (
+	metaCurrentVCPU        = "current_vcpu"
+	metaCurrentMemoryGB    = "current_memory_gb"
+	metaCurrentNetwork     = "current_network_performance"
+	metaRecommendedVCPU    = "recommended_vcpu"
+	metaRecommendedMemory  = "recommended_memory_gb"
+	metaRecommendedNetwork = "recommended_network_performance"
+)
+
+// instanceCapabilities describes the sizing of an EC2 instance type. Zero values mean unknown.
+type instanceCapabilities struct {
+	VCPU     int
+	MemoryGB float64
+	Network  string
+}
+
+// lookupInstanceCapabilities returns the vCPU and memory of instanceType from the CCF instance
+// spec table and its network performance from the EC2 price list.
+func (p *AWSPublicPlugin) lookupInstanceCapabilities(instanceType string) instanceCapabilities {
+	var caps instanceCapabilities
+	if spec, ok := carbon.GetInstanceSpec(instanceType); ok {
+		caps.VCPU = spec.VCPUCount
+		caps.MemoryGB = spec.MemoryGB
+	}
+	if network, ok := p.pricing.EC2NetworkPerformance(instanceType); ok {
+		caps.Network = network
+	}
+	return caps
+}
+
+// annotateInstanceCapabilities adds current and recommended instance capabilities to each
+// EC2 instance type change in recs, and warns in the reasoning when the recommended
+// instance has fewer vCPUs or less memory than the current one.
+func (p *AWSPublicPlugin) annotateInstanceCapabilities(recs []*pbc.Recommendation) {
+	for _, rec := range recs {
+		modify := rec.GetModify()
+		current := modify.GetCurrentConfig()["instance_type"]
+		recommended := modify.GetRecommendedConfig()["instance_type"]
+		if current == "" || recommended == "" {
+			continue
+		}
+		applyInstanceCapabilities(rec, current, recommended,
+			p.lookupInstanceCapabilities(current), p.lookupInstanceCapabilities(recommended))
+	}
+}
+
+// applyInstanceCapabilities records cur and next in rec's metadata and reasoning.
+func applyInstanceCapabilities(rec *pbc.Recommendation, currentType, recommendedType string, cur, next instanceCapabilities) {
+	if rec.Metadata == nil {
+		rec.Metadata = make(map[string]string)
+	}
+	setCapabilityMetadata(rec.Metadata, metaCurrentVCPU, metaCurrentMemoryGB, metaCurrentNetwork, cur)
+	setCapabilityMetadata(rec.Metadata, metaRecommendedVCPU, metaRecommendedMemory, metaRecommendedNetwork, next)
+
+	if cur.VCPU > 0 && next.VCPU > 0 && next.VCPU < cur.VCPU {
+		rec.Reasoning = append(rec.Reasoning, fmt.Sprintf(
+			"%s has fewer vCPUs than %s (%d vs %d); verify the workload fits",
+			recommendedType, currentType, next.VCPU, cur.VCPU))
+	}
+	if cur.MemoryGB > 0 && next.MemoryGB > 0 && next.MemoryGB < cur.MemoryGB {
+		rec.Reasoning = append(rec.Reasoning, fmt.Sprintf(
+			"%s has less memory than %s (%g GB vs %g GB); verify the workload fits",
+			recommendedType, currentType, next.MemoryGB, cur.MemoryGB))
+	}
+}
+
+// setCapabilityMetadata stores the known fields of caps under the given keys.
+func setCapabilityMetadata(md map[string]string, vcpuKey, memoryKey, networkKey string, caps instanceCapabilities) {
+	if caps.VCPU > 0 {
+		md[vcpuKey] = strconv.Itoa(caps.VCPU)
+	}
+	if caps.MemoryGB > 0 {
+		md[memoryKey] = strconv.FormatFloat(caps.MemoryGB, 'f', -1, 64)
+	}
+	if caps.Network != "" {
+		md[networkKey] = caps.Network
+	}
+}
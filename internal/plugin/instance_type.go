@@ -1,6 +1,9 @@
 package plugin
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // parseInstanceType splits an EC2 instance type into family and size.
 // Example: "t2.medium" → ("t2", "medium")
@@ -17,6 +20,59 @@ func parseInstanceType(instanceType string) (family, size string) {
 	return parts[0], parts[1]
 }
 
+// normalizeInstanceType trims an EC2 instance type and lowercases its family, keeping
+// the size as given, so "T3.micro" prices as "t3.micro".
+// Example: " M5.Large " → "m5.Large"
+func normalizeInstanceType(instanceType string) string {
+	instanceType = strings.TrimSpace(instanceType)
+	family, size, ok := strings.Cut(instanceType, ".")
+	if !ok {
+		return instanceType
+	}
+	return strings.ToLower(family) + "." + size
+}
+
+// retiredFamilySuccessors maps retired EC2 families, no longer in most regions' pricing
+// data, to the current family used to estimate them.
+var retiredFamilySuccessors = map[string]string{
+	"t1":  "t3",
+	"m1":  "m5",
+	"m2":  "r5",
+	"m3":  "m5",
+	"c1":  "c5",
+	"c3":  "c5",
+	"cc2": "c5",
+	"cr1": "r5",
+	"r3":  "r5",
+	"i2":  "i3",
+	"hs1": "d3",
+	"g2":  "g4dn",
+}
+
+// successorInstanceType returns the instance type used to estimate instanceType when its
+// retired family is not priced: the successor family at the same size, or its closest
+// priced size. note discloses the substitution for the billing detail. It returns
+// instanceType unchanged when the family is priced, not retired, or has no priced
+// successor.
+func (p *AWSPublicPlugin) successorInstanceType(instanceType, os, tenancy string) (resolved, note string) {
+	family, size := parseInstanceType(instanceType)
+	successor, ok := retiredFamilySuccessors[family]
+	if !ok {
+		return instanceType, ""
+	}
+	if _, found := p.pricing.EC2OnDemandPricePerHour(instanceType, os, tenancy); found {
+		return instanceType, ""
+	}
+
+	resolved = successor + "." + size
+	if _, found := p.pricing.EC2OnDemandPricePerHour(resolved, os, tenancy); !found {
+		if resolved = p.closestInstanceType(resolved, os, tenancy); resolved == "" {
+			return instanceType, ""
+		}
+	}
+	return resolved, fmt.Sprintf(", %s is a retired family, estimated as %s", instanceType, resolved)
+}
+
 // generationUpgradeMap maps old instance families to newer generations.
 // Only includes mappings where the newer generation is typically the same price
 // or cheaper with better performance.
@@ -88,6 +144,49 @@ var gravitonMap = map[string]string{
 	"t3a": "t4g",
 }
 
+// gravitonWorkloadCompatibility describes how readily a workload runs on ARM.
+type gravitonWorkloadCompatibility string
+
+const (
+	// workloadPortable runtimes ship official arm64 builds; migration is mostly a redeploy.
+	workloadPortable gravitonWorkloadCompatibility = "portable"
+	// workloadLimited workloads can run on ARM but usually need recompilation or testing.
+	workloadLimited gravitonWorkloadCompatibility = "limited"
+	// workloadIncompatible workloads are tied to x86 and cannot run on Graviton.
+	workloadIncompatible gravitonWorkloadCompatibility = "incompatible"
+)
+
+// gravitonWorkloads maps normalized "workload" tag values to their ARM compatibility
+// and the explanation surfaced in recommendation metadata.
+// Unlisted workloads keep the default Graviton confidence.
+var gravitonWorkloads = map[string]struct {
+	compatibility gravitonWorkloadCompatibility
+	reason        string
+}{
+	// Runtimes with first-class arm64 support
+	"java":   {workloadPortable, "JVM workloads run unmodified on arm64 (Corretto/OpenJDK arm64 builds)"},
+	"python": {workloadPortable, "Python runs on arm64; verify native wheels are available for dependencies"},
+	"node":   {workloadPortable, "Node.js publishes official arm64 builds"},
+	"nodejs": {workloadPortable, "Node.js publishes official arm64 builds"},
+	"ruby":   {workloadPortable, "Ruby runs on arm64; verify native gem extensions build"},
+	"php":    {workloadPortable, "PHP runs on arm64 with standard distribution packages"},
+	"go":     {workloadPortable, "Go cross-compiles to arm64 with GOARCH=arm64"},
+	"golang": {workloadPortable, "Go cross-compiles to arm64 with GOARCH=arm64"},
+
+	// Runs on ARM after recompilation or dependency checks
+	"dotnet": {workloadLimited, ".NET 6+ supports arm64, but .NET Framework and x86-only NuGet packages do not"},
+	"cpp":    {workloadLimited, "Native C/C++ code must be recompiled for arm64"},
+	"c++":    {workloadLimited, "Native C/C++ code must be recompiled for arm64"},
+	"native": {workloadLimited, "Native binaries must be recompiled for arm64"},
+
+	// Tied to x86
+	"native-x86":       {workloadIncompatible, "Native x86 binaries cannot run on Graviton (ARM)"},
+	"x86":              {workloadIncompatible, "Workload is pinned to the x86 architecture"},
+	"x86_64":           {workloadIncompatible, "Workload is pinned to the x86 architecture"},
+	"windows":          {workloadIncompatible, "Windows is not available on Graviton instances"},
+	"dotnet-framework": {workloadIncompatible, ".NET Framework only runs on x86 Windows"},
+}
+
 // parseRDSInstanceType splits an RDS instance type into family and size.
 // Example: "db.t3.medium" → ("db.t3", "medium")
 // Returns empty strings if the format is invalid.
@@ -140,11 +239,11 @@ var rdsGravitonMap = map[string]string{
 // rdsGravitonSupportedEngines lists engines that support Graviton instances.
 // Used to filter out Graviton recommendations for unsupported engines.
 var rdsGravitonSupportedEngines = map[string]bool{
-	"mysql":      true,
-	"postgres":   true,
-	"postgresql": true,
-	"mariadb":    true,
-	"aurora":     true, // Aurora MySQL/PostgreSQL
+	"mysql":             true,
+	"postgres":          true,
+	"postgresql":        true,
+	"mariadb":           true,
+	"aurora":            true, // Aurora MySQL/PostgreSQL
 	"aurora-mysql":      true,
 	"aurora-postgresql": true,
 }
@@ -80,6 +80,29 @@ func TestParseInstanceType(t *testing.T) {
 	}
 }
 
+// TestNormalizeInstanceType verifies the family is lowercased and trimmed while the size
+// is kept as given.
+func TestNormalizeInstanceType(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"t2.micro", "t2.micro"},
+		{"T2.micro", "t2.micro"},
+		{" M5.xlarge ", "m5.xlarge"},
+		{"C6I.2xlarge", "c6i.2xlarge"},
+		{"m5.Large", "m5.Large"},
+		{"T2MICRO", "T2MICRO"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeInstanceType(tt.input); got != tt.want {
+			t.Errorf("normalizeInstanceType(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
 // TestGenerationUpgradeMapEntries verifies the generation upgrade map contains
 // expected mappings for common instance families.
 func TestGenerationUpgradeMapEntries(t *testing.T) {
@@ -160,6 +183,12 @@ func TestNoSelfReferencesInMaps(t *testing.T) {
 			t.Errorf("rdsGravitonMap has self-reference: %q -> %q", old, new)
 		}
 	}
+
+	for old, new := range retiredFamilySuccessors {
+		if old == new {
+			t.Errorf("retiredFamilySuccessors has self-reference: %q -> %q", old, new)
+		}
+	}
 }
 
 // TestParseRDSInstanceType validates the parseRDSInstanceType function handles
@@ -564,7 +564,7 @@ func TestIntegration_EKS_UseEast1_Binary(t *testing.T) {
 			}
 
 			// Verify billing detail
-			expectedDetail := "EKS cluster (standard support), 730 hrs/month (control plane only, excludes worker nodes)"
+			expectedDetail := "EKS cluster (standard support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 			if resp.BillingDetail != expectedDetail {
 				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 			}
@@ -596,7 +596,7 @@ func TestIntegration_EKS_UseEast1_Binary(t *testing.T) {
 			}
 
 			// Verify billing detail mentions extended support
-			expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes)"
+			expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 			if resp.BillingDetail != expectedDetail {
 				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 			}
@@ -627,7 +627,7 @@ func TestIntegration_EKS_UseEast1_Binary(t *testing.T) {
 			}
 
 			// Verify billing detail mentions extended support
-			expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes)"
+			expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 			if resp.BillingDetail != expectedDetail {
 				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 			}
@@ -0,0 +1,34 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EnvLambdaARMFallbackDiscount is the fraction (0 to <1) taken off the x86_64 GB-second
+// rate when arm64 Lambda pricing is requested in a region that publishes no arm64 rate.
+// Unset or 0 uses the raw x86_64 rate. AWS list prices put arm64 about 20% below x86_64.
+const EnvLambdaARMFallbackDiscount = "FINFOCUS_LAMBDA_ARM_FALLBACK_DISCOUNT"
+
+// isLambdaARM reports whether a Lambda architecture tag refers to arm64.
+func isLambdaARM(arch string) bool {
+	arch = strings.ToLower(arch)
+	return arch == "arm64" || arch == "arm"
+}
+
+// lambdaGBSecondRate returns the GB-second rate for arch. When arm64 is requested but the
+// region has no arm64 rate, the x86_64 rate less the configured ARM fallback discount is
+// used and note describes the substitution; note is empty otherwise.
+func (p *AWSPublicPlugin) lambdaGBSecondRate(arch string) (rate float64, note string, found bool) {
+	rate, found = p.pricing.LambdaPricePerGBSecond(arch)
+	if !found || !isLambdaARM(arch) || p.pricing.LambdaHasARMPricing() {
+		return rate, "", found
+	}
+
+	if p.lambdaARMFallbackDiscount > 0 {
+		rate *= 1 - p.lambdaARMFallbackDiscount
+		return rate, fmt.Sprintf("arm64 rate unavailable in region, x86_64 rate less %.0f%% used",
+			p.lambdaARMFallbackDiscount*100), true
+	}
+	return rate, "arm64 rate unavailable in region, x86_64 rate used", true
+}
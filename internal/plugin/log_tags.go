@@ -0,0 +1,113 @@
+package plugin
+
+import "strings"
+
+const (
+	// EnvLogTagDenylist adds comma-separated key substrings (case-insensitive) whose tags are
+	// never logged, on top of defaultDeniedTagSubstrings.
+	EnvLogTagDenylist = "FINFOCUS_LOG_TAG_DENYLIST"
+
+	// EnvLogTagAllowlist restricts logged tags to the listed comma-separated keys
+	// (case-insensitive). Denied keys are dropped even when allowlisted.
+	EnvLogTagAllowlist = "FINFOCUS_LOG_TAG_ALLOWLIST"
+
+	// maxTagsToLog bounds the number of tags included in a single log entry.
+	maxTagsToLog = 5
+)
+
+// defaultDeniedTagSubstrings are always redacted from logged tags.
+var defaultDeniedTagSubstrings = []string{"secret", "password", "token"}
+
+// tagSanitizer filters resource tags before they are logged. It is compiled once at
+// startup from EnvLogTagDenylist and EnvLogTagAllowlist and is read-only afterwards.
+type tagSanitizer struct {
+	denied  []string        // lowercase key substrings that are never logged
+	allowed map[string]bool // lowercase keys that may be logged; nil allows all keys
+}
+
+// newTagSanitizer compiles a sanitizer from comma-separated allowlist and denylist values.
+// Empty entries are ignored; an empty allowlist allows every key that is not denied.
+func newTagSanitizer(allowlist, denylist string) *tagSanitizer {
+	s := &tagSanitizer{
+		denied: append(append([]string(nil), defaultDeniedTagSubstrings...), splitTagKeys(denylist)...),
+	}
+	if keys := splitTagKeys(allowlist); len(keys) > 0 {
+		s.allowed = make(map[string]bool, len(keys))
+		for _, key := range keys {
+			s.allowed[key] = true
+		}
+	}
+	return s
+}
+
+// splitTagKeys splits a comma-separated list into trimmed, lowercase, non-empty entries.
+func splitTagKeys(list string) []string {
+	var keys []string
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			keys = append(keys, entry)
+		}
+	}
+	return keys
+}
+
+// sanitize returns a subset of tags suitable for logging. It returns nil if tags is nil.
+// The result contains at most maxTagsToLog entries, excludes denied and non-allowlisted
+// keys, and preserves the original key casing. A nil sanitizer applies only the default
+// denylist.
+func (s *tagSanitizer) sanitize(tags map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+	if s == nil {
+		s = &tagSanitizer{denied: defaultDeniedTagSubstrings}
+	}
+	// Pre-allocate with bounded capacity
+	capacity := len(tags)
+	if capacity > maxTagsToLog {
+		capacity = maxTagsToLog
+	}
+	sanitized := make(map[string]string, capacity)
+	count := 0
+	for k, v := range tags {
+		// Issue #115: Use explicit count tracking
+		if count >= maxTagsToLog {
+			break
+		}
+		if !s.allows(k) {
+			continue
+		}
+		sanitized[k] = v
+		count++
+	}
+	return sanitized
+}
+
+// allows reports whether a tag key may be logged.
+func (s *tagSanitizer) allows(key string) bool {
+	kLower := strings.ToLower(key)
+	for _, denied := range s.denied {
+		if strings.Contains(kLower, denied) {
+			return false
+		}
+	}
+	return s.allowed == nil || s.allowed[kLower]
+}
+
+// sanitizeKeys returns the keys that may be logged, in order, capped at maxTagsToLog. A
+// nil sanitizer applies only the default denylist.
+func (s *tagSanitizer) sanitizeKeys(keys []string) []string {
+	if s == nil {
+		s = &tagSanitizer{denied: defaultDeniedTagSubstrings}
+	}
+	var sanitized []string
+	for _, key := range keys {
+		if len(sanitized) >= maxTagsToLog {
+			break
+		}
+		if s.allows(key) {
+			sanitized = append(sanitized, key)
+		}
+	}
+	return sanitized
+}
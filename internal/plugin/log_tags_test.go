@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestTagSanitizer_Sanitize verifies default, denylist and allowlist redaction rules.
+func TestTagSanitizer_Sanitize(t *testing.T) {
+	tags := map[string]string{
+		"Name":        "web",
+		"env":         "prod",
+		"db_password": "hunter2",
+		"API_TOKEN":   "abc",
+		"internal_id": "42",
+	}
+
+	tests := []struct {
+		name      string
+		allowlist string
+		denylist  string
+		wantKeys  []string
+	}{
+		{name: "defaults", wantKeys: []string{"Name", "env", "internal_id"}},
+		{name: "custom denylist", denylist: "internal, ", wantKeys: []string{"Name", "env"}},
+		{name: "allowlist", allowlist: "name,ENV", wantKeys: []string{"Name", "env"}},
+		{name: "denylist wins over allowlist", allowlist: "name,db_password", wantKeys: []string{"Name"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newTagSanitizer(tt.allowlist, tt.denylist).sanitize(tags)
+			if len(got) != len(tt.wantKeys) {
+				t.Errorf("sanitize() = %v, want keys %v", got, tt.wantKeys)
+			}
+			for _, key := range tt.wantKeys {
+				if got[key] != tags[key] {
+					t.Errorf("sanitize()[%q] = %q, want %q", key, got[key], tags[key])
+				}
+			}
+		})
+	}
+}
+
+// TestTagSanitizer_NilAndLimit verifies nil handling and the maxTagsToLog bound.
+func TestTagSanitizer_NilAndLimit(t *testing.T) {
+	var s *tagSanitizer
+	if got := s.sanitize(nil); got != nil {
+		t.Errorf("sanitize(nil) = %v, want nil", got)
+	}
+	if got := s.sanitize(map[string]string{"secret_key": "x", "env": "dev"}); len(got) != 1 || got["env"] != "dev" {
+		t.Errorf("nil sanitizer should apply default denylist, got %v", got)
+	}
+
+	many := map[string]string{}
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		many[k] = k
+	}
+	if got := newTagSanitizer("", "").sanitize(many); len(got) != maxTagsToLog {
+		t.Errorf("sanitize() returned %d tags, want %d", len(got), maxTagsToLog)
+	}
+}
+
+// TestTagSanitizer_SanitizeKeys verifies key lists drop denied and non-allowlisted keys,
+// keep their order, and are bounded by maxTagsToLog.
+func TestTagSanitizer_SanitizeKeys(t *testing.T) {
+	var s *tagSanitizer
+	if got := s.sanitizeKeys([]string{"db_password", "size"}); !slices.Equal(got, []string{"size"}) {
+		t.Errorf("nil sanitizer sanitizeKeys() = %v, want [size]", got)
+	}
+	if got := newTagSanitizer("size,iops", "").sanitizeKeys([]string{"env", "iops", "size"}); !slices.Equal(got, []string{"iops", "size"}) {
+		t.Errorf("allowlisted sanitizeKeys() = %v, want [iops size]", got)
+	}
+	if got := newTagSanitizer("", "").sanitizeKeys([]string{"a", "b", "c", "d", "e", "f", "g"}); len(got) != maxTagsToLog {
+		t.Errorf("sanitizeKeys() returned %d keys, want %d", len(got), maxTagsToLog)
+	}
+}
+
+// TestTagSanitizer_AppliedToOperationLogs verifies configured redaction is applied
+// wherever tags are logged (GetProjectedCost and GetActualCost).
+func TestTagSanitizer_AppliedToOperationLogs(t *testing.T) {
+	t.Setenv(EnvLogTagDenylist, "owner_email")
+	tags := map[string]string{"owner_email": "ops@example.com", "api_token": "abc", "env": "prod"}
+
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf).Level(zerolog.InfoLevel)
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Sku:          "t3.micro",
+			Region:       "us-east-1",
+			Tags:         tags,
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost() returned error: %v", err)
+	}
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = plugin.GetActualCost(context.Background(), &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON("aws", "ec2", "t3.micro", "us-east-1", tags),
+		Start:      timestamppb.New(from),
+		End:        timestamppb.New(from.Add(24 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("GetActualCost() returned error: %v", err)
+	}
+
+	logs := buf.String()
+	if strings.Count(logs, `"env":"prod"`) < 2 {
+		t.Errorf("allowed tag should be logged by both operations, logs: %s", logs)
+	}
+	for _, denied := range []string{"ops@example.com", `"api_token"`} {
+		if strings.Contains(logs, denied) {
+			t.Errorf("logs should not contain %s, logs: %s", denied, logs)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// Metric names are finfocus_aws_public_<name>, kept apart from the SDK's
+// finfocus_plugin_* interceptor metrics so both can share a registry.
+const (
+	metricsNamespace = "finfocus"
+	metricsSubsystem = "aws_public"
+)
+
+// Resource type label values for requests that do not name a single known service.
+const (
+	metricsResourceBatch   = "batch"
+	metricsResourceUnknown = "unknown"
+)
+
+// metricsRegionUnknown labels carbon estimated for a region without a grid factor.
+const metricsRegionUnknown = "unknown"
+
+// Metrics holds the plugin's per-operation Prometheus collectors. Every series is
+// labeled by operation (the RPC name) and resource_type (the normalized service, e.g.
+// "ec2"), which makes requests_total the per-service request counter. The carbon
+// counter is labeled by region and resource_type instead.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	errors   *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	carbon   *prometheus.CounterVec
+}
+
+// NewMetrics creates the plugin's collectors and registers them with reg.
+// It panics if they are already registered, like prometheus.MustRegister.
+func NewMetrics(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "requests_total",
+			Help:      "Total plugin requests by operation and resource type.",
+		}, []string{"operation", "resource_type"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "errors_total",
+			Help:      "Total failed plugin requests by operation, resource type and gRPC code.",
+		}, []string{"operation", "resource_type", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "request_duration_seconds",
+			Help:      "Plugin request duration in seconds by operation and resource type.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "resource_type"}),
+		carbon: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "estimated_carbon_grams_total",
+			Help:      "Cumulative estimated monthly carbon footprint in gCO2e by region and resource type.",
+		}, []string{"region", "resource_type"}),
+	}
+	reg.MustRegister(m.requests, m.errors, m.duration, m.carbon)
+	return m
+}
+
+// SetMetrics enables Prometheus instrumentation of the plugin's RPCs. It must be
+// called before the plugin starts serving; a nil m disables instrumentation.
+func (p *AWSPublicPlugin) SetMetrics(m *Metrics) {
+	p.metrics = m
+}
+
+// observe records one request. It is a no-op on a nil Metrics.
+func (m *Metrics) observe(operation, resourceType string, elapsed time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	m.requests.WithLabelValues(operation, resourceType).Inc()
+	m.duration.WithLabelValues(operation, resourceType).Observe(elapsed.Seconds())
+	if err != nil {
+		m.errors.WithLabelValues(operation, resourceType, status.Code(err).String()).Inc()
+	}
+}
+
+// observeCarbon adds the carbon footprint of an estimate to the carbon counter. Estimates
+// without one, or with a carbon-unavailable placeholder, are skipped. It is a no-op on a
+// nil Metrics.
+func (m *Metrics) observeCarbon(region, resourceType string, resp *pbc.GetProjectedCostResponse) {
+	if m == nil {
+		return
+	}
+	grams, ok := carbonFootprint(resp)
+	if !ok || grams <= 0 {
+		return
+	}
+	m.carbon.WithLabelValues(metricsRegion(region), resourceType).Add(grams)
+}
+
+// metricsRegion maps a request's region to a bounded label value: the region if it has
+// a grid emission factor, "unknown" otherwise.
+func metricsRegion(region string) string {
+	if _, ok := carbon.GridEmissionFactors[region]; ok {
+		return region
+	}
+	return metricsRegionUnknown
+}
+
+// metricsResourceType maps a request's resource type to a bounded label value:
+// the normalized service for supported and zero-cost services, "unknown" otherwise,
+// so arbitrary client input cannot create unbounded series.
+func metricsResourceType(resourceType string) string {
+	if resourceType == "" {
+		return metricsResourceUnknown
+	}
+	service := detectService(normalizeResourceType(resourceType))
+	switch service {
+	case "ec2", "ebs", "rds", "s3", "lambda", "dynamodb", "eks", "elb", "natgw",
+		"cloudwatch", "elasticache", "ecr", "secretsmanager", "kms", "waf", "athena", "glue", "ses", "stepfunctions":
+		return service
+	}
+	if IsZeroCostService(service) {
+		return service
+	}
+	return metricsResourceUnknown
+}
@@ -0,0 +1,138 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestMetrics_CountersIncrement verifies requests, errors and durations are recorded per
+// operation and normalized resource type.
+func TestMetrics_CountersIncrement(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	reg := prometheus.NewRegistry()
+	m := NewMetrics(reg)
+	plugin.SetMetrics(m)
+
+	ctx := context.Background()
+	ec2 := &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: "aws:ec2/instance:Instance",
+		Sku:          "t3.micro",
+		Region:       "us-east-1",
+	}
+	for range 2 {
+		if _, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: ec2}); err != nil {
+			t.Fatalf("GetProjectedCost() error: %v", err)
+		}
+	}
+	if _, err := plugin.Supports(ctx, &pbc.SupportsRequest{Resource: ec2}); err != nil {
+		t.Fatalf("Supports() error: %v", err)
+	}
+	if _, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{}); err == nil {
+		t.Fatal("GetProjectedCost() with no resource succeeded, want error")
+	}
+
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("GetProjectedCost", "ec2")); got != 2 {
+		t.Errorf("GetProjectedCost/ec2 requests = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("Supports", "ec2")); got != 1 {
+		t.Errorf("Supports/ec2 requests = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.errors.WithLabelValues("GetProjectedCost", "ec2", "InvalidArgument")); got != 0 {
+		t.Errorf("GetProjectedCost/ec2 errors = %v, want 0", got)
+	}
+	if got := testutil.ToFloat64(m.errors.WithLabelValues("GetProjectedCost", metricsResourceUnknown, "InvalidArgument")); got != 1 {
+		t.Errorf("GetProjectedCost/unknown InvalidArgument errors = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.duration); got != 3 {
+		t.Errorf("duration series = %d, want 3 (GetProjectedCost/ec2, Supports/ec2, GetProjectedCost/unknown)", got)
+	}
+}
+
+// TestMetrics_CarbonCounter verifies the carbon counter increments by each estimate's
+// carbon footprint, labeled by region and resource type.
+func TestMetrics_CarbonCounter(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	m := NewMetrics(prometheus.NewRegistry())
+	plugin.SetMetrics(m)
+
+	var want float64
+	for range 2 {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() error: %v", err)
+		}
+		grams, ok := carbonFootprint(resp)
+		if !ok || grams <= 0 {
+			t.Fatalf("expected a carbon footprint, got %v", resp.ImpactMetrics)
+		}
+		want += grams
+	}
+
+	if got := testutil.ToFloat64(m.carbon.WithLabelValues("us-east-1", "ec2")); math.Abs(got-want) > 1e-9 {
+		t.Errorf("us-east-1/ec2 carbon = %v, want %v", got, want)
+	}
+	if got := testutil.CollectAndCount(m.carbon); got != 1 {
+		t.Errorf("carbon series = %d, want 1", got)
+	}
+}
+
+// TestMetrics_Disabled verifies a plugin without metrics serves requests normally.
+func TestMetrics_Disabled(t *testing.T) {
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+	if _, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{}); err != nil {
+		t.Fatalf("Supports() error: %v", err)
+	}
+}
+
+// TestMetricsResourceType verifies label values are bounded to known services.
+func TestMetricsResourceType(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"ec2", "ec2"},
+		{"aws:ec2/instance:Instance", "ec2"},
+		{"aws:lb/loadBalancer:LoadBalancer", "elb"},
+		{"aws:ec2/vpc:Vpc", "vpc"},
+		{"aws:sqs/queue:Queue", metricsResourceUnknown},
+		{"", metricsResourceUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := metricsResourceType(tt.resourceType); got != tt.want {
+			t.Errorf("metricsResourceType(%q) = %q, want %q", tt.resourceType, got, tt.want)
+		}
+	}
+}
+
+// TestMetricsRegion verifies region label values are bounded to regions with grid factors.
+func TestMetricsRegion(t *testing.T) {
+	for region, want := range map[string]string{
+		"us-east-1":  "us-east-1",
+		"eu-north-1": "eu-north-1",
+		"xx-fake-9":  metricsRegionUnknown,
+		"":           metricsRegionUnknown,
+	} {
+		if got := metricsRegion(region); got != want {
+			t.Errorf("metricsRegion(%q) = %q, want %q", region, got, want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// EnvEmitMinorUnits enables an integer representation of CostPerMonth in minor
+	// currency units (cents), for clients that want to avoid float comparisons.
+	EnvEmitMinorUnits = "FINFOCUS_EMIT_MINOR_UNITS"
+
+	// costMinorUnitsHeaderKey carries CostPerMonth in minor units as a gRPC response header.
+	// GetProjectedCostResponse has no integer cost field, so it is sent out-of-band; the
+	// float fields are unchanged.
+	costMinorUnitsHeaderKey = "finfocus-cost-per-month-minor-units"
+
+	// minorUnitsPerMajor is the number of minor units in one unit of currency (USD cents).
+	minorUnitsPerMajor = 100
+)
+
+// toMinorUnits converts an amount in major currency units to integer minor units
+// (cents), rounding half-up (ties toward positive infinity): 1.234 -> 123, 0.125 -> 13.
+// Rounding applies to the binary float value, so an amount such as 1.005 that is
+// stored just below the midpoint rounds down.
+func toMinorUnits(amount float64) int64 {
+	return int64(math.Floor(amount*minorUnitsPerMajor + 0.5))
+}
+
+// setMinorUnitsHeader sends resp.CostPerMonth in minor units as a response header
+// when EnvEmitMinorUnits is enabled. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setMinorUnitsHeader(ctx context.Context, traceID string, resp *pbc.GetProjectedCostResponse) {
+	if !p.features.EmitMinorUnits || resp == nil {
+		return
+	}
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+
+	value := strconv.FormatInt(toMinorUnits(resp.CostPerMonth), 10)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(costMinorUnitsHeaderKey, value)); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set minor units header")
+	}
+}
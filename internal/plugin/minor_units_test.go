@@ -0,0 +1,70 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestToMinorUnits verifies half-up rounding to cents.
+func TestToMinorUnits(t *testing.T) {
+	tests := []struct {
+		amount float64
+		want   int64
+	}{
+		{0, 0},
+		{7.592, 759},
+		{7.595, 760},
+		{0.125, 13},
+		{1.994, 199},
+		{70.08, 7008},
+		{-0.125, -12},
+	}
+
+	for _, tt := range tests {
+		if got := toMinorUnits(tt.amount); got != tt.want {
+			t.Errorf("toMinorUnits(%v) = %d, want %d", tt.amount, got, tt.want)
+		}
+	}
+}
+
+// TestGetProjectedCost_MinorUnitsHeader verifies the cents header is only sent when enabled.
+func TestGetProjectedCost_MinorUnitsHeader(t *testing.T) {
+	for _, enabled := range []string{"true", ""} {
+		t.Run("enabled="+enabled, func(t *testing.T) {
+			t.Setenv(EnvEmitMinorUnits, enabled)
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			values := stream.header.Get(costMinorUnitsHeaderKey)
+			if enabled == "" {
+				if len(values) != 0 {
+					t.Errorf("header %s = %v, want none when disabled", costMinorUnitsHeaderKey, values)
+				}
+				return
+			}
+			// 0.0104 * 730 = 7.592 -> 759 cents
+			if len(values) != 1 || values[0] != "759" {
+				t.Errorf("header %s = %v, want [759] (CostPerMonth %v)", costMinorUnitsHeaderKey, values, resp.CostPerMonth)
+			}
+		})
+	}
+}
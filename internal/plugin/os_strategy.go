@@ -0,0 +1,72 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// tagOSStrategy selects how an EC2 instance without a platform tag is priced.
+const tagOSStrategy = "os_strategy"
+
+// OS strategies for EC2 instances without a platform tag.
+const (
+	// osStrategyLinux prices them as Linux (the default).
+	osStrategyLinux = "linux"
+	// osStrategyCheapest prices them at the lowest rate among the indexed OS variants.
+	osStrategyCheapest = "cheapest"
+	// osStrategyError rejects them with InvalidArgument.
+	osStrategyError = "error"
+)
+
+// ec2OSVariants are the normalized operating systems ExtractEC2AttributesFromTags can
+// return, in the order the cheapest strategy breaks ties.
+var ec2OSVariants = []string{"Linux", "Windows", "RHEL", "SUSE"}
+
+// resolveEC2OS applies the os_strategy tag to an instance's attributes. With a platform
+// tag, or under the linux strategy, attrs are returned unchanged. Under cheapest, the OS
+// becomes the lowest-priced variant indexed for the instance type and tenancy, and note
+// discloses the choice for the billing detail. Unknown strategies are logged and treated
+// as linux.
+func (p *AWSPublicPlugin) resolveEC2OS(
+	traceID, instanceType string, resource *pbc.ResourceDescriptor, attrs EC2Attributes,
+) (resolved EC2Attributes, note string, err error) {
+	if strings.TrimSpace(p.tagValue(resource, "platform")) != "" {
+		return attrs, "", nil
+	}
+
+	strategy := strings.ToLower(strings.TrimSpace(p.tagValue(resource, tagOSStrategy)))
+	switch strategy {
+	case "", osStrategyLinux:
+		return attrs, "", nil
+	case osStrategyError:
+		return attrs, "", p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("EC2 platform not specified: set the 'platform' tag (%s is %q)", tagOSStrategy, osStrategyError),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	case osStrategyCheapest:
+		cheapestRate := 0.0
+		cheapestOS := ""
+		for _, osName := range ec2OSVariants {
+			rate, found := p.pricing.EC2OnDemandPricePerHour(instanceType, osName, attrs.Tenancy)
+			if found && (cheapestOS == "" || rate < cheapestRate) {
+				cheapestRate, cheapestOS = rate, osName
+			}
+		}
+		if cheapestOS == "" {
+			// Nothing indexed; the Linux lookup reports the instance type as not found
+			return attrs, "", nil
+		}
+		attrs.OS = cheapestOS
+		return attrs, fmt.Sprintf(", %s is the cheapest OS (platform not set)", cheapestOS), nil
+	default:
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagOSStrategy).
+			Str("value", strategy).
+			Msg("unknown OS strategy, pricing as Linux")
+		return attrs, "", nil
+	}
+}
@@ -0,0 +1,105 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_OSStrategy verifies each os_strategy for EC2 instances without a
+// platform tag, and that an explicit platform always wins.
+func TestGetProjectedCost_OSStrategy(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m5.large/Windows/Shared"] = 0.188
+	// Only a commercial OS is indexed for this type, so cheapest differs from Linux
+	mock.ec2Prices["x9.large/RHEL/Shared"] = 0.30
+	mock.ec2Prices["x9.large/SUSE/Shared"] = 0.25
+	// m1 is retired and estimated as m5; a cheaper non-Linux variant is only found when
+	// the strategy runs on the successor
+	mock.ec2Prices["m5.xlarge/Linux/Shared"] = 0.192
+	mock.ec2Prices["m5.xlarge/SUSE/Shared"] = 0.15
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		sku        string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+		wantNote   bool
+	}{
+		{name: "default is linux", sku: "m5.large", wantCost: 0.096 * 730, wantDetail: "On-demand Linux"},
+		{name: "explicit linux", sku: "m5.large", tags: map[string]string{tagOSStrategy: "linux"}, wantCost: 0.096 * 730, wantDetail: "On-demand Linux"},
+		{name: "unknown strategy falls back to linux", sku: "m5.large", tags: map[string]string{tagOSStrategy: "priciest"}, wantCost: 0.096 * 730, wantDetail: "On-demand Linux"},
+		{
+			name: "cheapest picks linux", sku: "m5.large", tags: map[string]string{tagOSStrategy: "cheapest"},
+			wantCost: 0.096 * 730, wantDetail: "On-demand Linux", wantNote: true,
+		},
+		{
+			name: "cheapest picks lowest indexed variant", sku: "x9.large", tags: map[string]string{tagOSStrategy: "Cheapest"},
+			wantCost: 0.25 * 730, wantDetail: "On-demand SUSE", wantNote: true,
+		},
+		{
+			name: "cheapest runs on the successor of a retired family", sku: "m1.xlarge", tags: map[string]string{tagOSStrategy: "cheapest"},
+			wantCost: 0.15 * 730, wantDetail: "On-demand SUSE", wantNote: true,
+		},
+		{
+			name: "platform tag wins over strategy", sku: "m5.large",
+			tags:     map[string]string{tagOSStrategy: "cheapest", "platform": "windows"},
+			wantCost: 0.188 * 730, wantDetail: "On-demand Windows",
+		},
+		{
+			name: "error strategy accepts a platform", sku: "m5.large",
+			tags:     map[string]string{tagOSStrategy: "error", "platform": "linux"},
+			wantCost: 0.096 * 730, wantDetail: "On-demand Linux",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 1e-9 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.HasPrefix(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want prefix %q", resp.BillingDetail, tt.wantDetail)
+			}
+			if got := strings.Contains(resp.BillingDetail, "is the cheapest OS (platform not set)"); got != tt.wantNote {
+				t.Errorf("BillingDetail = %q, cheapest note present = %v, want %v", resp.BillingDetail, got, tt.wantNote)
+			}
+		})
+	}
+
+	t.Run("error rejects a missing platform", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "m5.large",
+				Region:       "us-east-1",
+				Tags:         map[string]string{tagOSStrategy: "error"},
+			},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("error = %v, want InvalidArgument", err)
+		}
+	})
+}
@@ -22,14 +22,27 @@ import (
 
 // AWSPublicPlugin implements the pluginsdk.Plugin interface for AWS public pricing.
 type AWSPublicPlugin struct {
-	region           string
-	version          string
-	pricing          pricing.PricingClient
-	carbonEstimator  carbon.CarbonEstimator
-	logger           zerolog.Logger // logger is immutable (copy-on-write)
-	testMode         bool           // true when FINFOCUS_TEST_MODE=true
-	maxBatchSize     int            // configured max batch size for recommendations (read-only after init)
-	strictValidation bool           // fail-fast on invalid resources in recommendations (read-only after init)
+	region                    string
+	version                   string
+	pricing                   pricing.PricingClient
+	carbonEstimator           carbon.CarbonEstimator
+	logger                    zerolog.Logger          // logger is immutable (copy-on-write)
+	testMode                  bool                    // true when FINFOCUS_TEST_MODE=true
+	maxBatchSize              int                     // configured max batch size for recommendations (read-only after init)
+	recommendationWorkers     int                     // resources processed concurrently per recommendation batch (read-only after init)
+	features                  Features                // environment-driven feature switches (read-only after init)
+	minMonthlySavings         float64                 // default minimum savings for recommendations (read-only after init)
+	lambdaARMFallbackDiscount float64                 // discount applied to x86_64 Lambda rates standing in for arm64 (read-only after init)
+	defaultUtilization        float64                 // utilization assumed for carbon when none is supplied; 0 uses the CCF default (read-only after init)
+	defaultRegion             string                  // region a fallback build answers for; "" when unset (set before serving)
+	carbonCoverage            carbonCoverage          // CCF instance spec coverage of the priced EC2 types (read-only after init)
+	pricingFreshness          pricingFreshness        // age of the embedded EC2 pricing at startup (read-only after init)
+	enabledServices           map[string]bool         // service allowlist; nil enables every service (read-only after init)
+	supportLevels             map[string]SupportLevel // overrides ServiceSupportLevels per service; nil uses the table (read-only after init)
+	tagSanitizer              *tagSanitizer           // filters tags before logging (read-only after init)
+	tagReads                  *tagReadTracker         // tag keys estimators read, for the tag usage log
+	clock                     clock                   // time source for duration_ms logging (read-only after init)
+	metrics                   *Metrics                // Prometheus collectors; nil disables instrumentation (set before serving)
 }
 
 // NewAWSPublicPlugin creates and returns a configured AWSPublicPlugin for the given AWS region.
@@ -82,22 +95,145 @@ func NewAWSPublicPlugin(region string, version string, pricingClient pricing.Pri
 		}
 	}
 
-	// Check for strict validation (new variable takes precedence over deprecated)
-	var strictValidation bool
-	val, _, found = getEnvWithDeprecation(logger, EnvStrictValidation, EnvStrictValidationDeprecated, EnvStrictValidationLegacy)
-	if found {
-		strictValidation = parseBoolVal(val)
+	// Check for recommendation batch concurrency (1 processes resources serially)
+	recommendationWorkers := defaultRecommendationWorkers
+	if val := os.Getenv(EnvRecommendationWorkers); val != "" {
+		n, err := strconv.Atoi(val)
+		switch {
+		case err != nil || n <= 0:
+			logger.Warn().
+				Str("variable", EnvRecommendationWorkers).
+				Str("value", val).
+				Msg("invalid recommendation worker count, processing batches serially")
+		case n > maxRecommendationWorkers:
+			logger.Warn().
+				Str("variable", EnvRecommendationWorkers).
+				Int("requested", n).
+				Int("max_allowed", maxRecommendationWorkers).
+				Msg("requested recommendation worker count exceeds maximum, capping")
+			recommendationWorkers = maxRecommendationWorkers
+		default:
+			recommendationWorkers = n
+		}
+	}
+
+	// Read feature switches (strict validation, region fallback, minor units)
+	features := LoadFeatures(logger)
+
+	// Check for minimum monthly savings threshold (0 keeps every recommendation)
+	var minMonthlySavings float64
+	if val := os.Getenv(EnvMinMonthlySavings); val != "" {
+		if f, err := strconv.ParseFloat(val, 64); err == nil && f >= 0 {
+			minMonthlySavings = f
+		} else {
+			logger.Warn().
+				Str("variable", EnvMinMonthlySavings).
+				Str("value", val).
+				Msg("invalid minimum monthly savings value, using default")
+		}
 	}
 
+	// Region fallback is only meaningful for fallback builds
+	if features.AllowRegionFallback && region != fallbackBuildRegion {
+		logger.Warn().
+			Str("variable", EnvAllowRegionFallback).
+			Str("aws_region", region).
+			Msg("region fallback only applies to fallback builds, ignoring")
+	}
+
+	// Check for Lambda arm64 fallback discount (0 uses the raw x86_64 rate)
+	var lambdaARMFallbackDiscount float64
+	if val := os.Getenv(EnvLambdaARMFallbackDiscount); val != "" {
+		if f, err := strconv.ParseFloat(val, 64); err == nil && f >= 0 && f < 1 {
+			lambdaARMFallbackDiscount = f
+		} else {
+			logger.Warn().
+				Str("variable", EnvLambdaARMFallbackDiscount).
+				Str("value", val).
+				Msg("invalid Lambda ARM fallback discount, must be in [0, 1), using raw x86_64 rate")
+		}
+	}
+
+	// Check for a baseline utilization for carbon estimates (unset uses the CCF default)
+	var defaultUtilization float64
+	if val := os.Getenv(EnvDefaultUtilization); val != "" {
+		if f, err := strconv.ParseFloat(val, 64); err == nil && f > 0 && f <= 1 {
+			defaultUtilization = f
+		} else {
+			logger.Warn().
+				Str("variable", EnvDefaultUtilization).
+				Str("value", val).
+				Msg("invalid default utilization, must be in (0, 1], using 50% default")
+		}
+	}
+
+	// Check for the region a fallback build answers for
+	var defaultRegion string
+	if val, varName, found := getEnvWithDeprecation(logger, EnvDefaultRegion, EnvDefaultRegionDeprecated, ""); found {
+		switch {
+		case !regionCodeRE.MatchString(val):
+			logger.Warn().
+				Str("variable", varName).
+				Str("value", val).
+				Msg("invalid default region, must be an AWS region code such as us-east-1, ignoring")
+		case region != fallbackBuildRegion:
+			logger.Warn().
+				Str("variable", varName).
+				Str("aws_region", region).
+				Msg("default region only applies to fallback builds, ignoring")
+		default:
+			defaultRegion = val
+			logger.Info().
+				Str("default_region", defaultRegion).
+				Str("reference_region", referencePricingRegion).
+				Msg("fallback build answering for default region")
+		}
+	}
+
+	// Quantify carbon coverage gaps so stale CCF data is visible at startup
+	carbonCoverage := checkCarbonCoverage(pricingClient, logger)
+
+	// Warn when the embedded pricing is older than the configured age threshold
+	maxPricingAgeDays := defaultPricingMaxAgeDays
+	if val := os.Getenv(EnvPricingMaxAgeDays); val != "" {
+		if n, err := strconv.Atoi(val); err == nil && n > 0 {
+			maxPricingAgeDays = n
+		} else {
+			logger.Warn().
+				Str("variable", EnvPricingMaxAgeDays).
+				Str("value", val).
+				Int("default", defaultPricingMaxAgeDays).
+				Msg("invalid pricing max age, must be a positive number of days, using default")
+		}
+	}
+	pricingFreshness := checkPricingFreshness(pricingClient, time.Now(), maxPricingAgeDays, logger)
+
+	// Restrict the services this binary answers for (unset enables all)
+	enabledServices := parseEnabledServices(os.Getenv(EnvEnabledServices), logger)
+
+	// Compile log tag redaction rules
+	tagSanitizer := newTagSanitizer(os.Getenv(EnvLogTagAllowlist), os.Getenv(EnvLogTagDenylist))
+
 	return &AWSPublicPlugin{
-		region:           region,
-		version:          version,
-		pricing:          pricingClient,
-		carbonEstimator:  carbon.NewEstimator(),
-		logger:           logger,
-		testMode:         testMode,
-		maxBatchSize:     maxBatchSize,
-		strictValidation: strictValidation,
+		region:                    region,
+		version:                   version,
+		pricing:                   pricingClient,
+		carbonEstimator:           carbon.NewEstimator(),
+		logger:                    logger,
+		testMode:                  testMode,
+		maxBatchSize:              maxBatchSize,
+		recommendationWorkers:     recommendationWorkers,
+		features:                  features,
+		minMonthlySavings:         minMonthlySavings,
+		lambdaARMFallbackDiscount: lambdaARMFallbackDiscount,
+		defaultUtilization:        defaultUtilization,
+		defaultRegion:             defaultRegion,
+		carbonCoverage:            carbonCoverage,
+		pricingFreshness:          pricingFreshness,
+		enabledServices:           enabledServices,
+		tagSanitizer:              tagSanitizer,
+		tagReads:                  &tagReadTracker{},
+		clock:                     wallClock{},
 	}
 }
 
@@ -167,41 +303,6 @@ func (p *AWSPublicPlugin) getTraceID(ctx context.Context) string {
 	return uuid.New().String()
 }
 
-const maxTagsToLog = 5
-
-// sanitizeTagsForLogging returns a sanitized subset of the input tags suitable for logging.
-// It returns nil if tags is nil. The result contains at most maxTagsToLog entries,
-// excludes any entries whose key (case-insensitive) contains "secret", "password", or "token",
-// and preserves the original key casing for included entries.
-func sanitizeTagsForLogging(tags map[string]string) map[string]string {
-	if tags == nil {
-		return nil
-	}
-	// Pre-allocate with bounded capacity
-	capacity := len(tags)
-	if capacity > maxTagsToLog {
-		capacity = maxTagsToLog
-	}
-	sanitized := make(map[string]string, capacity)
-	count := 0
-	for k, v := range tags {
-		// Issue #115: Use explicit count tracking
-		if count >= maxTagsToLog {
-			break
-		}
-		kLower := strings.ToLower(k)
-		// Skip known sensitive keys
-		if strings.Contains(kLower, "secret") ||
-			strings.Contains(kLower, "password") ||
-			strings.Contains(kLower, "token") {
-			continue
-		}
-		sanitized[k] = v
-		count++
-	}
-	return sanitized
-}
-
 // logErrorWithID logs an error using a pre-captured trace ID.
 // Use this when you've already extracted the trace ID to ensure consistency
 // between error objects and log entries.
@@ -263,15 +364,40 @@ func (p *AWSPublicPlugin) GetPluginInfo(ctx context.Context, _ *pbc.GetPluginInf
 	p.traceLogger(traceID, "GetPluginInfo").Info().
 		Msg("providing plugin info")
 
+	info := map[string]string{
+		"region": p.region,
+		"type":   "public-pricing-fallback",
+	}
+	if regionsJSON := p.regionsJSON(); regionsJSON != "" {
+		info[regionsMetadataKey] = regionsJSON
+	}
+	if coverage := p.carbonCoverage.json(); coverage != "" {
+		info[carbonCoverageMetadataKey] = coverage
+	}
+	if freshness := p.pricingFreshness.json(); freshness != "" {
+		info[pricingFreshnessMetadataKey] = freshness
+	}
+	if p.enabledServices != nil {
+		info[enabledServicesMetadataKey] = joinServices(p.enabledServices)
+	}
+	if drift := p.pricing.SchemaDrift(); len(drift) > 0 {
+		// JSON array, like the regions listing
+		if encoded, err := json.Marshal(drift); err == nil {
+			info[schemaDriftMetadataKey] = string(encoded)
+		}
+	}
+	if inconsistencies := p.pricing.PriceInconsistencies(); len(inconsistencies) > 0 {
+		if encoded, err := json.Marshal(inconsistencies); err == nil {
+			info[priceInconsistenciesMetadataKey] = string(encoded)
+		}
+	}
+
 	return &pbc.GetPluginInfoResponse{
 		Name:        p.Name(),
 		Version:     p.version,
 		SpecVersion: pluginsdk.SpecVersion,
 		Providers:   []string{"aws"},
-		Metadata: map[string]string{
-			"region": p.region,
-			"type":   "public-pricing-fallback",
-		},
+		Metadata:    info,
 	}, nil
 }
 
@@ -281,10 +407,16 @@ func (p *AWSPublicPlugin) GetPluginInfo(ctx context.Context, _ *pbc.GetPluginInf
 // The proto API uses ResourceId (string) which we expect to be a JSON-encoded
 // ResourceDescriptor. If ResourceId is empty, we fall back to extracting
 // resource info from the Tags map.
-func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualCostRequest) (*pbc.GetActualCostResponse, error) {
-	start := time.Now()
+func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualCostRequest) (_ *pbc.GetActualCostResponse, err error) {
+	start := p.clock.Now()
 	traceID := p.getTraceID(ctx)
 
+	// The resource type is only known once ResourceId or Tags have been decoded
+	resourceType := ""
+	defer func() {
+		p.metrics.observe("GetActualCost", metricsResourceType(resourceType), p.since(start), err)
+	}()
+
 	// Validate request, resolve timestamps, and extract resource
 	// Note: ValidateActualCostRequest now returns TimestampResolution for confidence tracking (Feature 016)
 	resource, resolution, err := p.ValidateActualCostRequest(ctx, req)
@@ -297,6 +429,7 @@ func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualC
 	// Create resolver after validation to cache service type across FOCUS record and routing.
 	// This ensures detectService() is called exactly once per request (SC-002).
 	resolver := newServiceResolver(resource.ResourceType)
+	resourceType = resource.ResourceType
 
 	// Determine confidence level from resolution (Feature 016)
 	confidence := determineConfidence(resolution)
@@ -341,7 +474,7 @@ func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualC
 			Float64("usage_amount", runtimeHours).
 			Str("usage_unit", "hours").
 			Str("confidence", string(confidence)).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("cost calculated")
 
 		return &pbc.GetActualCostResponse{
@@ -364,7 +497,7 @@ func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualC
 	}
 
 	// Get projected monthly cost using helper (pass resolver to reuse cached service type)
-	projectedResp, err := p.getProjectedForResource(traceID, resource, resolver)
+	projectedResp, err := p.getProjectedForResource(ctx, traceID, resource, resolver)
 	if err != nil {
 		// Extract error code from gRPC status to preserve context
 		errCode := extractErrorCode(err)
@@ -400,13 +533,13 @@ func (p *AWSPublicPlugin) GetActualCost(ctx context.Context, req *pbc.GetActualC
 		Str(pluginsdk.FieldResourceType, resource.ResourceType).
 		Str("aws_service", resource.ResourceType).
 		Str("aws_region", resource.Region).
-		Interface("tags", sanitizeTagsForLogging(resource.Tags)).
+		Interface("tags", p.tagSanitizer.sanitize(resource.Tags)).
 		Float64("cost_monthly", actualCost).
 		Float64("usage_amount", runtimeHours).
 		Str("usage_unit", "hours").
 		Str("confidence", string(confidence)).
 		Str("resolution_source", resolution.Source).
-		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 		Msg("cost calculated")
 
 	return &pbc.GetActualCostResponse{
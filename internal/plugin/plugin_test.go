@@ -6,8 +6,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -23,50 +25,92 @@ type mockPricingClient struct {
 	region                string
 	currency              string
 	ec2Prices             map[string]float64 // key: "instanceType/os/tenancy"
+	ec2Network            map[string]string  // key: "instanceType", network performance
+	ec2InterAZPrice       float64            // EC2 inter-AZ data transfer rate per GB
 	ebsPrices             map[string]float64 // key: "volumeType"
+	ebsSnapshotPrice      float64            // EBS snapshot storage rate per GB-month
+	ebsIOPSPrices         map[string]float64 // key: "volumeType", rate per IOPS-month
+	ebsThroughputPrices   map[string]float64 // key: "volumeType", rate per MiB/s-month
+	io2IOPSTiers          []pricing.TierRate // io2 tiered IOPS rates; nil prices io2 at the flat rate
 	s3Prices              map[string]float64 // key: "storageClass"
+	s3ITMonitoringPrice   float64            // S3 Intelligent-Tiering monitoring rate per object-month
+	s3RetrievalPrices     map[string]float64 // key: "storageClass", retrieval rate per GB
 	rdsInstancePrices     map[string]float64 // key: "instanceType/engine"
 	rdsStoragePrices      map[string]float64 // key: "volumeType"
+	rdsReservedPrices     map[string]float64 // key: "instanceType/engine", 1yr No Upfront hourly rate
+	rdsIOOptimizedPrices  map[string]float64 // key: "instanceType/engine", Aurora I/O-Optimized hourly rate
+	auroraIORequestPrice  float64            // Aurora Standard cost per I/O request
 	lambdaPrices          map[string]float64 // key: "request" or "gb-second"
 	dynamoDBPrices        map[string]float64 // key: "on-demand-read", "on-demand-write", "provisioned-rcu", "provisioned-wcu", "storage"
 	eksStandardPrice      float64            // EKS cluster standard support hourly rate
 	eksExtendedPrice      float64            // EKS cluster extended support hourly rate
+	eksFargateVCPUPrice   float64            // EKS Fargate pod rate per vCPU-hour
+	eksFargateGBPrice     float64            // EKS Fargate pod rate per GB-hour
+	pricingVersions       map[string]string  // key: offerCode, embedded data version
+	pricingDates          map[string]string  // key: offerCode, embedded data publication date
+	schemaDrift           []string           // "offerCode/family" entries reported by SchemaDrift
+	priceInconsistencies  []string           // "offerCode: detail" entries reported by PriceInconsistencies
 	albHourlyPrice        float64            // ALB fixed hourly rate
 	albLCUPrice           float64            // ALB cost per LCU-hour
 	nlbHourlyPrice        float64            // NLB fixed hourly rate
 	nlbNLCUPrice          float64            // NLB cost per NLCU-hour
+	elbOutpostsPrices     map[string]float64 // key: "alb/hourly", "alb/cu", "nlb/hourly", "nlb/cu"
 	natgwHourlyPrice      float64            // NAT Gateway hourly rate
 	natgwDataPrice        float64            // NAT Gateway data processing rate
 	cwLogsIngestionTiers  []pricing.TierRate // CloudWatch logs ingestion tiers
 	cwLogsStorageRate     float64            // CloudWatch logs storage rate per GB-month
 	cwMetricsTiers        []pricing.TierRate // CloudWatch custom metrics tiers
 	elasticachePrices     map[string]float64 // key: "nodeType:engine" (e.g., "cache.m5.large:Redis")
-	ec2OnDemandCalled     int
-	ebsPriceCalled        int
-	s3PriceCalled         int
-	rdsOnDemandCalled     int
-	rdsStoragePriceCalled int
-	eksPriceCalled        int
-	lambdaRequestCalled   int
-	lambdaGBSecondCalled  int
-	dynamoDBCalled        int
-	elbCalled             int
-	natgwCalled           int
+	ecrStoragePrice       float64            // ECR storage rate per GB-month
+	ecrDataTransferPrice  float64            // ECR data transfer out rate per GB
+	secretPrice           float64            // Secrets Manager rate per secret-month
+	secretAPICallPrice    float64            // Secrets Manager rate per API call
+	kmsKeyPrice           float64            // KMS rate per key-month
+	kmsRequestPrice       float64            // KMS rate per request beyond the free tier
+	wafWebACLPrice        float64            // WAF rate per web ACL-month
+	wafRulePrice          float64            // WAF rate per rule-month
+	wafRequestPrice       float64            // WAF rate per inspected request
+	athenaTBPrice         float64            // Athena rate per TB scanned
+	glueDPUHourPrices     map[string]float64 // key: job type ("etl", "flex", "crawler")
+	sesEmailPrice         float64            // SES rate per outbound email
+	sesAttachmentGBPrice  float64            // SES rate per GB of attachments
+	sfnTransitionPrice    float64            // Step Functions Standard rate per state transition
+	sfnExpressRequest     float64            // Step Functions Express rate per request
+	sfnExpressGBSecond    float64            // Step Functions Express rate per GB-second
+	ec2OnDemandCalled     atomic.Int64
+	ebsPriceCalled        atomic.Int64
+	s3PriceCalled         atomic.Int64
+	rdsOnDemandCalled     atomic.Int64
+	rdsStoragePriceCalled atomic.Int64
+	eksPriceCalled        atomic.Int64
+	lambdaRequestCalled   atomic.Int64
+	lambdaGBSecondCalled  atomic.Int64
+	dynamoDBCalled        atomic.Int64
+	elbCalled             atomic.Int64
+	natgwCalled           atomic.Int64
+	// vintages holds dated snapshot pricing (key: vintage, e.g., "2024-01")
+	vintages map[string]*mockPricingClient
 }
 
 // newMockPricingClient creates a new mockPricingClient with default values.
 func newMockPricingClient(region, currency string) *mockPricingClient {
 	return &mockPricingClient{
-		region:            region,
-		currency:          currency,
-		ec2Prices:         make(map[string]float64),
-		ebsPrices:         make(map[string]float64),
-		s3Prices:          make(map[string]float64),
-		rdsInstancePrices: make(map[string]float64),
-		rdsStoragePrices:  make(map[string]float64),
-		lambdaPrices:      make(map[string]float64),
-		dynamoDBPrices:    make(map[string]float64),
-		elasticachePrices: make(map[string]float64),
+		region:               region,
+		currency:             currency,
+		ec2Prices:            make(map[string]float64),
+		ebsPrices:            make(map[string]float64),
+		ebsIOPSPrices:        make(map[string]float64),
+		ebsThroughputPrices:  make(map[string]float64),
+		s3Prices:             make(map[string]float64),
+		s3RetrievalPrices:    make(map[string]float64),
+		rdsInstancePrices:    make(map[string]float64),
+		rdsStoragePrices:     make(map[string]float64),
+		rdsReservedPrices:    make(map[string]float64),
+		rdsIOOptimizedPrices: make(map[string]float64),
+		lambdaPrices:         make(map[string]float64),
+		dynamoDBPrices:       make(map[string]float64),
+		elasticachePrices:    make(map[string]float64),
+		glueDPUHourPrices:    make(map[string]float64),
 	}
 }
 
@@ -79,13 +123,13 @@ func (m *mockPricingClient) Currency() string {
 }
 
 func (m *mockPricingClient) LambdaPricePerRequest() (float64, bool) {
-	m.lambdaRequestCalled++
+	m.lambdaRequestCalled.Add(1)
 	price, found := m.lambdaPrices["request"]
 	return price, found
 }
 
 func (m *mockPricingClient) LambdaPricePerGBSecond(arch string) (float64, bool) {
-	m.lambdaGBSecondCalled++
+	m.lambdaGBSecondCalled.Add(1)
 	// Check for architecture-specific pricing first
 	switch strings.ToLower(arch) {
 	case "arm64", "arm":
@@ -98,70 +142,166 @@ func (m *mockPricingClient) LambdaPricePerGBSecond(arch string) (float64, bool)
 	return price, found
 }
 
+func (m *mockPricingClient) LambdaHasARMPricing() bool {
+	_, found := m.lambdaPrices["gb-second-arm64"]
+	return found
+}
+
 func (m *mockPricingClient) DynamoDBOnDemandReadPrice() (float64, bool) {
-	m.dynamoDBCalled++
+	m.dynamoDBCalled.Add(1)
 	price, found := m.dynamoDBPrices["on-demand-read"]
 	return price, found
 }
 
 func (m *mockPricingClient) DynamoDBOnDemandWritePrice() (float64, bool) {
-	m.dynamoDBCalled++
+	m.dynamoDBCalled.Add(1)
 	price, found := m.dynamoDBPrices["on-demand-write"]
 	return price, found
 }
 
 func (m *mockPricingClient) DynamoDBStoragePricePerGBMonth() (float64, bool) {
-	m.dynamoDBCalled++
+	m.dynamoDBCalled.Add(1)
 	price, found := m.dynamoDBPrices["storage"]
 	return price, found
 }
 
 func (m *mockPricingClient) DynamoDBProvisionedRCUPrice() (float64, bool) {
-	m.dynamoDBCalled++
+	m.dynamoDBCalled.Add(1)
 	price, found := m.dynamoDBPrices["provisioned-rcu"]
 	return price, found
 }
 
 func (m *mockPricingClient) DynamoDBProvisionedWCUPrice() (float64, bool) {
-	m.dynamoDBCalled++
+	m.dynamoDBCalled.Add(1)
 	price, found := m.dynamoDBPrices["provisioned-wcu"]
 	return price, found
 }
 
 func (m *mockPricingClient) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool) {
-	m.ec2OnDemandCalled++
+	m.ec2OnDemandCalled.Add(1)
 	key := instanceType + "/" + os + "/" + tenancy
 	price, found := m.ec2Prices[key]
 	return price, found
 }
 
+func (m *mockPricingClient) EC2NetworkPerformance(instanceType string) (string, bool) {
+	network, ok := m.ec2Network[instanceType]
+	return network, ok
+}
+
+func (m *mockPricingClient) EC2InstanceTypes() []string {
+	return mockIndexKeys(m.ec2Prices, "/")
+}
+
+func (m *mockPricingClient) EBSVolumeTypes() []string {
+	return mockIndexKeys(m.ebsPrices, "")
+}
+
+func (m *mockPricingClient) S3StorageClasses() []string {
+	return mockIndexKeys(m.s3Prices, "")
+}
+
+func (m *mockPricingClient) RDSInstanceTypes() []string {
+	return mockIndexKeys(m.rdsInstancePrices, "/")
+}
+
+func (m *mockPricingClient) ElastiCacheNodeTypes() []string {
+	return mockIndexKeys(m.elasticachePrices, ":")
+}
+
+// mockIndexKeys returns the distinct keys of a mock price map, cut at sep when non-empty,
+// sorted like the real client's listings.
+func mockIndexKeys(prices map[string]float64, sep string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for key := range prices {
+		if sep != "" {
+			key, _, _ = strings.Cut(key, sep)
+		}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (m *mockPricingClient) EC2InterAZDataTransferPricePerGB() (float64, bool) {
+	return m.ec2InterAZPrice, m.ec2InterAZPrice > 0
+}
+
+func (m *mockPricingClient) EBSSnapshotPricePerGBMonth() (float64, bool) {
+	return m.ebsSnapshotPrice, m.ebsSnapshotPrice > 0
+}
+
 func (m *mockPricingClient) EBSPricePerGBMonth(volumeType string) (float64, bool) {
-	m.ebsPriceCalled++
+	m.ebsPriceCalled.Add(1)
 	price, found := m.ebsPrices[volumeType]
 	return price, found
 }
 
+func (m *mockPricingClient) EBSIOPSPricePerMonth(volumeType string) (float64, bool) {
+	price, found := m.ebsIOPSPrices[volumeType]
+	return price, found
+}
+
+func (m *mockPricingClient) EBSIOPSTiers(volumeType string) ([]pricing.TierRate, bool) {
+	if volumeType != "io2" || len(m.io2IOPSTiers) == 0 {
+		return nil, false
+	}
+	return m.io2IOPSTiers, true
+}
+
+func (m *mockPricingClient) EBSThroughputPricePerMonth(volumeType string) (float64, bool) {
+	price, found := m.ebsThroughputPrices[volumeType]
+	return price, found
+}
+
 func (m *mockPricingClient) S3PricePerGBMonth(storageClass string) (float64, bool) {
-	m.s3PriceCalled++
+	m.s3PriceCalled.Add(1)
 	price, found := m.s3Prices[storageClass]
 	return price, found
 }
 
+func (m *mockPricingClient) S3IntelligentTieringMonitoringPricePerObject() (float64, bool) {
+	return m.s3ITMonitoringPrice, m.s3ITMonitoringPrice > 0
+}
+
+func (m *mockPricingClient) S3RetrievalPricePerGB(storageClass string) (float64, bool) {
+	price, found := m.s3RetrievalPrices[storageClass]
+	return price, found
+}
+
 func (m *mockPricingClient) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
-	m.rdsOnDemandCalled++
+	m.rdsOnDemandCalled.Add(1)
 	key := instanceType + "/" + engine
 	price, found := m.rdsInstancePrices[key]
 	return price, found
 }
 
 func (m *mockPricingClient) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
-	m.rdsStoragePriceCalled++
+	m.rdsStoragePriceCalled.Add(1)
 	price, found := m.rdsStoragePrices[volumeType]
 	return price, found
 }
 
+func (m *mockPricingClient) RDSReservedPricePerHour(instanceType, engine string) (float64, bool) {
+	price, found := m.rdsReservedPrices[instanceType+"/"+engine]
+	return price, found
+}
+
+func (m *mockPricingClient) RDSAuroraIOOptimizedPricePerHour(instanceType, engine string) (float64, bool) {
+	price, found := m.rdsIOOptimizedPrices[instanceType+"/"+engine]
+	return price, found
+}
+
+func (m *mockPricingClient) RDSAuroraIORequestPrice() (float64, bool) {
+	return m.auroraIORequestPrice, m.auroraIORequestPrice > 0
+}
+
 func (m *mockPricingClient) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
-	m.eksPriceCalled++
+	m.eksPriceCalled.Add(1)
 	if extendedSupport {
 		if m.eksExtendedPrice > 0 {
 			return m.eksExtendedPrice, true
@@ -174,8 +314,28 @@ func (m *mockPricingClient) EKSClusterPricePerHour(extendedSupport bool) (float6
 	return 0, false
 }
 
+func (m *mockPricingClient) PricingMetadata(offerCode string) (string, string, bool) {
+	version, ok := m.pricingVersions[offerCode]
+	return version, m.pricingDates[offerCode], ok
+}
+
+func (m *mockPricingClient) SchemaDrift() []string {
+	return m.schemaDrift
+}
+
+func (m *mockPricingClient) PriceInconsistencies() []string {
+	return m.priceInconsistencies
+}
+
+func (m *mockPricingClient) EKSFargatePricePerHour() (float64, float64, bool) {
+	if m.eksFargateVCPUPrice > 0 && m.eksFargateGBPrice > 0 {
+		return m.eksFargateVCPUPrice, m.eksFargateGBPrice, true
+	}
+	return 0, 0, false
+}
+
 func (m *mockPricingClient) ALBPricePerHour() (float64, bool) {
-	m.elbCalled++
+	m.elbCalled.Add(1)
 	if m.albHourlyPrice > 0 {
 		return m.albHourlyPrice, true
 	}
@@ -183,7 +343,7 @@ func (m *mockPricingClient) ALBPricePerHour() (float64, bool) {
 }
 
 func (m *mockPricingClient) ALBPricePerLCU() (float64, bool) {
-	m.elbCalled++
+	m.elbCalled.Add(1)
 	if m.albLCUPrice > 0 {
 		return m.albLCUPrice, true
 	}
@@ -191,7 +351,7 @@ func (m *mockPricingClient) ALBPricePerLCU() (float64, bool) {
 }
 
 func (m *mockPricingClient) NLBPricePerHour() (float64, bool) {
-	m.elbCalled++
+	m.elbCalled.Add(1)
 	if m.nlbHourlyPrice > 0 {
 		return m.nlbHourlyPrice, true
 	}
@@ -199,15 +359,27 @@ func (m *mockPricingClient) NLBPricePerHour() (float64, bool) {
 }
 
 func (m *mockPricingClient) NLBPricePerNLCU() (float64, bool) {
-	m.elbCalled++
+	m.elbCalled.Add(1)
 	if m.nlbNLCUPrice > 0 {
 		return m.nlbNLCUPrice, true
 	}
 	return 0, false
 }
 
+func (m *mockPricingClient) ELBOutpostsPricePerHour(lbType string) (float64, bool) {
+	m.elbCalled.Add(1)
+	price, found := m.elbOutpostsPrices[lbType+"/hourly"]
+	return price, found
+}
+
+func (m *mockPricingClient) ELBOutpostsPricePerCapacityUnit(lbType string) (float64, bool) {
+	m.elbCalled.Add(1)
+	price, found := m.elbOutpostsPrices[lbType+"/cu"]
+	return price, found
+}
+
 func (m *mockPricingClient) NATGatewayPrice() (*pricing.NATGatewayPrice, bool) {
-	m.natgwCalled++
+	m.natgwCalled.Add(1)
 	if m.natgwHourlyPrice > 0 {
 		return &pricing.NATGatewayPrice{
 			HourlyRate:         m.natgwHourlyPrice,
@@ -261,6 +433,90 @@ func (m *mockPricingClient) ElastiCacheOnDemandPricePerHour(instanceType, engine
 	return price, found
 }
 
+func (m *mockPricingClient) ECRStoragePricePerGBMonth() (float64, bool) {
+	return m.ecrStoragePrice, m.ecrStoragePrice > 0
+}
+
+func (m *mockPricingClient) ECRDataTransferOutPricePerGB() (float64, bool) {
+	return m.ecrDataTransferPrice, m.ecrDataTransferPrice > 0
+}
+
+func (m *mockPricingClient) SecretsManagerPricePerSecret() (float64, bool) {
+	return m.secretPrice, m.secretPrice > 0
+}
+
+func (m *mockPricingClient) SecretsManagerPricePerAPICall() (float64, bool) {
+	return m.secretAPICallPrice, m.secretAPICallPrice > 0
+}
+
+func (m *mockPricingClient) KMSPricePerKey() (float64, bool) {
+	return m.kmsKeyPrice, m.kmsKeyPrice > 0
+}
+
+func (m *mockPricingClient) KMSPricePerRequest() (float64, bool) {
+	return m.kmsRequestPrice, m.kmsRequestPrice > 0
+}
+
+func (m *mockPricingClient) WAFPricePerWebACL() (float64, bool) {
+	return m.wafWebACLPrice, m.wafWebACLPrice > 0
+}
+
+func (m *mockPricingClient) WAFPricePerRule() (float64, bool) {
+	return m.wafRulePrice, m.wafRulePrice > 0
+}
+
+func (m *mockPricingClient) WAFPricePerRequest() (float64, bool) {
+	return m.wafRequestPrice, m.wafRequestPrice > 0
+}
+
+func (m *mockPricingClient) AthenaPricePerTBScanned() (float64, bool) {
+	return m.athenaTBPrice, m.athenaTBPrice > 0
+}
+
+func (m *mockPricingClient) GluePricePerDPUHour(jobType string) (float64, bool) {
+	price, ok := m.glueDPUHourPrices[jobType]
+	return price, ok
+}
+
+func (m *mockPricingClient) SESPricePerEmail() (float64, bool) {
+	return m.sesEmailPrice, m.sesEmailPrice > 0
+}
+
+func (m *mockPricingClient) SESPricePerAttachmentGB() (float64, bool) {
+	return m.sesAttachmentGBPrice, m.sesAttachmentGBPrice > 0
+}
+
+func (m *mockPricingClient) StepFunctionsPricePerTransition() (float64, bool) {
+	return m.sfnTransitionPrice, m.sfnTransitionPrice > 0
+}
+
+func (m *mockPricingClient) StepFunctionsExpressPricing() (float64, float64, bool) {
+	if m.sfnExpressRequest > 0 && m.sfnExpressGBSecond > 0 {
+		return m.sfnExpressRequest, m.sfnExpressGBSecond, true
+	}
+	return 0, 0, false
+}
+
+func (m *mockPricingClient) Vintages() []string {
+	vintages := make([]string, 0, len(m.vintages))
+	for vintage := range m.vintages {
+		vintages = append(vintages, vintage)
+	}
+	sort.Strings(vintages)
+	return vintages
+}
+
+func (m *mockPricingClient) ForVintage(vintage string) (pricing.PricingClient, bool) {
+	if vintage == "" || vintage == pricing.LatestVintage {
+		return m, true
+	}
+	snapshot, ok := m.vintages[vintage]
+	if !ok {
+		return nil, false
+	}
+	return snapshot, true
+}
+
 func TestNewAWSPublicPlugin(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
@@ -690,8 +946,8 @@ func TestNewAWSPublicPlugin_DeprecatedEnvVars(t *testing.T) {
 				t.Errorf("maxBatchSize = %d, want %d", plugin.maxBatchSize, tt.expectedBatchSize)
 			}
 
-			if plugin.strictValidation != tt.expectedStrict {
-				t.Errorf("strictValidation = %v, want %v", plugin.strictValidation, tt.expectedStrict)
+			if plugin.features.StrictValidation != tt.expectedStrict {
+				t.Errorf("strictValidation = %v, want %v", plugin.features.StrictValidation, tt.expectedStrict)
 			}
 
 			logOutput := logBuf.String()
@@ -704,4 +960,4 @@ func TestNewAWSPublicPlugin_DeprecatedEnvVars(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
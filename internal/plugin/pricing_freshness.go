@@ -0,0 +1,94 @@
+package plugin
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// EnvPricingMaxAgeDays sets how many days after its AWS publication date the embedded
+// EC2 pricing is reported as stale at startup. Defaults to defaultPricingMaxAgeDays.
+const EnvPricingMaxAgeDays = "FINFOCUS_PRICING_MAX_AGE_DAYS"
+
+// defaultPricingMaxAgeDays is the pricing age threshold used when EnvPricingMaxAgeDays
+// is unset or invalid.
+const defaultPricingMaxAgeDays = 90
+
+// pricingFreshnessMetadataKey is the GetPluginInfo metadata key carrying the
+// JSON-encoded pricingFreshness of the embedded EC2 pricing.
+const pricingFreshnessMetadataKey = "pricing_freshness"
+
+// fallbackPricingVersion is the version of the placeholder pricing compiled into
+// fallback builds, whose publication date is not meaningful.
+const fallbackPricingVersion = "fallback"
+
+// pricingFreshness reports the age of the embedded EC2 pricing, so a regional binary
+// that has not been rebuilt in a while is visible before it produces outdated estimates.
+type pricingFreshness struct {
+	PublicationDate string `json:"publication_date"`
+	AgeDays         int    `json:"age_days"`
+	MaxAgeDays      int    `json:"max_age_days"`
+	Stale           bool   `json:"stale"`
+}
+
+// checkPricingFreshness compares the embedded EC2 publication date against now and
+// warns when it is more than maxAgeDays old. A nil client, fallback placeholder data,
+// or a missing or unparseable date yields the zero value.
+func checkPricingFreshness(
+	pricingClient pricing.PricingClient,
+	now time.Time,
+	maxAgeDays int,
+	logger zerolog.Logger,
+) pricingFreshness {
+	var fresh pricingFreshness
+	if pricingClient == nil {
+		return fresh
+	}
+	version, date, found := pricingClient.PricingMetadata(serviceOfferCodes["ec2"])
+	if !found || version == fallbackPricingVersion || date == "" {
+		return fresh
+	}
+	published, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		logger.Debug().
+			Str("publication_date", date).
+			Err(err).
+			Msg("unparseable EC2 pricing publication date, skipping staleness check")
+		return fresh
+	}
+
+	fresh.PublicationDate = date
+	fresh.AgeDays = int(now.Sub(published).Hours() / 24)
+	fresh.MaxAgeDays = maxAgeDays
+	fresh.Stale = fresh.AgeDays > maxAgeDays
+
+	if !fresh.Stale {
+		logger.Debug().
+			Str("publication_date", date).
+			Int("age_days", fresh.AgeDays).
+			Msg("embedded pricing is within the age threshold")
+		return fresh
+	}
+	logger.Warn().
+		Str("publication_date", date).
+		Int("age_days", fresh.AgeDays).
+		Int("max_age_days", maxAgeDays).
+		Msg("embedded pricing is older than the age threshold; rebuild with current pricing data")
+	return fresh
+}
+
+// json returns the freshness encoded for GetPluginInfo metadata, or "" when the check
+// was skipped.
+func (f pricingFreshness) json() string {
+	if f.PublicationDate == "" {
+		return ""
+	}
+	encoded, err := json.Marshal(f)
+	if err != nil {
+		return ""
+	}
+	return string(encoded)
+}
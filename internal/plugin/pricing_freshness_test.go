@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestCheckPricingFreshness verifies the staleness threshold and the skipped cases.
+func TestCheckPricingFreshness(t *testing.T) {
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		version   string
+		date      string
+		maxAge    int
+		wantAge   int
+		wantStale bool
+		wantWarn  bool
+		wantSkip  bool
+	}{
+		{name: "fresh", version: "20260901", date: "2026-09-01T00:00:00Z", maxAge: 90, wantAge: 44},
+		{name: "stale", version: "20260601", date: "2026-06-01T00:00:00Z", maxAge: 90, wantAge: 136, wantStale: true, wantWarn: true},
+		{name: "custom threshold", version: "20260901", date: "2026-09-01T00:00:00Z", maxAge: 30, wantAge: 44, wantStale: true, wantWarn: true},
+		{name: "exactly at threshold", version: "20260717", date: "2026-07-17T12:00:00Z", maxAge: 90, wantAge: 90},
+		{name: "fallback placeholder", version: fallbackPricingVersion, date: "2024-01-01T00:00:00Z", maxAge: 90, wantSkip: true},
+		{name: "unparseable date", version: "20260901", date: "September", maxAge: 90, wantSkip: true},
+		{name: "no metadata", maxAge: 90, wantSkip: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.version != "" {
+				mock.pricingVersions = map[string]string{"AmazonEC2": tt.version}
+				mock.pricingDates = map[string]string{"AmazonEC2": tt.date}
+			}
+			var buf bytes.Buffer
+			got := checkPricingFreshness(mock, now, tt.maxAge, zerolog.New(&buf))
+
+			if tt.wantSkip {
+				if got != (pricingFreshness{}) || got.json() != "" {
+					t.Errorf("checkPricingFreshness() = %+v, want the zero value", got)
+				}
+				return
+			}
+			want := pricingFreshness{PublicationDate: tt.date, AgeDays: tt.wantAge, MaxAgeDays: tt.maxAge, Stale: tt.wantStale}
+			if got != want {
+				t.Errorf("checkPricingFreshness() = %+v, want %+v", got, want)
+			}
+			if warned := strings.Contains(buf.String(), `"level":"warn"`); warned != tt.wantWarn {
+				t.Errorf("warning logged = %v, want %v (log: %s)", warned, tt.wantWarn, buf.String())
+			}
+		})
+	}
+}
+
+// TestNewAWSPublicPlugin_StalePricing verifies a backdated EC2 publication date fires the
+// startup warning, honors FINFOCUS_PRICING_MAX_AGE_DAYS and is reported in GetPluginInfo.
+func TestNewAWSPublicPlugin_StalePricing(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxAge    string
+		wantMax   int
+		wantStale bool
+	}{
+		{name: "default threshold", maxAge: "", wantMax: defaultPricingMaxAgeDays, wantStale: true},
+		{name: "raised threshold", maxAge: "365", wantMax: 365, wantStale: false},
+		{name: "invalid threshold uses default", maxAge: "ninety", wantMax: defaultPricingMaxAgeDays, wantStale: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvPricingMaxAgeDays, tt.maxAge)
+			mock := newMockPricingClient("us-east-1", "USD")
+			published := time.Now().UTC().AddDate(0, 0, -120).Format(time.RFC3339)
+			mock.pricingVersions = map[string]string{"AmazonEC2": "backdated"}
+			mock.pricingDates = map[string]string{"AmazonEC2": published}
+
+			var buf bytes.Buffer
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.New(&buf))
+
+			stale := strings.Contains(buf.String(), "embedded pricing is older than the age threshold")
+			if stale != tt.wantStale {
+				t.Errorf("stale warning logged = %v, want %v", stale, tt.wantStale)
+			}
+
+			resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+			if err != nil {
+				t.Fatalf("GetPluginInfo() error: %v", err)
+			}
+			var reported pricingFreshness
+			if err := json.Unmarshal([]byte(resp.Metadata[pricingFreshnessMetadataKey]), &reported); err != nil {
+				t.Fatalf("metadata %s = %q, not JSON: %v",
+					pricingFreshnessMetadataKey, resp.Metadata[pricingFreshnessMetadataKey], err)
+			}
+			if reported.PublicationDate != published || reported.AgeDays != 120 ||
+				reported.MaxAgeDays != tt.wantMax || reported.Stale != tt.wantStale {
+				t.Errorf("reported freshness = %+v, want date %s, 120 days, max %d, stale %v",
+					reported, published, tt.wantMax, tt.wantStale)
+			}
+		})
+	}
+}
@@ -3,7 +3,6 @@ package plugin
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
@@ -11,8 +10,13 @@ import (
 
 // GetPricingSpec returns detailed pricing specification for a resource type.
 // This provides information about how a resource is billed without calculating the actual cost.
-func (p *AWSPublicPlugin) GetPricingSpec(ctx context.Context, req *pbc.GetPricingSpecRequest) (*pbc.GetPricingSpecResponse, error) {
-	start := time.Now()
+func (p *AWSPublicPlugin) GetPricingSpec(
+	ctx context.Context, req *pbc.GetPricingSpecRequest,
+) (_ *pbc.GetPricingSpecResponse, err error) {
+	start := p.clock.Now()
+	defer func() {
+		p.metrics.observe("GetPricingSpec", metricsResourceType(req.GetResource().GetResourceType()), p.since(start), err)
+	}()
 	traceID := p.getTraceID(ctx)
 
 	// FR-009, FR-010: Use SDK validation + custom region check (US2)
@@ -71,10 +75,18 @@ func (p *AWSPublicPlugin) GetPricingSpec(ctx context.Context, req *pbc.GetPricin
 		}
 	}
 
+	// Advertise the tags the estimator consumes (no dedicated schema RPC exists)
+	if schemaJSON := p.tagSchemaJSON(resource.ResourceType); schemaJSON != "" {
+		if spec.PluginMetadata == nil {
+			spec.PluginMetadata = make(map[string]string)
+		}
+		spec.PluginMetadata[tagSchemaMetadataKey] = schemaJSON
+	}
+
 	p.traceLogger(traceID, "GetPricingSpec").Info().
 		Str(pluginsdk.FieldResourceType, resource.ResourceType).
 		Str("aws_region", resource.Region).
-		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 		Msg("pricing spec retrieved")
 
 	return &pbc.GetPricingSpecResponse{
@@ -218,7 +230,7 @@ func (p *AWSPublicPlugin) lambdaPricingSpec(resource *pbc.ResourceDescriptor) *p
 	}
 
 	requestRate, requestFound := p.pricing.LambdaPricePerRequest()
-	gbSecRate, gbSecFound := p.pricing.LambdaPricePerGBSecond(arch)
+	gbSecRate, archFallbackNote, gbSecFound := p.lambdaGBSecondRate(arch)
 
 	if !requestFound || !gbSecFound {
 		return &pbc.PricingSpec{
@@ -235,6 +247,16 @@ func (p *AWSPublicPlugin) lambdaPricingSpec(resource *pbc.ResourceDescriptor) *p
 		}
 	}
 
+	assumptions := []string{
+		fmt.Sprintf("Request rate: $%.10f per request", requestRate),
+		fmt.Sprintf("Compute rate: $%.10f per GB-second (%s)", gbSecRate, arch),
+		"Provisioned concurrency not included",
+		"Lambda@Edge pricing differs",
+	}
+	if archFallbackNote != "" {
+		assumptions = append(assumptions, archFallbackNote)
+	}
+
 	return &pbc.PricingSpec{
 		Provider:     resource.Provider,
 		ResourceType: resource.ResourceType,
@@ -246,12 +268,7 @@ func (p *AWSPublicPlugin) lambdaPricingSpec(resource *pbc.ResourceDescriptor) *p
 		Unit:         "GB-second",
 		Description:  fmt.Sprintf("Lambda %s architecture", arch),
 		Source:       "aws-public",
-		Assumptions: []string{
-			fmt.Sprintf("Request rate: $%.10f per request", requestRate),
-			fmt.Sprintf("Compute rate: $%.10f per GB-second (%s)", gbSecRate, arch),
-			"Provisioned concurrency not included",
-			"Lambda@Edge pricing differs",
-		},
+		Assumptions:  assumptions,
 	}
 }
 
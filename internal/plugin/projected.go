@@ -2,10 +2,12 @@ package plugin
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
@@ -15,6 +17,23 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+// tagEBSVolumes holds a JSON array of {"type","size"} objects for pricing several EBS
+// volumes in one request, e.g. [{"type":"gp3","size":100},{"type":"io2","size":500}].
+const tagEBSVolumes = "volumes"
+
+// tagEBSUsedGB is the data actually stored on an EBS volume in GB. AWS bills the
+// provisioned size, but only the used size is counted for carbon, since idle provisioned
+// capacity draws little energy. Absent, invalid or larger than the provisioned size, the
+// provisioned size is used.
+const tagEBSUsedGB = "used_gb"
+
+// ebsMinimumSizeGB is the smallest size AWS provisions for the HDD-backed volume types.
+// Smaller requested sizes are estimated, and billed, at the minimum.
+var ebsMinimumSizeGB = map[string]int{
+	"st1": 125,
+	"sc1": 125,
+}
+
 const (
 	defaultEBSGB      = 8
 	defaultRDSEngine  = "mysql"
@@ -22,6 +41,125 @@ const (
 	defaultRDSSizeGB  = 20
 )
 
+// tagAnnotateMissingCarbon opts a request into an explicit zero-valued carbon metric when
+// pricing resolves but CCF has no data for the instance type. It is off by default so
+// consumers that treat every metric as real data are unaffected.
+const (
+	tagAnnotateMissingCarbon = "annotate_missing_carbon"
+	carbonUnavailableUnit    = "gCO2e (unavailable)"
+)
+
+// RDS pricing models and Aurora storage modes accepted via resource tags.
+const (
+	rdsPricingOnDemand          = "on-demand"
+	rdsPricingReserved1yr       = "reserved-1yr"
+	rdsStorageAurora            = "aurora"
+	rdsStorageAuroraIOOptimized = "aurora-io-optimized"
+)
+
+// tagEC2DetailedMonitoring opts an EC2 estimate into 1-minute CloudWatch monitoring, which
+// AWS bills as detailedMonitoringMetrics custom metrics per instance.
+const (
+	tagEC2DetailedMonitoring  = "detailed_monitoring"
+	detailedMonitoringMetrics = 7
+)
+
+// tagCloudWatchRetentionDays is the log group retention in days. With log_ingestion_gb
+// it derives the steady-state stored GB (ingestion × retention as a fraction of a month)
+// when log_storage_gb is not set.
+const tagCloudWatchRetentionDays = "retention_days"
+
+// Usage tags for the analytics estimators: terabytes scanned by Athena queries and
+// DPU-hours consumed by Glue jobs or crawlers, both per month.
+const (
+	tagAthenaDataScannedTB = "data_scanned_tb"
+	tagGlueDPUHours        = "dpu_hours"
+)
+
+// SES usage tags. free_tier subtracts the monthly free email allowance AWS grants new
+// accounts for their first 12 months; attachments have no free allowance.
+const (
+	tagSESEmailsPerMonth      = "emails_per_month"
+	tagSESDataGB              = "data_gb"
+	tagFreeTier               = "free_tier"
+	sesFreeTierEmailsPerMonth = 3000
+)
+
+// Step Functions usage tags. type selects the workflow type, like the state machine's
+// type input; Standard workflows are billed per state transition and Express workflows
+// per request plus duration at their memory size (requests_per_month, avg_duration_ms).
+// AWS rounds Express duration up to 100 ms and memory up to 64 MB.
+const (
+	tagSFNType             = "type"
+	tagSFNStateTransitions = "state_transitions_per_month"
+	tagSFNMemoryMB         = "memory_mb"
+	sfnTypeStandard        = "standard"
+	sfnTypeExpress         = "express"
+	sfnDurationIncrementMs = 100
+	sfnMemoryIncrementMB   = 64
+)
+
+// daysPerMonth is the 730-hour month in days.
+const daysPerMonth = HoursPerMonthProd / 24.0
+
+// tagEC2CapacityReservation marks an EC2 estimate as an On-Demand Capacity Reservation,
+// which is billed at the On-Demand rate for every hour whether or not an instance runs.
+const tagEC2CapacityReservation = "capacity_reservation"
+
+// tagRDSMaxAllocatedStorage is the storage autoscaling ceiling in GB. RDS bills allocated
+// storage, so when it exceeds storage_size the billing detail adds the upper-bound cost
+// at full autoscaling; the estimate itself stays at the current allocation.
+const tagRDSMaxAllocatedStorage = "max_allocated_storage"
+
+// tagRDSReadReplicas is the number of read replicas of an RDS instance (0-15, default 0).
+// Each replica bills the instance's hourly rate; non-Aurora replicas also bill their own
+// copy of the allocated storage, while Aurora replicas share the cluster volume.
+const tagRDSReadReplicas = "read_replicas"
+
+// maxRDSReadReplicas is the most read replicas RDS allows per source instance.
+const maxRDSReadReplicas = 15
+
+// Aurora cluster storage (GB) and I/O requests per month. Aurora meters both separately
+// from instance hours; they take precedence over storage_size and io_requests_per_month.
+const (
+	tagAuroraStorageGB  = "aurora_storage_gb"
+	tagAuroraIORequests = "aurora_io_requests"
+)
+
+// tagS3RetrievalGB is the data read back from an S3 bucket per month in GB. Classes with
+// a per-GB retrieval fee (IA, Glacier, Deep Archive) add it as a separate line item.
+const tagS3RetrievalGB = "retrieval_gb"
+
+// tagOutposts opts a load balancer into AWS Outposts rates ("Outposts-" usage types),
+// which are excluded by default. Components without an Outposts rate keep the regional one.
+const tagOutposts = "outposts"
+
+// s3MinimumStorageDays is the minimum billable storage duration of S3 storage classes.
+// Objects deleted or transitioned earlier are charged for the remaining days.
+var s3MinimumStorageDays = map[string]int{
+	"STANDARD_IA":  30,
+	"ONEZONE_IA":   30,
+	"GLACIER_IR":   90,
+	"GLACIER":      90,
+	"DEEP_ARCHIVE": 180,
+}
+
+// EKS tags that add Fargate pod compute to the control-plane estimate.
+const (
+	tagEKSFargateVCPU     = "fargate_vcpu"
+	tagEKSFargateMemoryGB = "fargate_memory_gb"
+	tagEKSNumPods         = "num_pods"
+)
+
+// gp3 volumes include a free performance baseline; only provisioning above it is billed.
+const (
+	gp3BaselineIOPS       = 3000
+	gp3BaselineThroughput = 125 // MiB/s
+)
+
+// tagProvisionedIOPS is an alias for the "iops" EBS tag; "iops" wins when both are set.
+const tagProvisionedIOPS = "provisioned_iops"
+
 // normalizeResourceType converts various resource type formats to a canonical form.
 // Examples:
 //   - "aws:ec2/instance:Instance" -> "ec2"
@@ -62,8 +200,10 @@ func normalizeResourceType(resourceType string) string {
 			svcParts := strings.Split(parts[0], ":")
 			svc := svcParts[0]
 			switch svc {
-			case "ec2", "ebs", "rds", "s3", "lambda", "dynamodb", "eks", "natgw", "cloudwatch", "elasticache":
+			case "ec2", "ebs", "rds", "s3", "lambda", "dynamodb", "eks", "natgw", "cloudwatch", "elasticache", "ecr", "secretsmanager", "kms":
 				return svc
+			case "sfn", "stepfunctions":
+				return "stepfunctions"
 			case "lb", "alb", "nlb":
 				return "elb"
 			case "natgateway":
@@ -82,14 +222,20 @@ func normalizeResourceType(resourceType string) string {
 // Uses SDK mapping.ExtractSKU with extended key list for backwards compatibility
 // with both camelCase (SDK standard) and snake_case (legacy) property names.
 //
-// Priority order: instanceType > instance_class > instanceClass > type > volumeType > volume_type
+// Priority order: instanceType > instance_type > instance_class > instanceClass >
+// node_type > type > volumeType > volume_type
+//
+// Specific compute keys come before the generic "type" so that a provider emitting
+// both (e.g. snake_case instance_type plus a free-form type) resolves to the SKU.
 func extractAWSSKU(tags map[string]string) string {
 	// Use SDK's generic ExtractSKU with extended key list for backwards compatibility.
 	// This includes both SDK canonical keys (camelCase) and legacy snake_case variants.
 	return mapping.ExtractSKU(tags,
 		mapping.AWSKeyInstanceType,  // "instanceType" - EC2
+		"instance_type",             // snake_case for EC2 (Terraform-bridged providers)
 		"instance_class",            // legacy snake_case for RDS
 		mapping.AWSKeyInstanceClass, // "instanceClass" - RDS (SDK canonical)
+		"node_type",                 // snake_case for ElastiCache
 		mapping.AWSKeyType,          // "type" - generic fallback
 		mapping.AWSKeyVolumeType,    // "volumeType" - EBS (SDK canonical)
 		"volume_type",               // legacy snake_case for EBS
@@ -114,6 +260,10 @@ var engineNormalization = map[string]string{
 	"sqlserver":    "SQL Server",
 	"sqlserver-ex": "SQL Server",
 	"sql-server":   "SQL Server",
+
+	"aurora":            "Aurora MySQL",
+	"aurora-mysql":      "Aurora MySQL",
+	"aurora-postgresql": "Aurora PostgreSQL",
 }
 
 // validRDSStorageTypes contains the supported RDS storage volume types.
@@ -125,9 +275,20 @@ var validRDSStorageTypes = map[string]bool{
 	"standard": true,
 }
 
+// auroraStorageLabels maps Aurora storage modes to the names used in billing detail.
+var auroraStorageLabels = map[string]string{
+	rdsStorageAurora:            "Aurora Standard",
+	rdsStorageAuroraIOOptimized: "Aurora I/O-Optimized",
+}
+
 // GetProjectedCost estimates the monthly cost for the given resource.
-func (p *AWSPublicPlugin) GetProjectedCost(ctx context.Context, req *pbc.GetProjectedCostRequest) (*pbc.GetProjectedCostResponse, error) {
-	start := time.Now()
+func (p *AWSPublicPlugin) GetProjectedCost(
+	ctx context.Context, req *pbc.GetProjectedCostRequest,
+) (_ *pbc.GetProjectedCostResponse, err error) {
+	start := p.clock.Now()
+	defer func() {
+		p.metrics.observe("GetProjectedCost", metricsResourceType(req.GetResource().GetResourceType()), p.since(start), err)
+	}()
 	traceID := p.getTraceID(ctx)
 
 	// Early nil check to create serviceResolver (optimization: compute once per request)
@@ -151,6 +312,20 @@ func (p *AWSPublicPlugin) GetProjectedCost(ctx context.Context, req *pbc.GetProj
 		return nil, err
 	}
 
+	// Price from a dated snapshot when one is requested; the rest of the call uses it
+	vp, vintage, vintageErr := p.forPricingVintage(traceID, resource)
+	if vintageErr != nil {
+		p.logErrorWithID(traceID, "GetProjectedCost", vintageErr, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		return nil, vintageErr
+	}
+	p = vp
+
+	count, countErr := p.resourceCount(traceID, resource)
+	if countErr != nil {
+		p.logErrorWithID(traceID, "GetProjectedCost", countErr, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		return nil, countErr
+	}
+
 	// Test mode: Enhanced logging for request details (US3)
 	if p.testMode {
 		p.logger.Debug().
@@ -164,37 +339,71 @@ func (p *AWSPublicPlugin) GetProjectedCost(ctx context.Context, req *pbc.GetProj
 
 	// Route to appropriate estimator based on resource type
 	var resp *pbc.GetProjectedCostResponse
-	var err error
+	assumed := &assumptions{}
+	replicated := &carbonRegions{}
+	bounds := &carbonRange{}
+	var formula *billingFormula
+	if parseBoolVal(resource.Tags[tagVerboseBilling]) {
+		formula = &billingFormula{}
+	}
 
 	// Use cached service type from resolver (optimization: SC-002)
 	serviceType := resolver.ServiceType()
+	if p.serviceDisabled(serviceType) {
+		err = p.newErrorWithID(traceID, codes.Unimplemented,
+			fmt.Sprintf("service %q is disabled in this deployment", serviceType),
+			pbc.ErrorCode_ERROR_CODE_UNSPECIFIED)
+		p.logErrorWithID(traceID, "GetProjectedCost", err, pbc.ErrorCode_ERROR_CODE_UNSPECIFIED)
+		return nil, err
+	}
+	readTags := p.tagReads.track(resource)
 	switch serviceType {
 	case "ec2":
-		resp, err = p.estimateEC2(traceID, resource, req)
+		resp, err = p.estimateEC2(traceID, resource, req, bounds, formula)
 	case "ebs":
-		resp, err = p.estimateEBS(traceID, resource)
+		resp, err = p.estimateEBS(traceID, resource, assumed, formula)
 	case "rds":
-		resp, err = p.estimateRDS(traceID, resource)
+		resp, err = p.estimateRDS(traceID, resource, assumed, formula)
 	case "eks":
-		resp, err = p.estimateEKS(traceID, resource)
+		resp, err = p.estimateEKS(traceID, resource, formula)
 	case "s3":
-		resp, err = p.estimateS3(traceID, resource)
+		resp, err = p.estimateS3(traceID, resource, assumed, replicated, formula)
 	case "lambda":
-		resp, err = p.estimateLambda(traceID, resource)
+		resp, err = p.estimateLambda(traceID, resource, assumed, formula)
 	case "dynamodb":
-		resp, err = p.estimateDynamoDB(traceID, resource)
+		resp, err = p.estimateDynamoDB(traceID, resource, replicated, formula)
 	case "elb":
-		resp, err = p.estimateELB(traceID, resource)
+		resp, err = p.estimateELB(traceID, resource, formula)
 	case "natgw":
-		resp, err = p.estimateNATGateway(traceID, resource)
+		resp, err = p.estimateNATGateway(traceID, resource, formula)
 	case "cloudwatch":
-		resp, err = p.estimateCloudWatch(traceID, resource)
+		resp, err = p.estimateCloudWatch(traceID, resource, formula)
 	case "elasticache":
-		resp, err = p.estimateElastiCache(traceID, resource)
+		resp, err = p.estimateElastiCache(traceID, resource, formula)
+	case "ecr":
+		resp, err = p.estimateECR(traceID, resource, assumed, formula)
+	case "secretsmanager":
+		resp, err = p.estimateSecretsManager(traceID, resource, formula)
+	case "kms":
+		resp, err = p.estimateKMS(traceID, resource, assumed, formula)
+	case "waf":
+		resp, err = p.estimateWAF(traceID, resource, assumed, formula)
+	case "athena":
+		resp, err = p.estimateAthena(traceID, resource, assumed, formula)
+	case "glue":
+		resp, err = p.estimateGlue(traceID, resource, assumed, formula)
+	case "ses":
+		resp, err = p.estimateSES(traceID, resource, assumed, formula)
+	case "stepfunctions":
+		resp, err = p.estimateStepFunctions(traceID, resource, assumed, formula)
 	case "vpc", "securitygroup", "subnet", "iam":
 		// Zero-cost AWS networking and IAM resources - no direct charges
 		resp = p.estimateZeroCostResource(traceID, resource, serviceType)
 	default:
+		if p.supportLevel(serviceType) == SupportLevelStub {
+			resp, err = p.estimateStub(ctx, traceID, resource)
+			break
+		}
 		// Unknown resource type - return $0 with explanation
 		resp = &pbc.GetProjectedCostResponse{
 			CostPerMonth:  0,
@@ -204,11 +413,42 @@ func (p *AWSPublicPlugin) GetProjectedCost(ctx context.Context, req *pbc.GetProj
 		}
 	}
 
+	p.logTagUsage(traceID, serviceType, resource, readTags())
+
 	if err != nil {
 		p.logErrorWithID(traceID, "GetProjectedCost", err, pbc.ErrorCode_ERROR_CODE_UNSPECIFIED)
 		return nil, err
 	}
 
+	// Classify $0 estimates before later steps decorate the billing detail
+	zeroReason := zeroCostReasonFor(serviceType, p.supportLevel(serviceType), resp)
+	formula.apply(resp)
+
+	if vintage != "" {
+		resp.BillingDetail += fmt.Sprintf(" (%s pricing snapshot)", vintage)
+	}
+
+	gridFactor, customGrid := p.applyCustomGridFactor(traceID, resource, resp, replicated)
+	applyResourceCount(resp, count)
+
+	// Opt-in approximation for fallback builds serving other regions
+	if p.usesRegionFallback(resource.Region) {
+		applyRegionFallback(resp, resource.Region)
+		p.traceLogger(traceID, "GetProjectedCost").Warn().
+			Str("aws_region", resource.Region).
+			Str("reference_region", referencePricingRegion).
+			Msg("estimate derived from reference region pricing")
+	}
+
+	bounds.resolve(resp)
+	replicated.resolve(resp)
+
+	// Unit period conversion precedes rounding so the converted unit price is rounded too
+	perPeriod, hasPeriod := p.applyUnitPeriod(traceID, resource, serviceType, resp)
+
+	// Display rounding is applied last so every estimator and the fallback share it
+	p.applyRoundTo(traceID, resource, resp, replicated)
+
 	// Test mode: Enhanced logging for calculation result (US3)
 	if p.testMode {
 		p.logger.Debug().
@@ -225,25 +465,44 @@ func (p *AWSPublicPlugin) GetProjectedCost(ctx context.Context, req *pbc.GetProj
 		Str(pluginsdk.FieldResourceType, resource.ResourceType).
 		Str("aws_service", resource.ResourceType).
 		Str("aws_region", resource.Region).
-		Interface("tags", sanitizeTagsForLogging(resource.Tags)).
+		Interface("tags", p.tagSanitizer.sanitize(resource.Tags)).
 		Float64(pluginsdk.FieldCostMonthly, resp.CostPerMonth).
-		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 		Msg("cost calculated")
 
+	p.setMinorUnitsHeader(ctx, traceID, resp)
+	p.setPricingProvenanceHeader(ctx, traceID, serviceType)
+	p.setAssumptionsHeader(ctx, traceID, assumed)
+	p.setZeroCostReasonHeader(ctx, traceID, zeroReason)
+	p.setCarbonRegionsHeader(ctx, traceID, replicated)
+	p.setCostPerPeriodHeader(ctx, traceID, perPeriod, hasPeriod)
+	p.setCarbonRangeHeader(ctx, traceID, bounds)
+	p.setCarbonGridFactorHeader(ctx, traceID, gridFactor, customGrid)
+	p.metrics.observeCarbon(resource.Region, metricsResourceType(resource.ResourceType), resp)
+
 	return resp, nil
 }
 
 // estimateEC2 calculates the projected monthly cost for an EC2 instance.
 // traceID is passed from the parent handler to ensure consistent trace correlation.
-func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDescriptor, req *pbc.GetProjectedCostRequest) (*pbc.GetProjectedCostResponse, error) {
+// With EnvEmitCarbonRange enabled, the idle and full-load carbon bounds go to bounds.
+func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDescriptor, req *pbc.GetProjectedCostRequest, bounds *carbonRange, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// FR-012: Use resource.Sku first, fallback to tags extraction
 	instanceType := resource.Sku
 	if instanceType == "" {
 		instanceType = extractAWSSKU(resource.Tags)
 	}
+	instanceType = normalizeInstanceType(instanceType)
 
 	// Extract OS and tenancy using shared helper (FR-001, FR-002)
-	ec2Attrs := ExtractEC2AttributesFromTags(resource.Tags)
+	ec2Attrs := ExtractEC2AttributesFromTags(p.tagSubset(resource, "platform", "tenancy"))
+	// Resolve a retired family first so the cheapest OS is chosen among the variants
+	// indexed for the type actually priced
+	instanceType, retiredNote := p.successorInstanceType(instanceType, ec2Attrs.OS, ec2Attrs.Tenancy)
+	ec2Attrs, osNote, err := p.resolveEC2OS(traceID, instanceType, resource, ec2Attrs)
+	if err != nil {
+		return nil, err
+	}
 
 	// FR-020: Lookup pricing using embedded data
 	hourlyRate, found := p.pricing.EC2OnDemandPricePerHour(instanceType, ec2Attrs.OS, ec2Attrs.Tenancy)
@@ -255,11 +514,17 @@ func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDesc
 			Str("pricing_source", "embedded").
 			Msg("EC2 instance type not found in pricing data")
 
+		detail := fmt.Sprintf(PricingNotFoundTemplate, "EC2 instance type", instanceType)
+		if p.features.SuggestClosestSKU {
+			if closest := p.closestInstanceType(instanceType, ec2Attrs.OS, ec2Attrs.Tenancy); closest != "" {
+				detail += fmt.Sprintf("; did you mean %s?", closest)
+			}
+		}
 		return &pbc.GetProjectedCostResponse{
 			CostPerMonth:  0,
 			UnitPrice:     0,
 			Currency:      "USD",
-			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "EC2 instance type", instanceType),
+			BillingDetail: detail,
 		}, nil
 	}
 
@@ -271,21 +536,65 @@ func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDesc
 		Float64("unit_price", hourlyRate).
 		Msg("EC2 pricing lookup successful")
 
-	// FR-021: Calculate monthly cost (730 hours/month)
-	costPerMonth := hourlyRate * carbon.HoursPerMonth
+	// Capacity reservations always bill the full month, so a running schedule only
+	// applies to plain on-demand instances
+	capacityReservation := parseBoolVal(p.tagValue(resource, tagEC2CapacityReservation))
+	running := runningHours{hours: carbon.HoursPerMonth}
+	if !capacityReservation {
+		if running, err = p.resourceRunningHours(traceID, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	// FR-021: Calculate monthly cost (730 hours/month unless a schedule reduces it)
+	costPerMonth := hourlyRate * running.hours
+	formula.add(costPerMonth, "$%s/hr × %s hrs", formulaNum(hourlyRate), formulaNum(running.hours))
 
 	// FR-022, FR-023, FR-024: Return response with all required fields
 	resp := &pbc.GetProjectedCostResponse{
 		CostPerMonth:  costPerMonth,
 		UnitPrice:     hourlyRate,
 		Currency:      "USD",
-		BillingDetail: fmt.Sprintf("On-demand %s, %s tenancy, 730 hrs/month", ec2Attrs.OS, ec2Attrs.Tenancy),
+		BillingDetail: fmt.Sprintf("On-demand %s, %s tenancy, %s", ec2Attrs.OS, ec2Attrs.Tenancy, running.detail()),
+	}
+
+	// Make the reserved-capacity charge explicit
+	if capacityReservation {
+		resp.BillingDetail = fmt.Sprintf(
+			"On-demand capacity reservation %s, %s tenancy, 730 hrs/month reserved-capacity charge (billed even when idle)",
+			ec2Attrs.OS, ec2Attrs.Tenancy)
+	}
+
+	resp.BillingDetail += osNote + retiredNote
+
+	// Storage-optimized families bundle local storage in the instance price
+	if storeSpec, ok := carbon.GetInstanceStoreSpec(instanceType); ok {
+		if sizeGB := carbon.InstanceStoreSizeGB(instanceType); sizeGB > 0 {
+			resp.BillingDetail += fmt.Sprintf(", includes %.0f GB %s instance storage (no separate EBS needed)",
+				sizeGB, storeSpec.Technology)
+		} else {
+			resp.BillingDetail += fmt.Sprintf(", includes %s instance storage (no separate EBS needed)", storeSpec.Technology)
+		}
+	}
+
+	// Detailed monitoring is billed by CloudWatch, not EC2, and often overlooked
+	if parseBoolVal(p.tagValue(resource, tagEC2DetailedMonitoring)) {
+		if tiers, found := p.pricing.CloudWatchMetricsTiers(); found {
+			monitoringCost := calculateTieredCost(detailedMonitoringMetrics, tiers)
+			resp.CostPerMonth += monitoringCost
+			resp.BillingDetail += fmt.Sprintf(", detailed monitoring %d CloudWatch metrics ($%.2f/month)",
+				detailedMonitoringMetrics, monitoringCost)
+			formula.add(monitoringCost, "%d metrics at tiered rates (%s)", detailedMonitoringMetrics, tieredFormula(detailedMonitoringMetrics, tiers))
+		} else {
+			resp.BillingDetail += ", detailed monitoring excluded: " +
+				fmt.Sprintf(PricingUnavailableTemplate, "CloudWatch Metrics", p.region)
+		}
 	}
 
 	// Carbon estimation: Calculate carbon footprint for EC2 instance
-	utilization := carbon.GetUtilization(req.UtilizationPercentage, resource.UtilizationPercentage)
+	utilization := p.resourceUtilization(req, resource)
 	carbonGrams, carbonOK := p.carbonEstimator.EstimateCarbonGrams(
-		instanceType, resource.Region, utilization, carbon.HoursPerMonth,
+		instanceType, resource.Region, utilization, running.hours,
 	)
 
 	if carbonOK {
@@ -297,6 +606,14 @@ func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDesc
 			},
 		}
 
+		// CCF power is linear between idle and 100% utilization, so those bound the
+		// estimate whatever utilization was assumed
+		if p.features.EmitCarbonRange {
+			low, _ := p.carbonEstimator.EstimateCarbonGrams(instanceType, resource.Region, 0, running.hours)
+			high, _ := p.carbonEstimator.EstimateCarbonGrams(instanceType, resource.Region, 1, running.hours)
+			bounds.set(carbonGrams, low, high)
+		}
+
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
 			Str("instance_type", instanceType).
 			Str("aws_region", resource.Region).
@@ -308,6 +625,18 @@ func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDesc
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
 			Str("instance_type", instanceType).
 			Msg("Carbon estimation skipped - instance type not in CCF data")
+
+		// Let opted-in clients distinguish "unknown" from "zero" carbon
+		if parseBoolVal(p.tagValue(resource, tagAnnotateMissingCarbon)) {
+			resp.ImpactMetrics = []*pbc.ImpactMetric{
+				{
+					Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
+					Value: 0,
+					Unit:  carbonUnavailableUnit,
+				},
+			}
+			resp.BillingDetail += ", carbon unavailable for this instance type"
+		}
 	}
 
 	// Apply growth hint enrichment
@@ -318,7 +647,23 @@ func (p *AWSPublicPlugin) estimateEC2(traceID string, resource *pbc.ResourceDesc
 
 // estimateEBS calculates the projected monthly cost for an EBS volume.
 // traceID is passed from the parent handler to ensure consistent trace correlation.
-func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	// Multiple volumes described in one request take precedence over the single-volume tags
+	var volumesNote string
+	if volumesJSON := strings.TrimSpace(p.tagValue(resource, tagEBSVolumes)); volumesJSON != "" {
+		var volumes []ebsVolumeSpec
+		err := json.Unmarshal([]byte(volumesJSON), &volumes)
+		if err == nil {
+			return p.estimateEBSVolumes(traceID, resource, volumes, formula)
+		}
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagEBSVolumes).
+			Err(err).
+			Msg("invalid volumes JSON, estimating a single volume")
+		volumesNote = fmt.Sprintf(", '%s' tag ignored (invalid JSON)", tagEBSVolumes)
+	}
+
 	// FR-012: Use resource.Sku first, fallback to tags extraction
 	volumeType := resource.Sku
 	if volumeType == "" {
@@ -330,12 +675,12 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 	sizeAssumed := true
 
 	if resource.Tags != nil {
-		if sizeStr, ok := resource.Tags["size"]; ok {
+		if sizeStr, ok := p.tag(resource, "size"); ok {
 			if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
 				sizeGB = size
 				sizeAssumed = false
 			}
-		} else if sizeStr, ok := resource.Tags["volume_size"]; ok {
+		} else if sizeStr, ok := p.tag(resource, "volume_size"); ok {
 			if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
 				sizeGB = size
 				sizeAssumed = false
@@ -343,6 +688,25 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 		}
 	}
 
+	// st1/sc1 below their minimum size are billed at the minimum
+	var minimumNote string
+	if minGB, ok := ebsMinimumSizeGB[volumeType]; ok && sizeGB < minGB {
+		if sizeAssumed {
+			minimumNote = fmt.Sprintf(" (%s minimum, size defaulted)", volumeType)
+		} else {
+			minimumNote = fmt.Sprintf(" (%d GB requested, billed at the %s minimum)", sizeGB, volumeType)
+		}
+		if sizeAssumed {
+			assumed.add("size", strconv.Itoa(minGB), tagAssumptionReason(resource.Tags, "size"))
+		}
+		sizeGB = minGB
+		sizeAssumed = false
+	}
+
+	if sizeAssumed {
+		assumed.add("size", strconv.Itoa(sizeGB), tagAssumptionReason(resource.Tags, "size"))
+	}
+
 	// FR-020: Lookup pricing using embedded data
 	ratePerGBMonth, found := p.pricing.EBSPricePerGBMonth(volumeType)
 	if !found {
@@ -371,14 +735,25 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 
 	// Calculate monthly cost
 	costPerMonth := ratePerGBMonth * float64(sizeGB)
+	formula.add(costPerMonth, "$%s/GB-mo × %d GB", formulaNum(ratePerGBMonth), sizeGB)
 
 	// FR-043: Include assumption in billing_detail if size was defaulted
 	var billingDetail string
 	if sizeAssumed {
 		billingDetail = fmt.Sprintf("%s volume, %d GB (defaulted), $%.4f/GB-month", volumeType, sizeGB, ratePerGBMonth)
 	} else {
-		billingDetail = fmt.Sprintf("%s volume, %d GB, $%.4f/GB-month", volumeType, sizeGB, ratePerGBMonth)
+		billingDetail = fmt.Sprintf("%s volume, %d GB%s, $%.4f/GB-month", volumeType, sizeGB, minimumNote, ratePerGBMonth)
+	}
+
+	// Provisioned IOPS/throughput add-ons; unrecognized tags are ignored.
+	perfCost, perfDetail := p.estimateEBSPerformance(traceID, volumeType,
+		p.tagSubset(resource, "iops", tagProvisionedIOPS, "throughput"), assumed, formula)
+	costPerMonth += perfCost
+	if perfDetail != "" {
+		billingDetail += ", " + perfDetail
 	}
+	billingDetail += p.gp3SavingsNote(volumeType, sizeGB, ratePerGBMonth)
+	billingDetail += volumesNote
 
 	// FR-022, FR-023, FR-024: Build response
 	resp := &pbc.GetProjectedCostResponse{
@@ -388,11 +763,37 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 		BillingDetail: billingDetail,
 	}
 
+	// Carbon follows the used size; cost stays on the provisioned size
+	carbonSizeGB := float64(sizeGB)
+	if usedStr := p.tagValue(resource, tagEBSUsedGB); usedStr != "" {
+		used, err := strconv.ParseFloat(usedStr, 64)
+		switch {
+		case err != nil || used < 0 || math.IsNaN(used):
+			p.logger.Warn().
+				Str(pluginsdk.FieldTraceID, traceID).
+				Str("tag", tagEBSUsedGB).
+				Str("value", usedStr).
+				Msg("invalid used size, computing carbon on the provisioned size")
+			assumed.add(tagEBSUsedGB, strconv.Itoa(sizeGB), assumptionInvalid)
+		case used > carbonSizeGB:
+			p.logger.Warn().
+				Str(pluginsdk.FieldTraceID, traceID).
+				Str("tag", tagEBSUsedGB).
+				Float64("used_gb", used).
+				Int("size_gb", sizeGB).
+				Msg("used size exceeds provisioned size, computing carbon on the provisioned size")
+		default:
+			carbonSizeGB = used
+			resp.BillingDetail += fmt.Sprintf(", carbon on %s GB used of %d GB provisioned",
+				strconv.FormatFloat(used, 'f', -1, 64), sizeGB)
+		}
+	}
+
 	// Carbon estimation for EBS volume
 	ebsEstimator := carbon.NewEBSEstimator()
 	carbonGrams, carbonOK := ebsEstimator.EstimateCarbonGrams(carbon.EBSVolumeConfig{
 		VolumeType: volumeType,
-		SizeGB:     float64(sizeGB),
+		SizeGB:     carbonSizeGB,
 		Region:     resource.Region,
 		Hours:      HoursPerMonthProd,
 	})
@@ -409,6 +810,7 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
 			Str("volume_type", volumeType).
 			Int("size_gb", sizeGB).
+			Float64("carbon_size_gb", carbonSizeGB).
 			Str("aws_region", resource.Region).
 			Float64("carbon_grams", carbonGrams).
 			Msg("EBS carbon estimation successful")
@@ -420,8 +822,276 @@ func (p *AWSPublicPlugin) estimateEBS(traceID string, resource *pbc.ResourceDesc
 	return resp, nil
 }
 
+// gp3SavingsNote returns a billing-detail note with the monthly savings of the same size
+// as gp3 when volumeType is gp2 and gp3 is priced lower in the region. The estimate itself
+// stays on gp2; GetRecommendations proposes the migration.
+func (p *AWSPublicPlugin) gp3SavingsNote(volumeType string, sizeGB int, gp2Rate float64) string {
+	if volumeType != "gp2" {
+		return ""
+	}
+	gp3Rate, found := p.pricing.EBSPricePerGBMonth("gp3")
+	if !found || gp3Rate >= gp2Rate {
+		return ""
+	}
+	gp3Monthly := gp3Rate * float64(sizeGB)
+	return fmt.Sprintf(", gp3 would save $%.2f/month ($%.2f vs $%.2f)",
+		gp2Rate*float64(sizeGB)-gp3Monthly, gp3Monthly, gp2Rate*float64(sizeGB))
+}
+
+// ebsVolumeSpec is one entry of the JSON-encoded "volumes" tag. IOPS and Throughput
+// are the volume's provisioned performance, priced like the single-volume "iops" and
+// "throughput" tags.
+type ebsVolumeSpec struct {
+	Type       string  `json:"type"`
+	Size       float64 `json:"size"`
+	IOPS       *int64  `json:"iops,omitempty"`
+	Throughput *int64  `json:"throughput,omitempty"`
+}
+
+// performanceTags returns the volume's provisioned performance in the tag form
+// estimateEBSPerformance reads.
+func (v ebsVolumeSpec) performanceTags() map[string]string {
+	tags := make(map[string]string, 2)
+	if v.IOPS != nil {
+		tags["iops"] = strconv.FormatInt(*v.IOPS, 10)
+	}
+	if v.Throughput != nil {
+		tags["throughput"] = strconv.FormatInt(*v.Throughput, 10)
+	}
+	return tags
+}
+
+// estimateEBSVolumes prices several EBS volumes in one response, e.g. all volumes attached
+// to one instance. Each entry is itemized in the billing detail; entries with a missing or
+// unpriced type or a size that is not a positive whole number of GB are skipped and noted.
+// Provisioned IOPS and throughput are priced per entry as for a single volume, and an
+// io1/io2 entry without iops notes that its IOPS charges are excluded. UnitPrice is the
+// blended $/GB-month across the priced volumes, performance charges included.
+func (p *AWSPublicPlugin) estimateEBSVolumes(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	volumes []ebsVolumeSpec,
+	formula *billingFormula,
+) (*pbc.GetProjectedCostResponse, error) {
+	var costPerMonth, totalGB, carbonGrams float64
+	var carbonOK bool
+	var items, skipped []string
+
+	ebsEstimator := carbon.NewEBSEstimator()
+	for i, vol := range volumes {
+		n := i + 1
+
+		var reason string
+		ratePerGBMonth, found := p.pricing.EBSPricePerGBMonth(vol.Type)
+		switch {
+		case vol.Type == "":
+			reason = "missing type"
+		case vol.Size <= 0 || vol.Size != math.Trunc(vol.Size):
+			reason = fmt.Sprintf("invalid size %g", vol.Size)
+		case !found:
+			reason = fmt.Sprintf("unknown volume type %q", vol.Type)
+		}
+		if reason != "" {
+			p.logger.Warn().
+				Str(pluginsdk.FieldTraceID, traceID).
+				Str("tag", tagEBSVolumes).
+				Int("volume", n).
+				Str("reason", reason).
+				Msg("skipping invalid EBS volume entry")
+			skipped = append(skipped, fmt.Sprintf("[%d] %s", n, reason))
+			continue
+		}
+
+		var minimumNote string
+		if minGB, ok := ebsMinimumSizeGB[vol.Type]; ok && vol.Size < float64(minGB) {
+			minimumNote = fmt.Sprintf(" (%g GB requested, %s minimum)", vol.Size, vol.Type)
+			vol.Size = float64(minGB)
+		}
+
+		storageCost := ratePerGBMonth * vol.Size
+		formula.add(storageCost, "[%d] $%s/GB-mo × %s GB", n, formulaNum(ratePerGBMonth), formulaNum(vol.Size))
+		perfCost, perfDetail := p.estimateEBSPerformance(traceID, vol.Type, vol.performanceTags(), nil, formula)
+		if perfDetail != "" {
+			perfDetail = ", " + perfDetail
+		}
+		volumeCost := storageCost + perfCost
+		costPerMonth += volumeCost
+		totalGB += vol.Size
+		items = append(items, fmt.Sprintf("[%d] %s %g GB%s at $%.4f/GB-month%s ($%.2f)",
+			n, vol.Type, vol.Size, minimumNote, ratePerGBMonth, perfDetail, volumeCost))
+
+		if grams, ok := ebsEstimator.EstimateCarbonGrams(carbon.EBSVolumeConfig{
+			VolumeType: vol.Type,
+			SizeGB:     vol.Size,
+			Region:     resource.Region,
+			Hours:      HoursPerMonthProd,
+		}); ok {
+			carbonGrams += grams
+			carbonOK = true
+		}
+	}
+
+	var billingDetail string
+	if len(items) == 0 {
+		billingDetail = fmt.Sprintf("No valid EBS volumes in '%s' tag", tagEBSVolumes)
+	} else {
+		billingDetail = fmt.Sprintf("EBS volumes (%d), %g GB total: %s",
+			len(items), totalGB, strings.Join(items, "; "))
+	}
+	if len(skipped) > 0 {
+		billingDetail += fmt.Sprintf(", skipped %s", strings.Join(skipped, "; "))
+	}
+
+	var unitPrice float64
+	if totalGB > 0 {
+		unitPrice = costPerMonth / totalGB
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     unitPrice,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+	}
+
+	if carbonOK {
+		resp.ImpactMetrics = []*pbc.ImpactMetric{
+			{
+				Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
+				Value: carbonGrams,
+				Unit:  "gCO2e",
+			},
+		}
+	}
+
+	p.traceLogger(traceID, "GetProjectedCost").Debug().
+		Int("volumes", len(items)).
+		Int("skipped", len(skipped)).
+		Float64("total_gb", totalGB).
+		Float64("cost_per_month", costPerMonth).
+		Msg("EBS multi-volume estimation complete")
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:ebs:volume", resp)
+
+	return resp, nil
+}
+
+// estimateEBSPerformance returns the monthly cost and billing detail for provisioned
+// IOPS and throughput on an EBS volume, read from the "iops" (or "provisioned_iops") and
+// "throughput" tags.
+//
+// gp3 bills only above its 3000 IOPS / 125 MiB/s baseline; io1/io2 bill every
+// provisioned IOPS, io2 at lower rates above 32,000 and 64,000 IOPS when the pricing
+// data has its tiers. Other volume types have no performance add-ons and return (0, "").
+func (p *AWSPublicPlugin) estimateEBSPerformance(
+	traceID, volumeType string,
+	tags map[string]string,
+	assumed *assumptions,
+	formula *billingFormula,
+) (float64, string) {
+	iopsStr, hasIOPS := tags["iops"]
+	if !hasIOPS {
+		iopsStr, hasIOPS = tags[tagProvisionedIOPS]
+	}
+	throughputStr, hasThroughput := tags["throughput"]
+
+	var cost float64
+	var details []string
+
+	switch volumeType {
+	case "gp3":
+		if !hasIOPS && !hasThroughput {
+			return 0, ""
+		}
+
+		iops := int64(gp3BaselineIOPS)
+		iopsNote := " (defaulted)"
+		if hasIOPS {
+			iops = p.validateNonNegativeInt64(traceID, "iops", iopsStr)
+			iopsNote = ""
+		} else {
+			assumed.add("iops", strconv.Itoa(gp3BaselineIOPS), assumptionNotSet)
+		}
+		if billable := iops - gp3BaselineIOPS; billable > 0 {
+			if rate, found := p.pricing.EBSIOPSPricePerMonth(volumeType); found {
+				cost += float64(billable) * rate
+				details = append(details, fmt.Sprintf("%d IOPS (%d above baseline at $%.4f/IOPS-month)", iops, billable, rate))
+				formula.add(float64(billable)*rate, "$%s/IOPS-mo × (%d − %d) IOPS", formulaNum(rate), iops, gp3BaselineIOPS)
+			} else {
+				details = append(details, fmt.Sprintf("%d IOPS (IOPS pricing unavailable)", iops))
+			}
+		} else {
+			details = append(details, fmt.Sprintf("%d IOPS%s, within baseline", iops, iopsNote))
+		}
+
+		throughput := int64(gp3BaselineThroughput)
+		throughputNote := " (defaulted)"
+		if hasThroughput {
+			throughput = p.validateNonNegativeInt64(traceID, "throughput", throughputStr)
+			throughputNote = ""
+		} else {
+			assumed.add("throughput", strconv.Itoa(gp3BaselineThroughput), assumptionNotSet)
+		}
+		if billable := throughput - gp3BaselineThroughput; billable > 0 {
+			if rate, found := p.pricing.EBSThroughputPricePerMonth(volumeType); found {
+				cost += float64(billable) * rate
+				details = append(details, fmt.Sprintf("%d MiB/s (%d above baseline at $%.4f/MiBps-month)", throughput, billable, rate))
+				formula.add(float64(billable)*rate, "$%s/MiBps-mo × (%d − %d) MiB/s", formulaNum(rate), throughput, gp3BaselineThroughput)
+			} else {
+				details = append(details, fmt.Sprintf("%d MiB/s (throughput pricing unavailable)", throughput))
+			}
+		} else {
+			details = append(details, fmt.Sprintf("%d MiB/s%s, within baseline", throughput, throughputNote))
+		}
+
+	case "io1", "io2":
+		if !hasIOPS {
+			return 0, "IOPS not specified, provisioned IOPS charges excluded"
+		}
+		iops := p.validateNonNegativeInt64(traceID, "iops", iopsStr)
+		if tiers, found := p.pricing.EBSIOPSTiers(volumeType); found {
+			return p.estimateTieredIOPS(iops, tiers, formula)
+		}
+		rate, found := p.pricing.EBSIOPSPricePerMonth(volumeType)
+		if !found {
+			return 0, fmt.Sprintf("%d IOPS (IOPS pricing unavailable)", iops)
+		}
+		cost = float64(iops) * rate
+		details = append(details, fmt.Sprintf("%d IOPS at $%.4f/IOPS-month", iops, rate))
+		formula.add(cost, "$%s/IOPS-mo × %d IOPS", formulaNum(rate), iops)
+
+	default:
+		return 0, ""
+	}
+
+	return cost, strings.Join(details, ", ")
+}
+
+// estimateTieredIOPS returns the monthly cost and billing detail for iops provisioned
+// IOPS priced in tiers, itemizing the IOPS billed at each tier's rate.
+func (p *AWSPublicPlugin) estimateTieredIOPS(
+	iops int64,
+	tiers []pricing.TierRate,
+	formula *billingFormula,
+) (float64, string) {
+	cost := calculateTieredCost(float64(iops), tiers)
+	var parts []string
+	lower := 0.0
+	for _, tier := range tiers {
+		if float64(iops) <= lower {
+			break
+		}
+		inTier := math.Min(float64(iops), tier.UpTo) - lower
+		parts = append(parts, fmt.Sprintf("%.0f at $%.4f", inTier, tier.Rate))
+		formula.add(inTier*tier.Rate, "$%s/IOPS-mo × %.0f IOPS", formulaNum(tier.Rate), inTier)
+		lower = tier.UpTo
+	}
+	return cost, fmt.Sprintf("%d IOPS (%s /IOPS-month)", iops, strings.Join(parts, " + "))
+}
+
 // estimateS3 calculates projected monthly cost for S3 storage.
-func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, regions *carbonRegions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	storageClass := resource.Sku
 
 	// Extract size from tags, default to 1GB
@@ -429,7 +1099,7 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 	sizeAssumed := true
 
 	if resource.Tags != nil {
-		if sizeStr, ok := resource.Tags["size"]; ok {
+		if sizeStr, ok := p.tag(resource, "size"); ok {
 			if size, err := strconv.ParseFloat(sizeStr, 64); err == nil && size > 0 {
 				sizeGB = size
 				sizeAssumed = false
@@ -437,6 +1107,10 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 		}
 	}
 
+	if sizeAssumed {
+		assumed.add("size", strconv.FormatFloat(sizeGB, 'f', -1, 64), tagAssumptionReason(resource.Tags, "size"))
+	}
+
 	// Lookup pricing using embedded data
 	ratePerGBMonth, found := p.pricing.S3PricePerGBMonth(storageClass)
 	if !found {
@@ -465,6 +1139,7 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 
 	// Calculate monthly cost
 	costPerMonth := ratePerGBMonth * sizeGB
+	formula.add(costPerMonth, "$%s/GB-mo × %s GB", formulaNum(ratePerGBMonth), formulaNum(sizeGB))
 
 	// Include assumption in billing_detail if size was defaulted
 	var billingDetail string
@@ -474,6 +1149,22 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 		billingDetail = fmt.Sprintf("S3 %s storage, %.0f GB, $%.4f/GB-month", storageClass, sizeGB, ratePerGBMonth)
 	}
 
+	// Retrieval is itemized after storage; classes without a retrieval fee ignore the tag
+	if retrievalStr := p.tagValue(resource, tagS3RetrievalGB); retrievalStr != "" {
+		retrievalGB := p.validateNonNegativeFloat64(traceID, tagS3RetrievalGB, retrievalStr)
+		if retrievalRate, ok := p.pricing.S3RetrievalPricePerGB(storageClass); ok && retrievalGB > 0 {
+			retrievalCost := retrievalGB * retrievalRate
+			costPerMonth += retrievalCost
+			formula.add(retrievalCost, "$%s/GB retrieved × %s GB", formulaNum(retrievalRate), formulaNum(retrievalGB))
+			billingDetail += fmt.Sprintf(" ($%.2f); retrieval %.0f GB at $%.4f/GB ($%.2f)",
+				ratePerGBMonth*sizeGB, retrievalGB, retrievalRate, retrievalCost)
+		}
+	}
+
+	if days, ok := s3MinimumStorageDays[storageClass]; ok {
+		billingDetail += fmt.Sprintf("; %d-day minimum storage duration", days)
+	}
+
 	resp := &pbc.GetProjectedCostResponse{
 		CostPerMonth:  costPerMonth,
 		UnitPrice:     ratePerGBMonth,
@@ -489,6 +1180,12 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 		Region:       resource.Region,
 		Hours:        HoursPerMonthProd,
 	})
+	if carbonOK {
+		if total, note, ok := p.replicationCarbon(traceID, resource, "s3", storageClass, sizeGB, carbonGrams, regions); ok {
+			carbonGrams = total
+			resp.BillingDetail += note
+		}
+	}
 
 	if carbonOK {
 		resp.ImpactMetrics = []*pbc.ImpactMetric{
@@ -515,9 +1212,19 @@ func (p *AWSPublicPlugin) estimateS3(traceID string, resource *pbc.ResourceDescr
 
 // validateNonNegativeInt64 validates and parses an int64 tag value.
 // Returns the parsed value (defaulting to 0 if negative) and logs a warning if invalid.
+// Values too large for int64 are capped at math.MaxInt64 rather than treated as malformed,
+// so an oversized quantity never yields a misleadingly cheap estimate.
 func (p *AWSPublicPlugin) validateNonNegativeInt64(traceID, tagName, value string) int64 {
 	v, err := strconv.ParseInt(value, 10, 64)
-	if err != nil {
+	if errors.Is(err, strconv.ErrRange) && v > 0 {
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagName).
+			Str("value", value).
+			Msg("integer value overflows int64, capping at maximum")
+		return math.MaxInt64
+	}
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
 		p.logger.Warn().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Str("tag", tagName).
@@ -533,13 +1240,30 @@ func (p *AWSPublicPlugin) validateNonNegativeInt64(traceID, tagName, value strin
 			Msg("negative value, defaulting to 0")
 		return 0
 	}
+	p.warnImplausibleUsage(traceID, tagName, float64(v))
 	return v
 }
 
+// maxFloatUsage caps float usage tags at the same bound as integer ones. Multiplying
+// math.MaxFloat64 by a rate or by hours overflows to +Inf, so an unbounded quantity
+// would turn into an infinite cost; this bound leaves ample headroom for both.
+const maxFloatUsage = float64(math.MaxInt64)
+
 // validateNonNegativeFloat64 validates and parses a float64 tag value.
+// Values above maxFloatUsage, including ones beyond the float64 range and "Inf", are
+// capped at maxFloatUsage so the estimate stays finite; NaN is treated as invalid.
 func (p *AWSPublicPlugin) validateNonNegativeFloat64(traceID, tagName, value string) float64 {
 	v, err := strconv.ParseFloat(value, 64)
-	if err != nil {
+	if v > maxFloatUsage {
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagName).
+			Str("value", value).
+			Float64("capped", maxFloatUsage).
+			Msg("float value exceeds maximum usage quantity, capping")
+		return maxFloatUsage
+	}
+	if (err != nil && !errors.Is(err, strconv.ErrRange)) || math.IsNaN(v) {
 		p.logger.Warn().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Str("tag", tagName).
@@ -555,11 +1279,12 @@ func (p *AWSPublicPlugin) validateNonNegativeFloat64(traceID, tagName, value str
 			Msg("negative value, defaulting to 0")
 		return 0
 	}
+	p.warnImplausibleUsage(traceID, tagName, float64(v))
 	return v
 }
 
 // estimateDynamoDB calculates projected monthly cost for DynamoDB tables.
-func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.ResourceDescriptor, regions *carbonRegions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	capacityMode := strings.ToLower(resource.Sku)
 	if capacityMode == "" {
 		capacityMode = "on-demand"
@@ -572,7 +1297,7 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 
 	// Extract common storage
 	if resource.Tags != nil {
-		if s, ok := resource.Tags["storage_gb"]; ok {
+		if s, ok := p.tag(resource, "storage_gb"); ok {
 			storageGB = p.validateNonNegativeFloat64(traceID, "storage_gb", s)
 		}
 	}
@@ -591,10 +1316,10 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 	if capacityMode == "provisioned" {
 		// Provisioned Mode
 		if resource.Tags != nil {
-			if s, ok := resource.Tags["read_capacity_units"]; ok {
+			if s, ok := p.tag(resource, "read_capacity_units"); ok {
 				readUnits = p.validateNonNegativeInt64(traceID, "read_capacity_units", s)
 			}
-			if s, ok := resource.Tags["write_capacity_units"]; ok {
+			if s, ok := p.tag(resource, "write_capacity_units"); ok {
 				writeUnits = p.validateNonNegativeInt64(traceID, "write_capacity_units", s)
 			}
 		}
@@ -622,6 +1347,9 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 		rcuCost := float64(readUnits) * 730 * rcuPrice
 		wcuCost := float64(writeUnits) * 730 * wcuPrice
 		totalCost := rcuCost + wcuCost + storageCost
+		formula.add(rcuCost, "$%s/RCU-hr × %d RCUs × 730 hrs", formulaNum(rcuPrice), readUnits)
+		formula.add(wcuCost, "$%s/WCU-hr × %d WCUs × 730 hrs", formulaNum(wcuPrice), writeUnits)
+		formula.add(storageCost, "$%s/GB-mo × %s GB", formulaNum(storagePrice), formulaNum(storageGB))
 
 		billingDetail = fmt.Sprintf("DynamoDB provisioned, %d RCUs, %d WCUs, 730 hrs/month, %.0fGB storage",
 			readUnits, writeUnits, storageGB)
@@ -656,6 +1384,12 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 			Region: resource.Region,
 			Hours:  HoursPerMonthProd,
 		})
+		if carbonOK && storageGB > 0 {
+			if total, note, ok := p.replicationCarbon(traceID, resource, "dynamodb", "DYNAMODB", storageGB, carbonGrams, regions); ok {
+				carbonGrams = total
+				resp.BillingDetail += note
+			}
+		}
 
 		if carbonOK && storageGB > 0 {
 			resp.ImpactMetrics = []*pbc.ImpactMetric{
@@ -682,10 +1416,10 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 
 	// Default to On-Demand Mode
 	if resource.Tags != nil {
-		if s, ok := resource.Tags["read_requests_per_month"]; ok {
+		if s, ok := p.tag(resource, "read_requests_per_month"); ok {
 			readUnits = p.validateNonNegativeInt64(traceID, "read_requests_per_month", s)
 		}
-		if s, ok := resource.Tags["write_requests_per_month"]; ok {
+		if s, ok := p.tag(resource, "write_requests_per_month"); ok {
 			writeUnits = p.validateNonNegativeInt64(traceID, "write_requests_per_month", s)
 		}
 	}
@@ -714,6 +1448,9 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 	readCost := float64(readUnits) * readPrice
 	writeCost := float64(writeUnits) * writePrice
 	totalCost := readCost + writeCost + storageCost
+	formula.add(readCost, "$%s/read × %d reads", formulaNum(readPrice), readUnits)
+	formula.add(writeCost, "$%s/write × %d writes", formulaNum(writePrice), writeUnits)
+	formula.add(storageCost, "$%s/GB-mo × %s GB", formulaNum(storagePrice), formulaNum(storageGB))
 
 	billingDetail = fmt.Sprintf("DynamoDB on-demand, %d reads, %d writes, %.0fGB storage",
 		readUnits, writeUnits, storageGB)
@@ -748,6 +1485,12 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 		Region: resource.Region,
 		Hours:  HoursPerMonthProd,
 	})
+	if carbonOK && storageGB > 0 {
+		if total, note, ok := p.replicationCarbon(traceID, resource, "dynamodb", "DYNAMODB", storageGB, carbonGrams, regions); ok {
+			carbonGrams = total
+			resp.BillingDetail += note
+		}
+	}
 
 	if carbonOK && storageGB > 0 {
 		resp.ImpactMetrics = []*pbc.ImpactMetric{
@@ -772,7 +1515,7 @@ func (p *AWSPublicPlugin) estimateDynamoDB(traceID string, resource *pbc.Resourc
 }
 
 // estimateELB calculates projected monthly cost for load balancers.
-func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// 1. Identify Load Balancer Type (ALB vs NLB)
 	// Default to ALB per clarification
 	lbType := "alb"
@@ -785,20 +1528,23 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 
 	// 2. Extract Capacity Units from Tags
 	capacityUnits := 0.0
+	capacityTag := "capacity_units"
 	tagFound := false
 	if resource.Tags != nil {
 		// Specific tags take precedence
 		if lbType == "alb" {
-			if s, ok := resource.Tags["lcu_per_hour"]; ok {
+			if s, ok := p.tag(resource, "lcu_per_hour"); ok {
 				if v, err := strconv.ParseFloat(s, 64); err == nil && v >= 0 {
 					capacityUnits = v
+					capacityTag = "lcu_per_hour"
 					tagFound = true
 				}
 			}
 		} else {
-			if s, ok := resource.Tags["nlcu_per_hour"]; ok {
+			if s, ok := p.tag(resource, "nlcu_per_hour"); ok {
 				if v, err := strconv.ParseFloat(s, 64); err == nil && v >= 0 {
 					capacityUnits = v
+					capacityTag = "nlcu_per_hour"
 					tagFound = true
 				}
 			}
@@ -806,7 +1552,7 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 
 		// Generic fallback if specific tag not found or invalid
 		if !tagFound {
-			if s, ok := resource.Tags["capacity_units"]; ok {
+			if s, ok := p.tag(resource, "capacity_units"); ok {
 				if v, err := strconv.ParseFloat(s, 64); err == nil && v >= 0 {
 					capacityUnits = v
 				}
@@ -815,14 +1561,7 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 	}
 
 	// Warn if capacity units are unusually high (#165)
-	const warnCapacityUnitThreshold = 1000.0
-	if capacityUnits > warnCapacityUnitThreshold {
-		p.logger.Warn().
-			Str(pluginsdk.FieldTraceID, traceID).
-			Float64("capacity_units", capacityUnits).
-			Float64("threshold", warnCapacityUnitThreshold).
-			Msg("Capacity units unusually high - verify this is intentional")
-	}
+	p.warnImplausibleUsage(traceID, capacityTag, capacityUnits)
 
 	// 3. Lookup Pricing
 	var fixedRate, cuRate float64
@@ -839,6 +1578,22 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 		cuMetricName = "NLCU"
 	}
 
+	// Outposts-hosted load balancers resolve Outposts rates where the region publishes them
+	onOutposts := parseBoolVal(p.tagValue(resource, tagOutposts))
+	var regionalComponents []string
+	if onOutposts {
+		if rate, found := p.pricing.ELBOutpostsPricePerHour(lbType); found {
+			fixedRate, fixedFound = rate, true
+		} else {
+			regionalComponents = append(regionalComponents, "hourly")
+		}
+		if rate, found := p.pricing.ELBOutpostsPricePerCapacityUnit(lbType); found {
+			cuRate, cuFound = rate, true
+		} else {
+			regionalComponents = append(regionalComponents, cuMetricName)
+		}
+	}
+
 	if !fixedFound || !cuFound {
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
 			Str("lb_type", lbType).
@@ -857,10 +1612,20 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 	fixedMonthly := carbon.HoursPerMonth * fixedRate
 	cuMonthly := carbon.HoursPerMonth * capacityUnits * cuRate
 	totalMonthly := fixedMonthly + cuMonthly
+	formula.add(fixedMonthly, "$%s/hr × 730 hrs", formulaNum(fixedRate))
+	formula.add(cuMonthly, "$%s/%s-hr × %s %s × 730 hrs", formulaNum(cuRate), cuMetricName, formulaNum(capacityUnits), cuMetricName)
 
 	// 5. Build Billing Detail
 	billingDetail := fmt.Sprintf("%s, 730 hrs/month, %.1f %s avg/hr",
 		strings.ToUpper(lbType), capacityUnits, cuMetricName)
+	if onOutposts {
+		billingDetail = fmt.Sprintf("%s on Outposts, 730 hrs/month, %.1f %s avg/hr",
+			strings.ToUpper(lbType), capacityUnits, cuMetricName)
+		if len(regionalComponents) > 0 {
+			billingDetail += fmt.Sprintf(" (no Outposts pricing for %s, regional rate used)",
+				strings.Join(regionalComponents, " or "))
+		}
+	}
 
 	p.logger.Debug().
 		Str("lb_type", lbType).
@@ -884,8 +1649,16 @@ func (p *AWSPublicPlugin) estimateELB(traceID string, resource *pbc.ResourceDesc
 	return resp, nil
 }
 
-// estimateStub returns $0 cost for services not yet implemented.
-func (p *AWSPublicPlugin) estimateStub(resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+// estimateStub returns $0 cost for services not yet implemented, or codes.Unimplemented
+// when the request carries the finfocus-stub-unimplemented metadata.
+func (p *AWSPublicPlugin) estimateStub(ctx context.Context, traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+	// Strict clients must not mistake the placeholder for a real zero-cost resource
+	if stubUnimplementedRequested(ctx) {
+		return nil, p.newErrorWithID(traceID, codes.Unimplemented,
+			fmt.Sprintf("%s cost estimation is not implemented", resource.ResourceType),
+			pbc.ErrorCode_ERROR_CODE_UNSPECIFIED)
+	}
+
 	// FR-025 & FR-026: Return $0 with explanation
 	return &pbc.GetProjectedCostResponse{
 		CostPerMonth:  0,
@@ -897,7 +1670,7 @@ func (p *AWSPublicPlugin) estimateStub(resource *pbc.ResourceDescriptor) (*pbc.G
 
 // estimateRDS calculates the projected monthly cost for an RDS instance.
 // traceID is passed from the parent handler to ensure consistent trace correlation.
-func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// FR-012: Use resource.Sku first, fallback to tags extraction
 	instanceType := resource.Sku
 	if instanceType == "" {
@@ -908,7 +1681,7 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 	engine := defaultRDSEngine
 	engineDefaulted := true
 	if resource.Tags != nil {
-		if engineTag, ok := resource.Tags["engine"]; ok && engineTag != "" {
+		if engineTag, ok := p.tag(resource, "engine"); ok && engineTag != "" {
 			engine = strings.ToLower(engineTag)
 			engineDefaulted = false
 		}
@@ -922,49 +1695,81 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 		engineDefaulted = true
 	}
 
+	// Aurora engines use cluster storage (Standard or I/O-Optimized) instead of EBS volume types
+	isAurora := strings.HasPrefix(normalizedEngine, "Aurora")
+	defaultStorage := defaultRDSStorage
+	if isAurora {
+		defaultStorage = rdsStorageAurora
+	}
+
 	// Extract storage info from tags
-	storageType := defaultRDSStorage
+	storageType := defaultStorage
 	storageDefaulted := true
 	if resource.Tags != nil {
-		if st, ok := resource.Tags["storage_type"]; ok && st != "" {
+		if st, ok := p.tag(resource, "storage_type"); ok && st != "" {
 			storageType = strings.ToLower(st)
 			storageDefaulted = false
 		}
 	}
 
-	// Validate storage type
-	if !validRDSStorageTypes[storageType] {
-		storageType = defaultRDSStorage
+	// Validate storage type against the engine family
+	_, auroraStorage := auroraStorageLabels[storageType]
+	if (isAurora && !auroraStorage) || (!isAurora && !validRDSStorageTypes[storageType]) {
+		storageType = defaultStorage
 		storageDefaulted = true
 	}
+	ioOptimized := storageType == rdsStorageAuroraIOOptimized
 
-	// Extract storage size from tags
-	storageSizeGB := defaultRDSSizeGB
-	sizeDefaulted := true
+	// Extract pricing model from tags, default to on-demand
+	pricingModel := rdsPricingOnDemand
+	pricingModelDefaulted := false
 	if resource.Tags != nil {
-		if sizeStr, ok := resource.Tags["storage_size"]; ok {
-			if size, err := strconv.Atoi(sizeStr); err == nil && size > 0 {
-				storageSizeGB = size
-				sizeDefaulted = false
-			}
+		if pm, ok := p.tag(resource, "pricing_model"); ok && pm != "" {
+			pricingModel = strings.ToLower(pm)
 		}
 	}
+	if pricingModel != rdsPricingOnDemand && pricingModel != rdsPricingReserved1yr {
+		pricingModel = rdsPricingOnDemand
+		pricingModelDefaulted = true
+	}
 
-	// Extract Multi-AZ from tags (for carbon estimation)
-	multiAZ := false
+	// Extract storage size from tags; Aurora cluster volumes prefer aurora_storage_gb
+	storageSizeGB := defaultRDSSizeGB
+	sizeDefaulted := true
+	sizeTags := []string{"storage_size"}
+	if isAurora {
+		sizeTags = []string{tagAuroraStorageGB, "storage_size"}
+	}
+	for _, sizeTag := range sizeTags {
+		if size, err := strconv.Atoi(p.tagValue(resource, sizeTag)); err == nil && size > 0 {
+			storageSizeGB = size
+			sizeDefaulted = false
+			break
+		}
+	}
+
+	// Extract Multi-AZ from tags (for carbon estimation)
+	multiAZ := false
 	if resource.Tags != nil {
-		if multiAZStr, ok := resource.Tags["multi_az"]; ok {
+		if multiAZStr, ok := p.tag(resource, "multi_az"); ok {
 			multiAZ = strings.EqualFold(multiAZStr, "true")
 		}
 	}
 
-	// Lookup instance hourly rate
-	hourlyRate, found := p.pricing.RDSOnDemandPricePerHour(instanceType, normalizedEngine)
+	// Lookup instance hourly rate. I/O-Optimized clusters bill a higher instance rate.
+	var hourlyRate float64
+	var found bool
+	if ioOptimized {
+		hourlyRate, found = p.pricing.RDSAuroraIOOptimizedPricePerHour(instanceType, normalizedEngine)
+	} else {
+		hourlyRate, found = p.pricing.RDSOnDemandPricePerHour(instanceType, normalizedEngine)
+	}
 	if !found {
 		// Unknown instance type - return $0 with explanation
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
 			Str("instance_type", instanceType).
 			Str("engine", normalizedEngine).
+			Str("storage_type", storageType).
 			Str("aws_region", p.region).
 			Str("pricing_source", "embedded").
 			Msg("RDS instance type not found in pricing data")
@@ -977,6 +1782,22 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 		}, nil
 	}
 
+	// Reserved rates are only embedded for 1yr No Upfront on standard storage;
+	// anything else is priced on-demand with a note.
+	var pricingNotes []string
+	if pricingModel == rdsPricingReserved1yr {
+		reservedRate, reservedFound := 0.0, false
+		if !ioOptimized {
+			reservedRate, reservedFound = p.pricing.RDSReservedPricePerHour(instanceType, normalizedEngine)
+		}
+		if reservedFound {
+			hourlyRate = reservedRate
+		} else {
+			pricingModel = rdsPricingOnDemand
+			pricingNotes = append(pricingNotes, "reserved 1yr rate unavailable, priced on-demand")
+		}
+	}
+
 	// Lookup storage rate
 	storageRate, storageFound := p.pricing.RDSStoragePricePerGBMonth(storageType)
 	if !storageFound {
@@ -984,11 +1805,40 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 		storageRate = 0
 	}
 
+	// Storage autoscaling headroom (Aurora cluster storage grows on its own and has no ceiling tag)
+	var maxStorageGB int64
+	if maxStr, ok := p.tag(resource, tagRDSMaxAllocatedStorage); ok && maxStr != "" && !isAurora {
+		maxStorageGB = p.validateNonNegativeInt64(traceID, tagRDSMaxAllocatedStorage, maxStr)
+	}
+
+	// Aurora Standard bills I/O requests separately; they are only included when provided
+	var ioRequests int64
+	var ioRate, ioCostPerMonth float64
+	ioProvided := false
+	if storageType == rdsStorageAurora {
+		for _, ioTag := range []string{tagAuroraIORequests, "io_requests_per_month"} {
+			if ioStr := p.tagValue(resource, ioTag); ioStr != "" {
+				ioRequests = p.validateNonNegativeInt64(traceID, ioTag, ioStr)
+				ioProvided = true
+				break
+			}
+		}
+	}
+	if ioProvided {
+		if rate, ioFound := p.pricing.RDSAuroraIORequestPrice(); ioFound {
+			ioRate = rate
+			ioCostPerMonth = ioRate * float64(ioRequests)
+		} else {
+			pricingNotes = append(pricingNotes, "Aurora I/O rate unavailable, I/O charges excluded")
+		}
+	}
+
 	// Debug log successful lookup
 	p.logger.Debug().
 		Str("instance_type", instanceType).
 		Str("engine", normalizedEngine).
 		Str("storage_type", storageType).
+		Str("pricing_model", pricingModel).
 		Int("storage_size_gb", storageSizeGB).
 		Str("aws_region", p.region).
 		Str("pricing_source", "embedded").
@@ -996,30 +1846,126 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 		Float64("storage_rate", storageRate).
 		Msg("RDS pricing lookup successful")
 
+	// Instance hours follow the running schedule; storage is billed while stopped too
+	running, err := p.resourceRunningHours(traceID, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := 0
+	if val := p.tagValue(resource, tagRDSReadReplicas); val != "" {
+		parsed, parseErr := strconv.Atoi(val)
+		if parseErr != nil {
+			return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("invalid value for '%s': %q is not a valid integer", tagRDSReadReplicas, val),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		if parsed < 0 || parsed > maxRDSReadReplicas {
+			return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("invalid value for '%s': %d must be between 0 and %d", tagRDSReadReplicas, parsed, maxRDSReadReplicas),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		replicas = parsed
+	}
+
 	// Calculate monthly costs
-	instanceCostPerMonth := hourlyRate * carbon.HoursPerMonth
+	instanceCostPerMonth := hourlyRate * running.hours
 	storageCostPerMonth := storageRate * float64(storageSizeGB)
-	totalCostPerMonth := instanceCostPerMonth + storageCostPerMonth
+	totalCostPerMonth := instanceCostPerMonth + storageCostPerMonth + ioCostPerMonth
+	formula.add(instanceCostPerMonth, "$%s/hr × %s hrs", formulaNum(hourlyRate), formulaNum(running.hours))
+	formula.add(storageCostPerMonth, "$%s/GB-mo × %d GB", formulaNum(storageRate), storageSizeGB)
+	if ioRate > 0 {
+		formula.add(ioCostPerMonth, "$%s/I/O request × %d requests", formulaNum(ioRate), ioRequests)
+	}
+
+	// Read replicas run the same instance class; Aurora replicas share the cluster volume
+	var replicaInstanceCost, replicaStorageCost float64
+	if replicas > 0 {
+		replicaInstanceCost = instanceCostPerMonth * float64(replicas)
+		formula.add(replicaInstanceCost, "%d replicas × $%s/hr × %s hrs",
+			replicas, formulaNum(hourlyRate), formulaNum(running.hours))
+		if !isAurora {
+			replicaStorageCost = storageCostPerMonth * float64(replicas)
+			formula.add(replicaStorageCost, "%d replicas × $%s/GB-mo × %d GB",
+				replicas, formulaNum(storageRate), storageSizeGB)
+		}
+		totalCostPerMonth += replicaInstanceCost + replicaStorageCost
+	}
 
 	// Build billing detail message
+	commitment := "on-demand Single-AZ"
+	if pricingModel == rdsPricingReserved1yr {
+		commitment = "reserved 1yr No Upfront Single-AZ"
+	}
+
+	storageDetail := fmt.Sprintf("%dGB %s storage", storageSizeGB, storageType)
+	if label, ok := auroraStorageLabels[storageType]; ok {
+		storageDetail = fmt.Sprintf("%dGB %s storage", storageSizeGB, label)
+		switch {
+		case ioOptimized:
+			storageDetail += ", no per-I/O charges"
+		case ioProvided:
+			storageDetail += fmt.Sprintf(" + %d I/O requests", ioRequests)
+		default:
+			storageDetail += ", I/O charges excluded (set aurora_io_requests)"
+		}
+	}
+
 	var billingDetail string
 	defaultNotes := []string{}
 	if engineDefaulted {
 		defaultNotes = append(defaultNotes, "engine defaulted to MySQL")
+		assumed.add("engine", defaultRDSEngine, tagAssumptionReason(resource.Tags, "engine"))
 	}
 	if storageDefaulted {
 		defaultNotes = append(defaultNotes, "storage type defaulted")
+		assumed.add("storage_type", storageType, tagAssumptionReason(resource.Tags, "storage_type"))
 	}
 	if sizeDefaulted {
 		defaultNotes = append(defaultNotes, "size defaulted to 20GB")
+		assumed.add(sizeTags[0], strconv.Itoa(storageSizeGB), tagAssumptionReason(resource.Tags, sizeTags[0]))
+	}
+	if pricingModelDefaulted {
+		defaultNotes = append(defaultNotes, "pricing model defaulted to on-demand")
+		assumed.add("pricing_model", rdsPricingOnDemand, assumptionInvalid)
+	}
+	defaultNotes = append(defaultNotes, pricingNotes...)
+	if multiAZ {
+		defaultNotes = append(defaultNotes, "Multi-AZ priced at Single-AZ rate")
 	}
 
 	if len(defaultNotes) > 0 {
-		billingDetail = fmt.Sprintf("RDS %s %s, 730 hrs/month + %dGB %s storage (%s)",
-			instanceType, normalizedEngine, storageSizeGB, storageType, strings.Join(defaultNotes, ", "))
+		billingDetail = fmt.Sprintf("RDS %s %s, %s, %s + %s (%s)",
+			instanceType, normalizedEngine, commitment, running.detail(), storageDetail, strings.Join(defaultNotes, ", "))
 	} else {
-		billingDetail = fmt.Sprintf("RDS %s %s, 730 hrs/month + %dGB %s storage",
-			instanceType, normalizedEngine, storageSizeGB, storageType)
+		billingDetail = fmt.Sprintf("RDS %s %s, %s, %s + %s",
+			instanceType, normalizedEngine, commitment, running.detail(), storageDetail)
+	}
+	if isAurora {
+		billingDetail += fmt.Sprintf("; instance $%.2f + storage $%.2f", instanceCostPerMonth, storageCostPerMonth)
+		if ioRate > 0 {
+			billingDetail += fmt.Sprintf(" + I/O $%.2f", ioCostPerMonth)
+		}
+	}
+	if replicas > 0 {
+		replicaWord := "replicas"
+		if replicas == 1 {
+			replicaWord = "replica"
+		}
+		if isAurora {
+			billingDetail += fmt.Sprintf("; %d read %s $%.2f (instance hours only, cluster storage shared)",
+				replicas, replicaWord, replicaInstanceCost)
+		} else {
+			billingDetail += fmt.Sprintf("; %d read %s $%.2f (instance $%.2f + storage $%.2f)",
+				replicas, replicaWord, replicaInstanceCost+replicaStorageCost, replicaInstanceCost, replicaStorageCost)
+		}
+	}
+	if maxStorageGB > int64(storageSizeGB) {
+		// Each non-Aurora read replica autoscales its own copy of the storage
+		maxCostPerMonth := totalCostPerMonth +
+			storageRate*float64(maxStorageGB-int64(storageSizeGB))*float64(1+replicas)
+		billingDetail += fmt.Sprintf("; upper bound if storage autoscales to %dGB: $%.2f/month",
+			maxStorageGB, maxCostPerMonth)
 	}
 
 	resp := &pbc.GetProjectedCostResponse{
@@ -1029,17 +1975,33 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 		BillingDetail: billingDetail,
 	}
 
-	// Carbon estimation for RDS instance (compute + storage)
+	// Carbon estimation for RDS instance (compute + storage).
+	// Aurora cluster storage is SSD-backed, so gp3 serves as the storage proxy.
+	carbonStorageType := storageType
+	if isAurora {
+		carbonStorageType = "gp3"
+	}
 	rdsEstimator := carbon.NewRDSEstimator()
-	carbonGrams, carbonOK := rdsEstimator.EstimateCarbonGrams(carbon.RDSInstanceConfig{
+	carbonConfig := carbon.RDSInstanceConfig{
 		InstanceType:  instanceType,
 		Region:        resource.Region,
 		MultiAZ:       multiAZ,
-		StorageType:   storageType,
+		StorageType:   carbonStorageType,
 		StorageSizeGB: float64(storageSizeGB),
-		Utilization:   carbon.DefaultUtilization, // Use CCF default (50%)
-		Hours:         HoursPerMonthProd,
-	})
+		Utilization:   p.baselineUtilization(), // CCF default (50%) unless configured
+		Hours:         running.hours,
+	}
+	carbonGrams, carbonOK := rdsEstimator.EstimateCarbonGrams(carbonConfig)
+	if carbonOK && replicas > 0 {
+		// Replicas are single-AZ instances; Aurora replicas add no storage of their own
+		carbonConfig.MultiAZ = false
+		if isAurora {
+			carbonConfig.StorageSizeGB = 0
+		}
+		if replicaGrams, ok := rdsEstimator.EstimateCarbonGrams(carbonConfig); ok {
+			carbonGrams += replicaGrams * float64(replicas)
+		}
+	}
 
 	if carbonOK {
 		resp.ImpactMetrics = []*pbc.ImpactMetric{
@@ -1070,7 +2032,7 @@ func (p *AWSPublicPlugin) estimateRDS(traceID string, resource *pbc.ResourceDesc
 func detectService(resourceType string) string {
 	// Fast path for canonical forms
 	switch resourceType {
-	case "ec2", "ebs", "rds", "s3", "lambda", "dynamodb", "eks", "elb", "natgw", "cloudwatch", "elasticache":
+	case "ec2", "ebs", "rds", "s3", "lambda", "dynamodb", "eks", "elb", "natgw", "cloudwatch", "elasticache", "ecr", "secretsmanager", "kms", "waf", "athena", "glue", "ses", "stepfunctions":
 		return resourceType
 	case "alb", "nlb":
 		return "elb"
@@ -1119,6 +2081,33 @@ func detectService(resourceType string) string {
 	if strings.Contains(resourceTypeLower, "elasticache/") {
 		return "elasticache"
 	}
+	if strings.Contains(resourceTypeLower, "ecr/repository") {
+		return "ecr"
+	}
+	if strings.Contains(resourceTypeLower, "secretsmanager/secret") {
+		return "secretsmanager"
+	}
+	if strings.Contains(resourceTypeLower, "kms/key") {
+		return "kms"
+	}
+	if strings.Contains(resourceTypeLower, "wafv2/webacl:") {
+		return "waf"
+	}
+	if strings.Contains(resourceTypeLower, "athena/workgroup:") {
+		return "athena"
+	}
+	if strings.Contains(resourceTypeLower, "glue/job:") || strings.Contains(resourceTypeLower, "glue/crawler:") {
+		return "glue"
+	}
+	// Only configuration sets stand for sending; identities, templates and receipt
+	// rules have no charge of their own and would repeat the sending estimate
+	if strings.HasPrefix(resourceTypeLower, "aws:ses/configurationset:") ||
+		strings.HasPrefix(resourceTypeLower, "aws:sesv2/configurationset:") {
+		return "ses"
+	}
+	if strings.Contains(resourceTypeLower, "sfn/statemachine:") {
+		return "stepfunctions"
+	}
 	if strings.Contains(resourceTypeLower, "iam/") {
 		return "iam"
 	}
@@ -1128,12 +2117,12 @@ func detectService(resourceType string) string {
 
 // estimateEKS calculates projected monthly cost for EKS clusters.
 // EKS has a simple fixed hourly rate per cluster (standard or extended support).
-func (p *AWSPublicPlugin) estimateEKS(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateEKS(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// Determine support type from resource.Sku or tags
 	// resource.Sku = "cluster" (standard) or "cluster-extended" (extended support)
 	// OR use tags: tags["support_type"] == "extended" (case-insensitive)
 	extendedSupport := resource.Sku == "cluster-extended" ||
-		(resource.Tags != nil && strings.EqualFold(resource.Tags["support_type"], "extended"))
+		(resource.Tags != nil && strings.EqualFold(p.tagValue(resource, "support_type"), "extended"))
 
 	// Look up EKS pricing based on support type
 	hourlyRate, found := p.pricing.EKSClusterPricePerHour(extendedSupport)
@@ -1160,6 +2149,7 @@ func (p *AWSPublicPlugin) estimateEKS(traceID string, resource *pbc.ResourceDesc
 
 	// Calculate monthly cost (730 hours/month)
 	costPerMonth := hourlyRate * carbon.HoursPerMonth
+	formula.add(costPerMonth, "$%s/hr × 730 hrs", formulaNum(hourlyRate))
 
 	// Determine support type description
 	supportType := "standard support"
@@ -1167,11 +2157,18 @@ func (p *AWSPublicPlugin) estimateEKS(traceID string, resource *pbc.ResourceDesc
 		supportType = "extended support"
 	}
 
+	scope := "control plane only, excludes worker nodes and Fargate pods"
+	if fargateCost, note, requested := p.estimateEKSFargatePods(traceID,
+		p.tagSubset(resource, tagEKSFargateVCPU, tagEKSFargateMemoryGB, tagEKSNumPods), formula); requested {
+		costPerMonth += fargateCost
+		scope = note
+	}
+
 	resp := &pbc.GetProjectedCostResponse{
 		CostPerMonth:  costPerMonth,
 		UnitPrice:     hourlyRate,
 		Currency:      "USD",
-		BillingDetail: fmt.Sprintf("EKS cluster (%s), 730 hrs/month (control plane only, excludes worker nodes)", supportType),
+		BillingDetail: fmt.Sprintf("EKS cluster (%s), 730 hrs/month (%s)", supportType, scope),
 	}
 
 	// Carbon estimation for EKS (control plane is shared, returns 0)
@@ -1202,9 +2199,46 @@ func (p *AWSPublicPlugin) estimateEKS(traceID string, resource *pbc.ResourceDesc
 	return resp, nil
 }
 
+// estimateEKSFargatePods prices pods scheduled on Fargate when the fargate_vcpu
+// and fargate_memory_gb tags are set. num_pods defaults to 1. Returns the monthly
+// pod cost, the billing scope note, and whether Fargate pods were requested at all.
+func (p *AWSPublicPlugin) estimateEKSFargatePods(traceID string, tags map[string]string, formula *billingFormula) (float64, string, bool) {
+	vcpuStr, hasVCPU := tags[tagEKSFargateVCPU]
+	memStr, hasMem := tags[tagEKSFargateMemoryGB]
+	if !hasVCPU && !hasMem {
+		return 0, "", false
+	}
+
+	vcpu := p.validateNonNegativeFloat64(traceID, tagEKSFargateVCPU, vcpuStr)
+	memGB := p.validateNonNegativeFloat64(traceID, tagEKSFargateMemoryGB, memStr)
+	if vcpu == 0 || memGB == 0 {
+		return 0, "control plane only, Fargate pods excluded: fargate_vcpu and fargate_memory_gb must both be positive", true
+	}
+
+	numPods := int64(1)
+	if v, ok := tags[tagEKSNumPods]; ok {
+		numPods = p.validateNonNegativeInt64(traceID, tagEKSNumPods, v)
+	}
+
+	vcpuRate, gbRate, found := p.pricing.EKSFargatePricePerHour()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Warn().
+			Str("aws_region", p.region).
+			Msg("EKS Fargate pricing not found, excluding pod cost")
+		return 0, "control plane only, Fargate pods excluded: pricing unavailable", true
+	}
+
+	podHourly := vcpu*vcpuRate + memGB*gbRate
+	cost := float64(numPods) * podHourly * carbon.HoursPerMonth
+	formula.add(cost, "%d pods × ($%s/vCPU-hr × %s vCPU + $%s/GB-hr × %s GB) × 730 hrs",
+		numPods, formulaNum(vcpuRate), formulaNum(vcpu), formulaNum(gbRate), formulaNum(memGB))
+	return cost, fmt.Sprintf("control plane + Fargate pods included: %d pods × %g vCPU/%g GB at $%.5f/pod-hr, excludes EC2 worker nodes",
+		numPods, vcpu, memGB, podHourly), true
+}
+
 // estimateLambda calculates projected monthly cost for Lambda functions.
 // Uses request count and GB-seconds from resource tags.
-func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// 1. Determine Memory (SKU -> MB)
 	memoryMB := 128
 	memoryDefaulted := false
@@ -1227,23 +2261,22 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 	archDefaulted := true
 
 	if resource.Tags != nil {
-		if reqStr, ok := resource.Tags["requests_per_month"]; ok {
-			if reqs, err := strconv.ParseInt(reqStr, 10, 64); err == nil && reqs >= 0 {
-				requestsPerMonth = reqs
-				requestsDefaulted = false
-			}
+		if reqStr, ok := p.tag(resource, "requests_per_month"); ok {
+			// Invalid and negative values fall back to 0 and are reported as defaulted
+			requestsPerMonth = p.validateNonNegativeInt64(traceID, "requests_per_month", reqStr)
+			requestsDefaulted = requestsPerMonth == 0 && reqStr != "0"
 		}
-		if durStr, ok := resource.Tags["avg_duration_ms"]; ok {
+		if durStr, ok := p.tag(resource, "avg_duration_ms"); ok {
 			if dur, err := strconv.Atoi(durStr); err == nil && dur > 0 {
 				avgDurationMs = dur
 				durationDefaulted = false
 			}
 		}
 		// FR-011: Read architecture from tags
-		if archStr, ok := resource.Tags["arch"]; ok && archStr != "" {
+		if archStr, ok := p.tag(resource, "arch"); ok && archStr != "" {
 			architecture = archStr
 			archDefaulted = false
-		} else if archStr, ok := resource.Tags["architecture"]; ok && archStr != "" {
+		} else if archStr, ok := p.tag(resource, "architecture"); ok && archStr != "" {
 			architecture = archStr
 			archDefaulted = false
 		}
@@ -1251,7 +2284,7 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 
 	// 3. Lookup Pricing (with architecture)
 	reqPrice, reqFound := p.pricing.LambdaPricePerRequest()
-	gbSecPrice, gbSecFound := p.pricing.LambdaPricePerGBSecond(architecture)
+	gbSecPrice, archFallbackNote, gbSecFound := p.lambdaGBSecondRate(architecture)
 
 	if !reqFound || !gbSecFound {
 		p.traceLogger(traceID, "GetProjectedCost").Debug().
@@ -1278,20 +2311,32 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 	requestCost := float64(requestsPerMonth) * reqPrice
 	computeCost := totalGBSec * gbSecPrice
 	totalCost := requestCost + computeCost
+	formula.add(requestCost, "$%s/request × %d requests", formulaNum(reqPrice), requestsPerMonth)
+	formula.add(computeCost, "$%s/GB-s × (%d MB / 1024 × %d ms / 1000 × %d requests) GB-s",
+		formulaNum(gbSecPrice), memoryMB, avgDurationMs, requestsPerMonth)
 
 	// 5. Build Billing Detail
 	var notes []string
 	if memoryDefaulted {
 		notes = append(notes, "memory defaulted")
+		memoryReason := assumptionNotSet
+		if resource.Sku != "" {
+			memoryReason = assumptionInvalid
+		}
+		assumed.add("sku", strconv.Itoa(memoryMB), memoryReason)
 	}
 	if requestsDefaulted {
 		notes = append(notes, "requests defaulted")
+		assumed.add("requests_per_month", strconv.FormatInt(requestsPerMonth, 10),
+			tagAssumptionReason(resource.Tags, "requests_per_month"))
 	}
 	if durationDefaulted {
 		notes = append(notes, "duration defaulted")
+		assumed.add("avg_duration_ms", strconv.Itoa(avgDurationMs), tagAssumptionReason(resource.Tags, "avg_duration_ms"))
 	}
 	if archDefaulted {
 		notes = append(notes, "arch defaulted to x86_64")
+		assumed.add("arch", architecture, assumptionNotSet)
 	}
 
 	// Normalize architecture display name for consistent billing details.
@@ -1299,7 +2344,7 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 	// architecture naming (x86_64, arm64). This ensures billing details are
 	// consistent regardless of whether the user specifies "arm" or "arm64".
 	archDisplay := "x86_64"
-	if strings.ToLower(architecture) == "arm64" || strings.ToLower(architecture) == "arm" {
+	if isLambdaARM(architecture) {
 		archDisplay = "arm64"
 	}
 
@@ -1309,6 +2354,14 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 		detail += fmt.Sprintf(" (%s)", strings.Join(notes, ", "))
 	}
 	detail += fmt.Sprintf(", %.0f GB-seconds", totalGBSec)
+	if archFallbackNote != "" {
+		detail += ", " + archFallbackNote
+
+		p.traceLogger(traceID, "GetProjectedCost").Warn().
+			Str("aws_region", p.region).
+			Float64("arm_fallback_discount", p.lambdaARMFallbackDiscount).
+			Msg("Lambda arm64 pricing unavailable, estimated from x86_64 rate")
+	}
 
 	p.logger.Debug().
 		Int("memory_mb", memoryMB).
@@ -1362,7 +2415,7 @@ func (p *AWSPublicPlugin) estimateLambda(traceID string, resource *pbc.ResourceD
 
 // estimateNATGateway calculates projected monthly cost for VPC NAT Gateways.
 // Combines fixed hourly cost and variable data processing cost.
-func (p *AWSPublicPlugin) estimateNATGateway(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateNATGateway(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// 1. Lookup Pricing
 	pricing, found := p.pricing.NATGatewayPrice()
 	if !found {
@@ -1382,7 +2435,7 @@ func (p *AWSPublicPlugin) estimateNATGateway(traceID string, resource *pbc.Resou
 	dataProcessedGB := 0.0
 	tagPresent := false
 	if resource.Tags != nil {
-		if val, ok := resource.Tags["data_processed_gb"]; ok {
+		if val, ok := p.tag(resource, "data_processed_gb"); ok {
 			tagPresent = true
 			if val == "" {
 				return nil, p.newErrorWithID(traceID, codes.InvalidArgument, "tag 'data_processed_gb' is present but empty", pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
@@ -1395,6 +2448,7 @@ func (p *AWSPublicPlugin) estimateNATGateway(traceID string, resource *pbc.Resou
 				return nil, p.newErrorWithID(traceID, codes.InvalidArgument, fmt.Sprintf("invalid value for 'data_processed_gb': %.2f cannot be negative", parsed), pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 			}
 			dataProcessedGB = parsed
+			p.warnImplausibleUsage(traceID, "data_processed_gb", parsed)
 		}
 	}
 
@@ -1402,6 +2456,8 @@ func (p *AWSPublicPlugin) estimateNATGateway(traceID string, resource *pbc.Resou
 	hourlyCost := pricing.HourlyRate * carbon.HoursPerMonth
 	processingCost := dataProcessedGB * pricing.DataProcessingRate
 	totalCost := hourlyCost + processingCost
+	formula.add(hourlyCost, "$%s/hr × 730 hrs", formulaNum(pricing.HourlyRate))
+	formula.add(processingCost, "$%s/GB × %s GB processed", formulaNum(pricing.DataProcessingRate), formulaNum(dataProcessedGB))
 
 	// 4. Build Billing Detail
 	detail := fmt.Sprintf("NAT Gateway, %d hrs/month ($%.3f/hr)", int(carbon.HoursPerMonth), pricing.HourlyRate)
@@ -1490,8 +2546,10 @@ func calculateTieredCost(quantity float64, tiers []pricing.TierRate) float64 {
 // Tags:
 //   - log_ingestion_gb: GB of logs ingested per month
 //   - log_storage_gb: GB of logs stored
+//   - retention_days: Log retention; with log_ingestion_gb, derives the steady-state
+//     stored GB when log_storage_gb is not set
 //   - custom_metrics: Number of custom metrics
-func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	sku := strings.ToLower(resource.Sku)
 	if sku == "" {
 		sku = "logs" // Default to logs estimation
@@ -1500,11 +2558,13 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 	// Extract tag values with safe defaults
 	logIngestionGB := 0.0
 	logStorageGB := 0.0
+	logStorageSet := false
+	retentionDays := 0.0
 	customMetrics := 0.0
 
 	if resource.Tags != nil {
 		// Parse log_ingestion_gb
-		if val, ok := resource.Tags["log_ingestion_gb"]; ok && val != "" {
+		if val, ok := p.tag(resource, "log_ingestion_gb"); ok && val != "" {
 			parsed, err := strconv.ParseFloat(val, 64)
 			if err != nil {
 				return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
@@ -1517,10 +2577,11 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 			}
 			logIngestionGB = parsed
+			p.warnImplausibleUsage(traceID, "log_ingestion_gb", parsed)
 		}
 
 		// Parse log_storage_gb
-		if val, ok := resource.Tags["log_storage_gb"]; ok && val != "" {
+		if val, ok := p.tag(resource, "log_storage_gb"); ok && val != "" {
 			parsed, err := strconv.ParseFloat(val, 64)
 			if err != nil {
 				return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
@@ -1533,10 +2594,28 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 			}
 			logStorageGB = parsed
+			logStorageSet = true
+			p.warnImplausibleUsage(traceID, "log_storage_gb", parsed)
+		}
+
+		// Parse retention_days
+		if val, ok := p.tag(resource, tagCloudWatchRetentionDays); ok && val != "" {
+			parsed, err := strconv.ParseFloat(val, 64)
+			if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+				return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+					fmt.Sprintf("invalid value for '%s': %q is not a valid number", tagCloudWatchRetentionDays, val),
+					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+			}
+			if parsed <= 0 {
+				return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+					fmt.Sprintf("invalid value for '%s': %g must be positive", tagCloudWatchRetentionDays, parsed),
+					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+			}
+			retentionDays = parsed
 		}
 
 		// Parse custom_metrics
-		if val, ok := resource.Tags["custom_metrics"]; ok && val != "" {
+		if val, ok := p.tag(resource, "custom_metrics"); ok && val != "" {
 			parsed, err := strconv.ParseFloat(val, 64)
 			if err != nil {
 				return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
@@ -1549,9 +2628,20 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 			}
 			customMetrics = parsed
+			p.warnImplausibleUsage(traceID, "custom_metrics", parsed)
 		}
 	}
 
+	// Explicit stored GB wins; otherwise retention derives it from ingestion
+	var storageNote string
+	switch {
+	case retentionDays > 0 && logStorageSet:
+		storageNote = fmt.Sprintf(" (log_storage_gb set, %s ignored)", tagCloudWatchRetentionDays)
+	case retentionDays > 0:
+		logStorageGB = logIngestionGB * retentionDays / daysPerMonth
+		storageNote = fmt.Sprintf(" (steady state for %s-day retention)", formulaNum(retentionDays))
+	}
+
 	// Calculate costs based on SKU
 	var totalCost float64
 	var details []string
@@ -1566,6 +2656,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 			tiers, found := p.pricing.CloudWatchLogsIngestionTiers()
 			if found {
 				ingestionCost = calculateTieredCost(logIngestionGB, tiers)
+				formula.add(ingestionCost, "%s GB ingested at tiered rates (%s)", formulaNum(logIngestionGB), tieredFormula(logIngestionGB, tiers))
 				details = append(details, fmt.Sprintf("%.2f GB logs ingested ($%.2f)", logIngestionGB, ingestionCost))
 			} else {
 				details = append(details, fmt.Sprintf(PricingUnavailableTemplate, "CloudWatch Logs ingestion", p.region))
@@ -1577,7 +2668,8 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 			storageRate, found := p.pricing.CloudWatchLogsStoragePrice()
 			if found {
 				storageCost = logStorageGB * storageRate
-				details = append(details, fmt.Sprintf("%.2f GB logs stored @ $%.4f/GB-mo ($%.2f)", logStorageGB, storageRate, storageCost))
+				formula.add(storageCost, "$%s/GB-mo × %s GB stored", formulaNum(storageRate), formulaNum(logStorageGB))
+				details = append(details, fmt.Sprintf("%.2f GB logs stored @ $%.4f/GB-mo ($%.2f)%s", logStorageGB, storageRate, storageCost, storageNote))
 			} else {
 				details = append(details, fmt.Sprintf(PricingUnavailableTemplate, "CloudWatch Logs storage", p.region))
 			}
@@ -1594,6 +2686,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 			tiers, found := p.pricing.CloudWatchMetricsTiers()
 			if found {
 				metricsCost = calculateTieredCost(customMetrics, tiers)
+				formula.add(metricsCost, "%s metrics at tiered rates (%s)", formulaNum(customMetrics), tieredFormula(customMetrics, tiers))
 				details = append(details, fmt.Sprintf("%.0f custom metrics ($%.2f)", customMetrics, metricsCost))
 			} else {
 				details = append(details, fmt.Sprintf(PricingUnavailableTemplate, "CloudWatch Metrics", p.region))
@@ -1616,6 +2709,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 		Str("sku", sku).
 		Float64("log_ingestion_gb", logIngestionGB).
 		Float64("log_storage_gb", logStorageGB).
+		Float64("retention_days", retentionDays).
 		Float64("custom_metrics", customMetrics).
 		Float64("total_cost", totalCost).
 		Msg("CloudWatch cost estimated")
@@ -1640,7 +2734,8 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 //   - Cache engine (Redis, Memcached, Valkey)
 //   - Number of nodes (default: 1)
 //
-// Cost formula: hourly_rate × num_nodes × 730 hours/month
+// Cost formula: hourly_rate × num_nodes × 730 hours/month (or the running hours of the
+// "schedule" / "running_hours_per_month" tags)
 //
 // Required fields:
 //   - resource.Sku: The cache node type (e.g., "cache.m5.large")
@@ -1648,7 +2743,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch(traceID string, resource *pbc.Resou
 // Optional tags:
 //   - "engine": Cache engine - "redis" (default), "memcached", or "valkey" (open-source Redis fork)
 //   - "num_nodes" or "num_cache_nodes": Number of cache nodes (default: 1)
-func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
 	// Extract node type from SKU
 	nodeType := resource.Sku
 	if nodeType == "" {
@@ -1664,7 +2759,7 @@ func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.Reso
 	// Extract engine (default: redis)
 	engine := "redis"
 	if resource.Tags != nil {
-		if val, ok := resource.Tags["engine"]; ok && val != "" {
+		if val, ok := p.tag(resource, "engine"); ok && val != "" {
 			engine = strings.ToLower(val)
 		}
 	}
@@ -1674,9 +2769,9 @@ func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.Reso
 	if resource.Tags != nil {
 		// Try num_nodes first, then num_cache_nodes
 		nodeCountStr := ""
-		if val, ok := resource.Tags["num_nodes"]; ok && val != "" {
+		if val, ok := p.tag(resource, "num_nodes"); ok && val != "" {
 			nodeCountStr = val
-		} else if val, ok := resource.Tags["num_cache_nodes"]; ok && val != "" {
+		} else if val, ok := p.tag(resource, "num_cache_nodes"); ok && val != "" {
 			nodeCountStr = val
 		}
 		if nodeCountStr != "" {
@@ -1707,15 +2802,21 @@ func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.Reso
 		}, nil
 	}
 
+	running, err := p.resourceRunningHours(traceID, resource)
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate monthly cost: hourly_rate × num_nodes × hours_per_month
-	monthlyCost := hourlyRate * float64(numNodes) * carbon.HoursPerMonth
+	monthlyCost := hourlyRate * float64(numNodes) * running.hours
+	formula.add(monthlyCost, "$%s/hr × %d nodes × %s hrs", formulaNum(hourlyRate), numNodes, formulaNum(running.hours))
 
 	// Build billing detail
 	var billingDetail string
 	if numNodes == 1 {
-		billingDetail = fmt.Sprintf("ElastiCache %s (%s), 1 node, 730 hrs/month", nodeType, engine)
+		billingDetail = fmt.Sprintf("ElastiCache %s (%s), 1 node, %s", nodeType, engine, running.detail())
 	} else {
-		billingDetail = fmt.Sprintf("ElastiCache %s (%s), %d nodes, 730 hrs/month", nodeType, engine, numNodes)
+		billingDetail = fmt.Sprintf("ElastiCache %s (%s), %d nodes, %s", nodeType, engine, numNodes, running.detail())
 	}
 
 	p.logger.Debug().
@@ -1740,8 +2841,8 @@ func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.Reso
 		Engine:      engine,
 		Nodes:       numNodes,
 		Region:      resource.Region,
-		Utilization: carbon.DefaultUtilization, // Use CCF default (50%)
-		Hours:       carbon.HoursPerMonth,
+		Utilization: p.baselineUtilization(), // CCF default (50%) unless configured
+		Hours:       running.hours,
 	})
 
 	if carbonOK {
@@ -1767,6 +2868,602 @@ func (p *AWSPublicPlugin) estimateElastiCache(traceID string, resource *pbc.Reso
 	return resp, nil
 }
 
+// estimateECR calculates projected monthly cost for ECR image storage.
+// Storage comes from the storage_gb tag (defaulting to 0). Data transfer out to the
+// internet is added only when the data_transfer_out_gb tag is present.
+func (p *AWSPublicPlugin) estimateECR(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	storageRate, found := p.pricing.ECRStoragePricePerGBMonth()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("ECR pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "ECR", p.region),
+		}, nil
+	}
+
+	storageGB := 0.0
+	storageVal, storageSet := p.tag(resource, "storage_gb")
+	if storageSet {
+		storageGB = p.validateNonNegativeFloat64(traceID, "storage_gb", storageVal)
+	}
+
+	costPerMonth := storageGB * storageRate
+	formula.add(costPerMonth, "$%s/GB-mo × %s GB", formulaNum(storageRate), formulaNum(storageGB))
+	var detail string
+	if storageSet {
+		detail = fmt.Sprintf("ECR storage, %.2f GB, $%.4f/GB-month", storageGB, storageRate)
+	} else {
+		detail = fmt.Sprintf("ECR storage, 0 GB (defaulted; set 'storage_gb' to estimate), $%.4f/GB-month", storageRate)
+		assumed.add("storage_gb", "0", assumptionNotSet)
+	}
+
+	if val, ok := p.tag(resource, "data_transfer_out_gb"); ok {
+		transferGB := p.validateNonNegativeFloat64(traceID, "data_transfer_out_gb", val)
+		if transferRate, rateFound := p.pricing.ECRDataTransferOutPricePerGB(); rateFound {
+			costPerMonth += transferGB * transferRate
+			formula.add(transferGB*transferRate, "$%s/GB × %s GB transferred out", formulaNum(transferRate), formulaNum(transferGB))
+			detail += fmt.Sprintf(" + %.2f GB data transfer out ($%.4f/GB)", transferGB, transferRate)
+		} else {
+			detail += " (data transfer out excluded: pricing unavailable)"
+		}
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("storage_rate", storageRate).
+		Float64("storage_gb", storageGB).
+		Float64("total_cost", costPerMonth).
+		Msg("ECR cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     storageRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:ecr:repository", resp)
+
+	return resp, nil
+}
+
+// kmsFreeRequestsPerMonth is the monthly KMS request allowance that is not billed. It is
+// shared by every key in the account, so it is only subtracted when free_tier is set.
+const kmsFreeRequestsPerMonth = 20000
+
+// estimateSecretsManager calculates projected monthly cost for a Secrets Manager secret:
+// a flat per-secret monthly rate plus API calls from the api_calls_per_month tag.
+func (p *AWSPublicPlugin) estimateSecretsManager(traceID string, resource *pbc.ResourceDescriptor, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	secretRate, found := p.pricing.SecretsManagerPricePerSecret()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("Secrets Manager pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Secrets Manager", p.region),
+		}, nil
+	}
+
+	// Secrets Manager has no free API allowance beyond the trial, so calls default to 0
+	apiCalls := int64(0)
+	if val, ok := p.tag(resource, "api_calls_per_month"); ok {
+		apiCalls = p.validateNonNegativeInt64(traceID, "api_calls_per_month", val)
+	}
+
+	costPerMonth := secretRate
+	formula.add(secretRate, "$%s/secret-mo × 1 secret", formulaNum(secretRate))
+	detail := fmt.Sprintf("Secrets Manager, 1 secret ($%.4f/secret-month)", secretRate)
+	if apiCalls > 0 {
+		if callRate, callFound := p.pricing.SecretsManagerPricePerAPICall(); callFound {
+			costPerMonth += float64(apiCalls) * callRate
+			formula.add(float64(apiCalls)*callRate, "$%s/call × %d calls", formulaNum(callRate), apiCalls)
+			detail += fmt.Sprintf(" + %d API calls ($%.4f per 10k)", apiCalls, callRate*10000)
+		} else {
+			detail += fmt.Sprintf(" (%d API calls excluded: pricing unavailable)", apiCalls)
+		}
+	} else {
+		detail += " + 0 API calls"
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     secretRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:secretsmanager:secret", resp)
+
+	return resp, nil
+}
+
+// estimateKMS calculates projected monthly cost for a KMS customer managed key:
+// a flat per-key monthly rate plus symmetric requests beyond the free tier. The
+// api_calls_per_month tag defaults to the free allowance.
+func (p *AWSPublicPlugin) estimateKMS(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	keyRate, found := p.pricing.KMSPricePerKey()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("KMS pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "KMS", p.region),
+		}, nil
+	}
+
+	var requests int64
+	requestsNote := ""
+	if val, ok := p.tag(resource, "api_calls_per_month"); ok {
+		requests = p.validateNonNegativeInt64(traceID, "api_calls_per_month", val)
+	} else {
+		requestsNote = " (defaulted; set 'api_calls_per_month' to estimate)"
+		assumed.add("api_calls_per_month", "0", assumptionNotSet)
+	}
+
+	// The free allowance is account-wide, so it is only subtracted on request
+	var free int64
+	if parseBoolVal(p.tagValue(resource, tagFreeTier)) {
+		free = kmsFreeRequestsPerMonth
+	}
+
+	costPerMonth := keyRate
+	formula.add(keyRate, "$%s/key-mo × 1 key", formulaNum(keyRate))
+	detail := fmt.Sprintf("KMS key, 1 key ($%.4f/key-month)", keyRate)
+	billable := requests - free
+	switch {
+	case requests == 0:
+		detail += " + 0 requests" + requestsNote
+	case billable <= 0:
+		detail += fmt.Sprintf(" + %d requests, within %d free", requests, free)
+	default:
+		requestRate, rateFound := p.pricing.KMSPricePerRequest()
+		switch {
+		case !rateFound:
+			detail += fmt.Sprintf(" (%d requests excluded: pricing unavailable)", requests)
+		case free > 0:
+			costPerMonth += float64(billable) * requestRate
+			formula.add(float64(billable)*requestRate, "$%s/request × (%d − %d free) requests",
+				formulaNum(requestRate), requests, free)
+			detail += fmt.Sprintf(" + %d requests (%d free, %d billed at $%.4f per 10k)",
+				requests, free, billable, requestRate*10000)
+		default:
+			costPerMonth += float64(requests) * requestRate
+			formula.add(float64(requests)*requestRate, "$%s/request × %d requests", formulaNum(requestRate), requests)
+			detail += fmt.Sprintf(" + %d requests ($%.4f per 10k)", requests, requestRate*10000)
+		}
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     keyRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:kms:key", resp)
+
+	return resp, nil
+}
+
+// wafDefaultRules is the rule count assumed when the rules tag is not set: the smallest
+// web ACL that inspects anything has one rule (or one managed rule group).
+const wafDefaultRules = 1
+
+// estimateWAF calculates projected monthly cost for an AWS WAF web ACL: a flat per-ACL
+// monthly rate, a monthly rate per rule from the rules tag, and inspected requests from
+// the requests_per_month tag. Shield Advanced is an account-level subscription and is
+// not included.
+func (p *AWSPublicPlugin) estimateWAF(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	aclRate, found := p.pricing.WAFPricePerWebACL()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("WAF pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "WAF", p.region),
+		}, nil
+	}
+
+	rules := int64(wafDefaultRules)
+	rulesNote := ""
+	if val, ok := p.tag(resource, "rules"); ok {
+		rules = p.validateNonNegativeInt64(traceID, "rules", val)
+	} else {
+		rulesNote = " (defaulted)"
+		assumed.add("rules", strconv.Itoa(wafDefaultRules), assumptionNotSet)
+	}
+
+	requests := int64(0)
+	requestsNote := ""
+	if val, ok := p.tag(resource, "requests_per_month"); ok {
+		requests = p.validateNonNegativeInt64(traceID, "requests_per_month", val)
+	} else {
+		requestsNote = " (defaulted; set 'requests_per_month' to estimate)"
+		assumed.add("requests_per_month", "0", assumptionNotSet)
+	}
+
+	costPerMonth := aclRate
+	formula.add(aclRate, "$%s/web-ACL-mo × 1 web ACL", formulaNum(aclRate))
+	detail := fmt.Sprintf("WAF web ACL, 1 web ACL ($%.4f/web-ACL-month)", aclRate)
+
+	if rules > 0 {
+		if ruleRate, rateFound := p.pricing.WAFPricePerRule(); rateFound {
+			costPerMonth += float64(rules) * ruleRate
+			formula.add(float64(rules)*ruleRate, "$%s/rule-mo × %d rules", formulaNum(ruleRate), rules)
+			detail += fmt.Sprintf(" + %d rules%s ($%.4f/rule-month)", rules, rulesNote, ruleRate)
+		} else {
+			detail += fmt.Sprintf(" (%d rules excluded: pricing unavailable)", rules)
+		}
+	} else {
+		detail += " + 0 rules"
+	}
+
+	if requests > 0 {
+		if requestRate, rateFound := p.pricing.WAFPricePerRequest(); rateFound {
+			costPerMonth += float64(requests) * requestRate
+			formula.add(float64(requests)*requestRate, "$%s/request × %d requests", formulaNum(requestRate), requests)
+			detail += fmt.Sprintf(" + %d requests ($%.4f per million)", requests, requestRate*1e6)
+		} else {
+			detail += fmt.Sprintf(" (%d requests excluded: pricing unavailable)", requests)
+		}
+	} else {
+		detail += " + 0 requests" + requestsNote
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     aclRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:wafv2:webacl", resp)
+
+	return resp, nil
+}
+
+// estimateAthena calculates projected monthly cost for an Amazon Athena workgroup from
+// the data its SQL queries scan, given by the data_scanned_tb tag. Provisioned capacity,
+// Spark notebooks and the per-query 10 MB minimum are not included.
+func (p *AWSPublicPlugin) estimateAthena(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	tbRate, found := p.pricing.AthenaPricePerTBScanned()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("Athena pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Athena", p.region),
+		}, nil
+	}
+
+	scannedTB := 0.0
+	scannedNote := ""
+	if val, ok := p.tag(resource, tagAthenaDataScannedTB); ok {
+		scannedTB = p.validateNonNegativeFloat64(traceID, tagAthenaDataScannedTB, val)
+	} else {
+		scannedNote = " (defaulted; set 'data_scanned_tb' to estimate)"
+		assumed.add(tagAthenaDataScannedTB, "0", assumptionNotSet)
+	}
+
+	costPerMonth := scannedTB * tbRate
+	formula.add(costPerMonth, "$%s/TB × %s TB scanned", formulaNum(tbRate), formulaNum(scannedTB))
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: costPerMonth,
+		UnitPrice:    tbRate,
+		Currency:     "USD",
+		BillingDetail: fmt.Sprintf("Athena SQL queries, %.2f TB scanned%s ($%.2f/TB)",
+			scannedTB, scannedNote, tbRate),
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:athena:workgroup", resp)
+
+	return resp, nil
+}
+
+// glueJobTypes maps Glue SKUs (the job execution class) to the job type priced by
+// GluePricePerDPUHour. Crawler resources are priced as "crawler" whatever their SKU.
+var glueJobTypes = map[string]string{
+	"standard": "etl",
+	"etl":      "etl",
+	"flex":     "flex",
+	"crawler":  "crawler",
+}
+
+// estimateGlue calculates projected monthly cost for an AWS Glue job or crawler from the
+// DPU-hours it consumes, given by the dpu_hours tag. The SKU selects the execution class
+// (standard or flex, standard when unset); crawlers use the crawler rate. Data Catalog
+// storage and requests, DataBrew and interactive sessions are not included.
+func (p *AWSPublicPlugin) estimateGlue(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	jobType := "etl"
+	jobNote := ""
+	sku := strings.ToLower(strings.TrimSpace(resource.Sku))
+	if strings.Contains(strings.ToLower(resource.ResourceType), "glue/crawler") {
+		jobType = "crawler"
+	} else if t, ok := glueJobTypes[sku]; ok {
+		jobType = t
+	} else if sku == "" {
+		jobNote = " (execution class defaulted to standard)"
+		assumed.add("sku", "standard", assumptionNotSet)
+	} else {
+		jobNote = fmt.Sprintf(" (unknown execution class %q, priced as standard)", resource.Sku)
+		assumed.add("sku", "standard", assumptionInvalid)
+	}
+
+	dpuRate, found := p.pricing.GluePricePerDPUHour(jobType)
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Str("job_type", jobType).
+			Msg("Glue pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Glue", p.region),
+		}, nil
+	}
+
+	dpuHours := 0.0
+	dpuNote := ""
+	if val, ok := p.tag(resource, tagGlueDPUHours); ok {
+		dpuHours = p.validateNonNegativeFloat64(traceID, tagGlueDPUHours, val)
+	} else {
+		dpuNote = " (defaulted; set 'dpu_hours' to estimate)"
+		assumed.add(tagGlueDPUHours, "0", assumptionNotSet)
+	}
+
+	costPerMonth := dpuHours * dpuRate
+	formula.add(costPerMonth, "$%s/DPU-hr × %s DPU-hours", formulaNum(dpuRate), formulaNum(dpuHours))
+
+	label := "job"
+	switch jobType {
+	case "flex":
+		label = "Flex job"
+	case "crawler":
+		label = "crawler"
+	}
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: costPerMonth,
+		UnitPrice:    dpuRate,
+		Currency:     "USD",
+		BillingDetail: fmt.Sprintf("Glue %s%s, %.2f DPU-hours%s ($%.4f/DPU-hour)",
+			label, jobNote, dpuHours, dpuNote, dpuRate),
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:glue:job", resp)
+
+	return resp, nil
+}
+
+// estimateSES calculates projected monthly cost for Amazon SES outbound email: emails
+// sent from the emails_per_month tag and attachment volume from the data_gb tag. With
+// free_tier set, the monthly free email allowance is subtracted first. Inbound mail,
+// dedicated IPs and Virtual Deliverability Manager are not included.
+func (p *AWSPublicPlugin) estimateSES(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	emailRate, found := p.pricing.SESPricePerEmail()
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Msg("SES pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "SES", p.region),
+		}, nil
+	}
+
+	emails := int64(0)
+	emailsNote := ""
+	if val, ok := p.tag(resource, tagSESEmailsPerMonth); ok {
+		emails = p.validateNonNegativeInt64(traceID, tagSESEmailsPerMonth, val)
+	} else {
+		emailsNote = " (defaulted; set 'emails_per_month' to estimate)"
+		assumed.add(tagSESEmailsPerMonth, "0", assumptionNotSet)
+	}
+
+	dataGB := 0.0
+	dataNote := ""
+	if val, ok := p.tag(resource, tagSESDataGB); ok {
+		dataGB = p.validateNonNegativeFloat64(traceID, tagSESDataGB, val)
+	} else {
+		dataNote = " (defaulted; set 'data_gb' to estimate)"
+		assumed.add(tagSESDataGB, "0", assumptionNotSet)
+	}
+
+	costPerMonth := 0.0
+	detail := fmt.Sprintf("SES email, %d emails%s", emails, emailsNote)
+	if parseBoolVal(p.tagValue(resource, tagFreeTier)) {
+		billable := max(emails-sesFreeTierEmailsPerMonth, 0)
+		costPerMonth += float64(billable) * emailRate
+		formula.add(float64(billable)*emailRate, "$%s/email × (%d − %d free) emails",
+			formulaNum(emailRate), emails, sesFreeTierEmailsPerMonth)
+		detail += fmt.Sprintf(" (%d free, %d billed at $%.2f per 1,000)",
+			sesFreeTierEmailsPerMonth, billable, emailRate*1000)
+	} else {
+		costPerMonth += float64(emails) * emailRate
+		formula.add(float64(emails)*emailRate, "$%s/email × %d emails", formulaNum(emailRate), emails)
+		detail += fmt.Sprintf(" ($%.2f per 1,000)", emailRate*1000)
+	}
+
+	if dataGB > 0 {
+		if gbRate, rateFound := p.pricing.SESPricePerAttachmentGB(); rateFound {
+			costPerMonth += dataGB * gbRate
+			formula.add(dataGB*gbRate, "$%s/GB × %s GB attachments", formulaNum(gbRate), formulaNum(dataGB))
+			detail += fmt.Sprintf(" + %.2f GB attachments ($%.2f/GB)", dataGB, gbRate)
+		} else {
+			detail += fmt.Sprintf(" (%.2f GB attachments excluded: pricing unavailable)", dataGB)
+		}
+	} else {
+		detail += " + 0 GB attachments" + dataNote
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  costPerMonth,
+		UnitPrice:     emailRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:ses:email", resp)
+
+	return resp, nil
+}
+
+// estimateStepFunctions calculates projected monthly cost for an AWS Step Functions state
+// machine. Standard workflows are priced per state transition from the
+// state_transitions_per_month tag. Express workflows are priced per request plus
+// GB-seconds of duration, like Lambda. The workflow type comes from the type tag, then a
+// "standard" or "express" SKU, and defaults to Standard. The Standard free tier is not subtracted.
+func (p *AWSPublicPlugin) estimateStepFunctions(traceID string, resource *pbc.ResourceDescriptor, assumed *assumptions, formula *billingFormula) (*pbc.GetProjectedCostResponse, error) {
+	workflowType := strings.ToLower(strings.TrimSpace(p.tagValue(resource, tagSFNType)))
+	if sku := strings.ToLower(resource.Sku); workflowType == "" && (sku == sfnTypeStandard || sku == sfnTypeExpress) {
+		workflowType = sku
+	}
+	typeNote := ""
+	switch workflowType {
+	case sfnTypeStandard, sfnTypeExpress:
+	case "":
+		workflowType = sfnTypeStandard
+		typeNote = " (type defaulted to standard)"
+		assumed.add(tagSFNType, sfnTypeStandard, assumptionNotSet)
+	default:
+		typeNote = fmt.Sprintf(" (unknown type %q, priced as standard)", workflowType)
+		workflowType = sfnTypeStandard
+		assumed.add(tagSFNType, sfnTypeStandard, assumptionInvalid)
+	}
+
+	var transitionRate, requestRate, gbSecondRate float64
+	var found bool
+	if workflowType == sfnTypeExpress {
+		requestRate, gbSecondRate, found = p.pricing.StepFunctionsExpressPricing()
+	} else {
+		transitionRate, found = p.pricing.StepFunctionsPricePerTransition()
+	}
+	if !found {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("aws_region", p.region).
+			Str("workflow_type", workflowType).
+			Msg("Step Functions pricing data not found")
+
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth:  0,
+			UnitPrice:     0,
+			Currency:      "USD",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Step Functions", p.region),
+		}, nil
+	}
+
+	var resp *pbc.GetProjectedCostResponse
+	if workflowType == sfnTypeStandard {
+		transitions := int64(0)
+		transitionsNote := ""
+		if val, ok := p.tag(resource, tagSFNStateTransitions); ok {
+			transitions = p.validateNonNegativeInt64(traceID, tagSFNStateTransitions, val)
+		} else {
+			transitionsNote = " (defaulted; set 'state_transitions_per_month' to estimate)"
+			assumed.add(tagSFNStateTransitions, "0", assumptionNotSet)
+		}
+
+		costPerMonth := float64(transitions) * transitionRate
+		formula.add(costPerMonth, "$%s/transition × %d state transitions", formulaNum(transitionRate), transitions)
+		resp = &pbc.GetProjectedCostResponse{
+			CostPerMonth: costPerMonth,
+			UnitPrice:    transitionRate,
+			Currency:     "USD",
+			BillingDetail: fmt.Sprintf("Step Functions Standard%s, %d state transitions%s ($%.3f per 1,000)",
+				typeNote, transitions, transitionsNote, transitionRate*1000),
+		}
+	} else {
+		requests := int64(0)
+		durationMs := int64(0)
+		memoryMB := int64(0)
+		var notes []string
+		if val, ok := p.tag(resource, "requests_per_month"); ok {
+			requests = p.validateNonNegativeInt64(traceID, "requests_per_month", val)
+		} else {
+			notes = append(notes, "requests defaulted")
+			assumed.add("requests_per_month", "0", assumptionNotSet)
+		}
+		if val, ok := p.tag(resource, "avg_duration_ms"); ok {
+			durationMs = p.validateNonNegativeInt64(traceID, "avg_duration_ms", val)
+		}
+		if durationMs == 0 {
+			durationMs = sfnDurationIncrementMs
+			notes = append(notes, "duration defaulted")
+			assumed.add("avg_duration_ms", strconv.Itoa(sfnDurationIncrementMs), tagAssumptionReason(resource.Tags, "avg_duration_ms"))
+		}
+		if val, ok := p.tag(resource, tagSFNMemoryMB); ok {
+			memoryMB = p.validateNonNegativeInt64(traceID, tagSFNMemoryMB, val)
+		}
+		if memoryMB == 0 {
+			memoryMB = sfnMemoryIncrementMB
+			notes = append(notes, "memory defaulted")
+			assumed.add(tagSFNMemoryMB, strconv.Itoa(sfnMemoryIncrementMB), tagAssumptionReason(resource.Tags, tagSFNMemoryMB))
+		}
+
+		// AWS bills Express duration in 100 ms and memory in 64 MB increments, rounded up
+		billedMs := math.Ceil(float64(durationMs)/sfnDurationIncrementMs) * sfnDurationIncrementMs
+		billedMB := math.Ceil(float64(memoryMB)/sfnMemoryIncrementMB) * sfnMemoryIncrementMB
+		gbSeconds := billedMB / 1024.0 * billedMs / 1000.0 * float64(requests)
+
+		requestCost := float64(requests) * requestRate
+		durationCost := gbSeconds * gbSecondRate
+		formula.add(requestCost, "$%s/request × %d requests", formulaNum(requestRate), requests)
+		formula.add(durationCost, "$%s/GB-s × (%s MB / 1024 × %s ms / 1000 × %d requests) GB-s",
+			formulaNum(gbSecondRate), formulaNum(billedMB), formulaNum(billedMs), requests)
+
+		detail := fmt.Sprintf("Step Functions Express, %d requests/month, %.0fms billed duration, %.0fMB billed memory",
+			requests, billedMs, billedMB)
+		if len(notes) > 0 {
+			detail += fmt.Sprintf(" (%s)", strings.Join(notes, ", "))
+		}
+		detail += fmt.Sprintf(", %.0f GB-seconds", gbSeconds)
+		resp = &pbc.GetProjectedCostResponse{
+			CostPerMonth:  requestCost + durationCost,
+			UnitPrice:     requestRate,
+			Currency:      "USD",
+			BillingDetail: detail,
+		}
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:sfn:statemachine", resp)
+
+	return resp, nil
+}
+
 // zeroCostResourceDescriptions provides billing detail messages for resources with no direct AWS charges.
 var zeroCostResourceDescriptions = map[string]string{
 	"vpc":           "VPC has no direct hourly or monthly charge. Costs may apply for associated resources (NAT Gateway, VPN, etc.)",
@@ -5,11 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"math"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 	"google.golang.org/grpc/codes"
@@ -55,8 +57,8 @@ func TestGetProjectedCost_EC2(t *testing.T) {
 	}
 
 	// Verify pricing client was called
-	if mock.ec2OnDemandCalled != 1 {
-		t.Errorf("EC2OnDemandPricePerHour called %d times, want 1", mock.ec2OnDemandCalled)
+	if mock.ec2OnDemandCalled.Load() != 1 {
+		t.Errorf("EC2OnDemandPricePerHour called %d times, want 1", mock.ec2OnDemandCalled.Load())
 	}
 }
 
@@ -130,8 +132,228 @@ func TestGetProjectedCost_EBS_WithSize(t *testing.T) {
 	}
 
 	// Verify pricing client was called
-	if mock.ebsPriceCalled != 1 {
-		t.Errorf("EBSPricePerGBMonth called %d times, want 1", mock.ebsPriceCalled)
+	if mock.ebsPriceCalled.Load() != 1 {
+		t.Errorf("EBSPricePerGBMonth called %d times, want 1", mock.ebsPriceCalled.Load())
+	}
+}
+
+// TestGetProjectedCost_EBS_PerformanceTags verifies iops/throughput tags add provisioned
+// performance charges and that unrecognized tags are ignored.
+func TestGetProjectedCost_EBS_PerformanceTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantContains string
+	}{
+		{
+			name:     "gp3 unknown tags ignored",
+			sku:      "gp3",
+			tags:     map[string]string{"size": "100", "encrypted": "true", "kms_key_id": "abc"},
+			wantCost: 8.0,
+		},
+		{
+			name:         "gp3 within baseline",
+			sku:          "gp3",
+			tags:         map[string]string{"size": "100", "iops": "3000", "throughput": "125"},
+			wantCost:     8.0,
+			wantContains: "3000 IOPS, within baseline",
+		},
+		{
+			name: "gp3 above baseline",
+			sku:  "gp3",
+			tags: map[string]string{"size": "100", "iops": "4000", "throughput": "225"},
+			// 8.0 storage + 1000 × 0.005 IOPS + 100 × 0.04 throughput
+			wantCost:     8.0 + 5.0 + 4.0,
+			wantContains: "1000 above baseline",
+		},
+		{
+			name:         "gp3 throughput only defaults iops",
+			sku:          "gp3",
+			tags:         map[string]string{"size": "100", "throughput": "250"},
+			wantCost:     8.0 + 125*0.04,
+			wantContains: "3000 IOPS (defaulted)",
+		},
+		{
+			name:     "gp3 invalid iops falls back to zero",
+			sku:      "gp3",
+			tags:     map[string]string{"size": "100", "iops": "lots"},
+			wantCost: 8.0,
+		},
+		{
+			name:         "io1 bills all provisioned iops",
+			sku:          "io1",
+			tags:         map[string]string{"size": "100", "iops": "1000"},
+			wantCost:     12.5 + 65.0,
+			wantContains: "1000 IOPS at $0.0650/IOPS-month",
+		},
+		{
+			name:         "io1 without iops is annotated",
+			sku:          "io1",
+			tags:         map[string]string{"size": "100"},
+			wantCost:     12.5,
+			wantContains: "IOPS not specified",
+		},
+		{
+			name:         "io2 within the first iops tier",
+			sku:          "io2",
+			tags:         map[string]string{"size": "100", "iops": "10000"},
+			wantCost:     12.5 + 10000*0.065,
+			wantContains: "10000 IOPS (10000 at $0.0650 /IOPS-month)",
+		},
+		{
+			name: "io2 block express spans iops tiers",
+			sku:  "io2",
+			tags: map[string]string{"size": "100", "provisioned_iops": "80000"},
+			// 32000 at the base rate, 32000 at tier 2, 16000 at tier 3
+			wantCost:     12.5 + 32000*0.065 + 32000*0.0455 + 16000*0.03185,
+			wantContains: "80000 IOPS (32000 at $0.0650 + 32000 at $0.0455 + 16000 at $0.0319 /IOPS-month)",
+		},
+		{
+			name:     "gp2 ignores performance tags",
+			sku:      "gp2",
+			tags:     map[string]string{"size": "100", "iops": "5000", "throughput": "500"},
+			wantCost: 10.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp3"] = 0.08
+			mock.ebsPrices["gp2"] = 0.10
+			mock.ebsPrices["io1"] = 0.125
+			mock.ebsIOPSPrices["gp3"] = 0.005
+			mock.ebsIOPSPrices["io1"] = 0.065
+			mock.ebsPrices["io2"] = 0.125
+			mock.ebsIOPSPrices["io2"] = 0.065
+			mock.io2IOPSTiers = []pricing.TierRate{
+				{UpTo: 32000, Rate: 0.065},
+				{UpTo: 64000, Rate: 0.0455},
+				{UpTo: math.MaxFloat64, Rate: 0.03185},
+			}
+			mock.ebsThroughputPrices["gp3"] = 0.04
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ebs",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if tt.wantContains != "" && !strings.Contains(resp.BillingDetail, tt.wantContains) {
+				t.Errorf("BillingDetail = %q, want substring %q", resp.BillingDetail, tt.wantContains)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EBS_MultipleVolumes tests pricing several volumes via the "volumes" tag.
+func TestGetProjectedCost_EBS_MultipleVolumes(t *testing.T) {
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantCost     float64
+		wantContains []string
+		wantCarbon   bool
+	}{
+		{
+			name:     "sums and itemizes volumes",
+			tags:     map[string]string{"volumes": `[{"type":"gp3","size":100},{"type":"io1","size":200}]`},
+			wantCost: 100*0.08 + 200*0.125,
+			wantContains: []string{
+				"EBS volumes (2), 300 GB total",
+				"[1] gp3 100 GB at $0.0800/GB-month ($8.00)",
+				"[2] io1 200 GB at $0.1250/GB-month, IOPS not specified, provisioned IOPS charges excluded ($25.00)",
+			},
+			wantCarbon: true,
+		},
+		{
+			name: "provisioned IOPS and throughput priced per volume",
+			tags: map[string]string{"volumes": `[{"type":"gp3","size":100,"iops":4000,"throughput":250},{"type":"io1","size":200,"iops":1000}]`},
+			wantCost: 100*0.08 + 1000*0.005 + 125*0.04 +
+				200*0.125 + 1000*0.065,
+			wantContains: []string{
+				"[1] gp3 100 GB at $0.0800/GB-month, 4000 IOPS (1000 above baseline at $0.0050/IOPS-month), " +
+					"250 MiB/s (125 above baseline at $0.0400/MiBps-month) ($18.00)",
+				"[2] io1 200 GB at $0.1250/GB-month, 1000 IOPS at $0.0650/IOPS-month ($90.00)",
+			},
+			wantCarbon: true,
+		},
+		{
+			name:     "invalid entries skipped and noted",
+			tags:     map[string]string{"volumes": `[{"type":"gp3","size":50},{"type":"st9","size":10},{"size":10},{"type":"gp2","size":-5}]`},
+			wantCost: 50 * 0.08,
+			wantContains: []string{
+				"EBS volumes (1), 50 GB total",
+				`[2] unknown volume type "st9"`,
+				"[3] missing type",
+				"[4] invalid size -5",
+			},
+			wantCarbon: true,
+		},
+		{
+			name:         "no valid entries costs nothing",
+			tags:         map[string]string{"volumes": `[{"type":"bogus","size":10}]`},
+			wantCost:     0,
+			wantContains: []string{"No valid EBS volumes", "skipped [1]"},
+		},
+		{
+			name:         "malformed JSON falls back to single volume",
+			tags:         map[string]string{"size": "100", "volumes": `[{"type":`},
+			wantCost:     100 * 0.08,
+			wantContains: []string{"gp3 volume, 100 GB", "'volumes' tag ignored (invalid JSON)"},
+			wantCarbon:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp3"] = 0.08
+			mock.ebsPrices["gp2"] = 0.10
+			mock.ebsPrices["io1"] = 0.125
+			mock.ebsIOPSPrices["gp3"] = 0.005
+			mock.ebsIOPSPrices["io1"] = 0.065
+			mock.ebsThroughputPrices["gp3"] = 0.04
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ebs",
+					Sku:          "gp3",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(resp.BillingDetail, want) {
+					t.Errorf("BillingDetail = %q, want substring %q", resp.BillingDetail, want)
+				}
+			}
+			if hasCarbon := len(resp.ImpactMetrics) > 0; hasCarbon != tt.wantCarbon {
+				t.Errorf("has carbon metric = %v, want %v", hasCarbon, tt.wantCarbon)
+			}
+		})
 	}
 }
 
@@ -172,6 +394,239 @@ func TestGetProjectedCost_EBS_DefaultSize(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_EBS_MinimumSize verifies st1/sc1 volumes below 125GB are billed
+// at the minimum with a note, while other types keep the requested size.
+func TestGetProjectedCost_EBS_MinimumSize(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["st1"] = 0.045
+	mock.ebsPrices["sc1"] = 0.015
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		volumeType string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{name: "st1 below minimum", volumeType: "st1", tags: map[string]string{"size": "10"},
+			wantCost: 0.045 * 125, wantDetail: "st1 volume, 125 GB (10 GB requested, billed at the st1 minimum)"},
+		{name: "sc1 default size", volumeType: "sc1",
+			wantCost: 0.015 * 125, wantDetail: "sc1 volume, 125 GB (sc1 minimum, size defaulted)"},
+		{name: "sc1 above minimum", volumeType: "sc1", tags: map[string]string{"size": "500"},
+			wantCost: 0.015 * 500, wantDetail: "sc1 volume, 500 GB, "},
+		{name: "gp3 has no minimum", volumeType: "gp3", tags: map[string]string{"size": "10"},
+			wantCost: 0.08 * 10, wantDetail: "gp3 volume, 10 GB, "},
+		{name: "st1 in volumes list", volumeType: "st1", tags: map[string]string{"volumes": `[{"type":"st1","size":10}]`},
+			wantCost: 0.045 * 125, wantDetail: "st1 125 GB (10 GB requested, st1 minimum)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ebs",
+					Sku:          tt.volumeType,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EBS_UsedGB verifies used_gb scales carbon to the stored data while
+// cost stays on the provisioned size, and that unusable values fall back to provisioned.
+func TestGetProjectedCost_EBS_UsedGB(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(t *testing.T, tags map[string]string) *pbc.GetProjectedCostResponse {
+		t.Helper()
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ebs",
+				Sku:          "gp3",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		if len(resp.ImpactMetrics) == 0 {
+			t.Fatal("expected a carbon impact metric")
+		}
+		return resp
+	}
+	provisioned := estimate(t, map[string]string{"size": "100"})
+
+	tests := []struct {
+		name       string
+		usedGB     string
+		wantRatio  float64
+		wantDetail string
+	}{
+		{name: "used below provisioned", usedGB: "25", wantRatio: 0.25, wantDetail: ", carbon on 25 GB used of 100 GB provisioned"},
+		{name: "empty volume", usedGB: "0", wantRatio: 0, wantDetail: ", carbon on 0 GB used of 100 GB provisioned"},
+		{name: "used above provisioned", usedGB: "150", wantRatio: 1},
+		{name: "invalid", usedGB: "half", wantRatio: 1},
+		{name: "negative", usedGB: "-5", wantRatio: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := estimate(t, map[string]string{"size": "100", tagEBSUsedGB: tt.usedGB})
+			if resp.CostPerMonth != provisioned.CostPerMonth {
+				t.Errorf("CostPerMonth = %v, want provisioned cost %v", resp.CostPerMonth, provisioned.CostPerMonth)
+			}
+			want := provisioned.ImpactMetrics[0].Value * tt.wantRatio
+			if got := resp.ImpactMetrics[0].Value; math.Abs(got-want) > 1e-9 {
+				t.Errorf("carbon = %v, want %v", got, want)
+			}
+			if tt.wantDetail != "" && !strings.HasSuffix(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want suffix %q", resp.BillingDetail, tt.wantDetail)
+			}
+			if tt.wantDetail == "" && strings.Contains(resp.BillingDetail, "used of") {
+				t.Errorf("BillingDetail = %q, want no used-size note", resp.BillingDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EBS_GP3SavingsNote verifies gp2 estimates note the gp3 savings
+// only when gp3 is priced lower, without changing the gp2 cost.
+func TestGetProjectedCost_EBS_GP3SavingsNote(t *testing.T) {
+	tests := []struct {
+		name       string
+		sku        string
+		gp3Price   float64 // 0 leaves gp3 unpriced
+		wantDetail string
+	}{
+		{name: "gp3 cheaper", sku: "gp2", gp3Price: 0.08, wantDetail: ", gp3 would save $2.00/month ($8.00 vs $10.00)"},
+		{name: "gp3 not cheaper", sku: "gp2", gp3Price: 0.10},
+		{name: "gp3 unpriced", sku: "gp2"},
+		{name: "already gp3", sku: "gp3", gp3Price: 0.08},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp2"] = 0.10
+			if tt.gp3Price > 0 {
+				mock.ebsPrices["gp3"] = tt.gp3Price
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ebs",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         map[string]string{"size": "100"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if want := mock.ebsPrices[tt.sku] * 100; math.Abs(resp.CostPerMonth-want) > 1e-9 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, want)
+			}
+			if tt.wantDetail == "" {
+				if strings.Contains(resp.BillingDetail, "would save") {
+					t.Errorf("BillingDetail = %q, want no gp3 note", resp.BillingDetail)
+				}
+				return
+			}
+			if !strings.HasSuffix(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want suffix %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_FamilyNormalization verifies uppercase families price like
+// lowercase ones and unpriced retired families are estimated as their successor with a
+// note.
+func TestGetProjectedCost_EC2_FamilyNormalization(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.micro/Linux/Shared"] = 0.0116
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m5.xlarge/Linux/Shared"] = 0.192
+	mock.ec2Prices["m3.large/Linux/Shared"] = 0.133
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		sku        string
+		wantRate   float64
+		wantDetail string
+	}{
+		{name: "uppercase family", sku: "T2.micro", wantRate: 0.0116},
+		{name: "padded", sku: " t2.micro ", wantRate: 0.0116},
+		{
+			name: "retired family, same size", sku: "m1.xlarge", wantRate: 0.192,
+			wantDetail: ", m1.xlarge is a retired family, estimated as m5.xlarge",
+		},
+		{
+			name: "retired uppercase family, closest size", sku: "M1.small", wantRate: 0.096,
+			wantDetail: ", m1.small is a retired family, estimated as m5.large",
+		},
+		{name: "retired family still priced", sku: "m3.large", wantRate: 0.133},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if resp.UnitPrice != tt.wantRate {
+				t.Errorf("UnitPrice = %v, want %v (BillingDetail %q)", resp.UnitPrice, tt.wantRate, resp.BillingDetail)
+			}
+			if tt.wantDetail != "" && !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+			if tt.wantDetail == "" && strings.Contains(resp.BillingDetail, "retired family") {
+				t.Errorf("BillingDetail = %q, want no retired family note", resp.BillingDetail)
+			}
+		})
+	}
+
+	// Retired families without a priced successor still report the original as not found
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "g2.2xlarge", Region: "us-east-1"},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost(g2.2xlarge) returned error: %v", err)
+	}
+	if resp.CostPerMonth != 0 || !strings.Contains(resp.BillingDetail, `"g2.2xlarge"`) {
+		t.Errorf("g2.2xlarge = $%v %q, want $0 not found", resp.CostPerMonth, resp.BillingDetail)
+	}
+}
+
 // TestGetProjectedCost_RegionMismatch tests region mismatch error handling (T043)
 func TestGetProjectedCost_RegionMismatch(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
@@ -787,6 +1242,7 @@ func TestGetProjectedCostLogsContainRequiredFields(t *testing.T) {
 	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
 	logger := zerolog.New(&logBuf).Level(zerolog.InfoLevel)
 	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+	plugin.clock = newFakeClock(42 * time.Millisecond)
 
 	req := &pbc.GetProjectedCostRequest{
 		Resource: &pbc.ResourceDescriptor{
@@ -853,10 +1309,10 @@ func TestGetProjectedCostLogsContainRequiredFields(t *testing.T) {
 		}
 	}
 
-	// duration_ms should be non-negative
+	// duration_ms is measured with the fake clock: one 42ms step from start to log
 	if durationMs, ok := logEntry["duration_ms"].(float64); ok {
-		if durationMs < 0 {
-			t.Errorf("duration_ms = %v, should be non-negative", durationMs)
+		if durationMs != 42 {
+			t.Errorf("duration_ms = %v, want 42", durationMs)
 		}
 	}
 }
@@ -1012,8 +1468,8 @@ func TestGetProjectedCost_RDS_MySQL(t *testing.T) {
 	}
 
 	// Verify pricing client was called
-	if mock.rdsOnDemandCalled != 1 {
-		t.Errorf("RDSOnDemandPricePerHour called %d times, want 1", mock.rdsOnDemandCalled)
+	if mock.rdsOnDemandCalled.Load() != 1 {
+		t.Errorf("RDSOnDemandPricePerHour called %d times, want 1", mock.rdsOnDemandCalled.Load())
 	}
 }
 
@@ -1221,48 +1677,381 @@ func TestGetProjectedCost_RDS_AllEngines(t *testing.T) {
 	}
 }
 
-// TestGetProjectedCost_RDS_InvalidStorageSize tests invalid storage size handling
-func TestGetProjectedCost_RDS_InvalidStorageSize(t *testing.T) {
+// TestGetProjectedCost_RDS_Reserved tests the reserved-1yr pricing model
+func TestGetProjectedCost_RDS_Reserved(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
-	mock.rdsInstancePrices["db.t3.micro/MySQL"] = 0.017
-	mock.rdsStoragePrices["gp2"] = 0.115
+	mock.rdsInstancePrices["db.m5.large/MySQL"] = 0.171
+	mock.rdsReservedPrices["db.m5.large/MySQL"] = 0.117
+	mock.rdsStoragePrices["gp3"] = 0.115
 	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
 
 	tests := []struct {
-		name        string
-		storageSize string
+		name         string
+		sku          string
+		pricingModel string
+		wantRate     float64
+		wantDetail   string
 	}{
-		{"negative size", "-100"},
-		{"zero size", "0"},
-		{"non-numeric", "abc"},
-		{"empty string", ""},
+		{"reserved rate", "db.m5.large", "reserved-1yr", 0.117, "reserved 1yr No Upfront Single-AZ"},
+		{"default on-demand", "db.m5.large", "", 0.171, "on-demand Single-AZ"},
+		{"unknown model", "db.m5.large", "spot", 0.171, "pricing model defaulted to on-demand"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			tags := map[string]string{"storage_type": "gp3", "storage_size": "100"}
+			if tt.pricingModel != "" {
+				tags["pricing_model"] = tt.pricingModel
+			}
+
 			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
 				Resource: &pbc.ResourceDescriptor{
 					Provider:     "aws",
 					ResourceType: "rds",
-					Sku:          "db.t3.micro",
+					Sku:          tt.sku,
 					Region:       "us-east-1",
-					Tags: map[string]string{
-						"engine":       "mysql",
-						"storage_size": tt.storageSize,
-					},
+					Tags:         tags,
 				},
 			})
-
 			if err != nil {
 				t.Fatalf("GetProjectedCost() returned error: %v", err)
 			}
 
-			// Should default to 20GB storage
-			// Instance cost: 0.017 * 730 = 12.41
-			// Storage cost: 0.115 * 20 = 2.30
-			expectedStorageCost := 0.115 * 20.0
-			expectedInstanceCost := 0.017 * 730.0
+			if resp.UnitPrice != tt.wantRate {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantRate)
+			}
+			wantCost := tt.wantRate*730.0 + 0.115*100.0
+			if math.Abs(resp.CostPerMonth-wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail should contain %q, got: %s", tt.wantDetail, resp.BillingDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_RDS_ReservedFallback tests on-demand fallback when no reserved rate exists
+func TestGetProjectedCost_RDS_ReservedFallback(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	mock.rdsInstancePrices["db.t3.micro/MySQL"] = 0.017
+	mock.rdsStoragePrices["gp2"] = 0.115
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "rds",
+			Sku:          "db.t3.micro",
+			Region:       "us-east-1",
+			Tags: map[string]string{
+				"pricing_model": "reserved-1yr",
+				"multi_az":      "true",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost() returned error: %v", err)
+	}
+
+	if resp.UnitPrice != 0.017 {
+		t.Errorf("UnitPrice = %v, want on-demand 0.017", resp.UnitPrice)
+	}
+	for _, want := range []string{"on-demand Single-AZ", "reserved 1yr rate unavailable", "Multi-AZ priced at Single-AZ rate"} {
+		if !strings.Contains(resp.BillingDetail, want) {
+			t.Errorf("BillingDetail should contain %q, got: %s", want, resp.BillingDetail)
+		}
+	}
+}
+
+// TestGetProjectedCost_RDS_AuroraStorage tests Aurora Standard and I/O-Optimized storage modes
+func TestGetProjectedCost_RDS_AuroraStorage(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	mock.rdsInstancePrices["db.r6g.large/Aurora PostgreSQL"] = 0.26
+	mock.rdsIOOptimizedPrices["db.r6g.large/Aurora PostgreSQL"] = 0.338
+	mock.rdsReservedPrices["db.r6g.large/Aurora PostgreSQL"] = 0.18
+	mock.rdsStoragePrices["aurora"] = 0.10
+	mock.rdsStoragePrices["aurora-io-optimized"] = 0.225
+	mock.auroraIORequestPrice = 0.0000002
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	tests := []struct {
+		name        string
+		tags        map[string]string
+		wantRate    float64
+		wantCost    float64
+		wantDetails []string
+	}{
+		{
+			name:        "standard with I/O",
+			tags:        map[string]string{"io_requests_per_month": "100000000"},
+			wantRate:    0.26,
+			wantCost:    0.26*730.0 + 0.10*100.0 + 0.0000002*100000000,
+			wantDetails: []string{"Aurora Standard storage", "100000000 I/O requests", "storage type defaulted"},
+		},
+		{
+			name:        "standard without I/O",
+			tags:        map[string]string{"storage_type": "aurora"},
+			wantRate:    0.26,
+			wantCost:    0.26*730.0 + 0.10*100.0,
+			wantDetails: []string{"I/O charges excluded"},
+		},
+		{
+			name:        "I/O-Optimized",
+			tags:        map[string]string{"storage_type": "aurora-io-optimized", "io_requests_per_month": "100000000"},
+			wantRate:    0.338,
+			wantCost:    0.338*730.0 + 0.225*100.0,
+			wantDetails: []string{"Aurora I/O-Optimized storage", "no per-I/O charges", "on-demand Single-AZ"},
+		},
+		{
+			name:        "I/O-Optimized ignores reserved",
+			tags:        map[string]string{"storage_type": "aurora-io-optimized", "pricing_model": "reserved-1yr"},
+			wantRate:    0.338,
+			wantCost:    0.338*730.0 + 0.225*100.0,
+			wantDetails: []string{"reserved 1yr rate unavailable, priced on-demand"},
+		},
+		{
+			name:     "aurora tags override generic storage and I/O tags",
+			tags:     map[string]string{"aurora_storage_gb": "500", "aurora_io_requests": "50000000", "io_requests_per_month": "1"},
+			wantRate: 0.26,
+			wantCost: 0.26*730.0 + 0.10*500.0 + 0.0000002*50000000,
+			wantDetails: []string{"500GB Aurora Standard storage", "50000000 I/O requests",
+				"instance $189.80 + storage $50.00 + I/O $10.00"},
+		},
+		{
+			name:        "I/O-Optimized itemizes without I/O",
+			tags:        map[string]string{"storage_type": "aurora-io-optimized", "aurora_storage_gb": "200"},
+			wantRate:    0.338,
+			wantCost:    0.338*730.0 + 0.225*200.0,
+			wantDetails: []string{"instance $246.74 + storage $45.00"},
+		},
+		{
+			name:        "non-Aurora storage type defaults to Aurora Standard",
+			tags:        map[string]string{"storage_type": "gp3"},
+			wantRate:    0.26,
+			wantCost:    0.26*730.0 + 0.10*100.0,
+			wantDetails: []string{"Aurora Standard storage", "storage type defaulted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := map[string]string{"engine": "aurora-postgresql", "storage_size": "100"}
+			for k, v := range tt.tags {
+				tags[k] = v
+			}
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "rds",
+					Sku:          "db.r6g.large",
+					Region:       "us-east-1",
+					Tags:         tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if resp.UnitPrice != tt.wantRate {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantRate)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			for _, want := range tt.wantDetails {
+				if !strings.Contains(resp.BillingDetail, want) {
+					t.Errorf("BillingDetail should contain %q, got: %s", want, resp.BillingDetail)
+				}
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_RDS_AuroraTagsIgnoredForNonAurora verifies aurora_storage_gb and
+// aurora_io_requests only apply to Aurora engines.
+func TestGetProjectedCost_RDS_AuroraTagsIgnoredForNonAurora(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.rdsInstancePrices["db.t3.micro/MySQL"] = 0.017
+	mock.rdsStoragePrices["gp2"] = 0.115
+	mock.auroraIORequestPrice = 0.0000002
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "rds",
+			Sku:          "db.t3.micro",
+			Region:       "us-east-1",
+			Tags: map[string]string{
+				"engine":             "mysql",
+				"storage_size":       "50",
+				"aurora_storage_gb":  "500",
+				"aurora_io_requests": "50000000",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost() returned error: %v", err)
+	}
+
+	if want := 0.017*730.0 + 0.115*50.0; math.Abs(resp.CostPerMonth-want) > 0.0001 {
+		t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, want)
+	}
+	if strings.Contains(resp.BillingDetail, "I/O") || !strings.Contains(resp.BillingDetail, "50GB gp2 storage") {
+		t.Errorf("BillingDetail = %q, want 50GB gp2 storage without I/O", resp.BillingDetail)
+	}
+}
+
+// TestGetProjectedCost_RDS_ReadReplicas verifies read replicas add the instance cost per
+// replica, plus their own storage for non-Aurora engines, itemized in the billing detail.
+func TestGetProjectedCost_RDS_ReadReplicas(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.rdsInstancePrices["db.m5.large/PostgreSQL"] = 0.178
+	mock.rdsInstancePrices["db.r6g.large/Aurora PostgreSQL"] = 0.26
+	mock.rdsStoragePrices["gp3"] = 0.115
+	mock.rdsStoragePrices["aurora"] = 0.10
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(sku string, tags map[string]string) (*pbc.GetProjectedCostResponse, error) {
+		return plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "rds",
+				Sku:          sku,
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+	}
+
+	tests := []struct {
+		name       string
+		sku        string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:     "no replicas by default",
+			sku:      "db.m5.large",
+			tags:     map[string]string{"engine": "postgres", "storage_type": "gp3", "storage_size": "100"},
+			wantCost: 0.178*730 + 0.115*100,
+		},
+		{
+			name: "PostgreSQL replicas bill instance and storage",
+			sku:  "db.m5.large",
+			tags: map[string]string{"engine": "postgres", "storage_type": "gp3", "storage_size": "100",
+				"read_replicas": "2"},
+			wantCost:   3 * (0.178*730 + 0.115*100),
+			wantDetail: "; 2 read replicas $282.88 (instance $259.88 + storage $23.00)",
+		},
+		{
+			name:       "Aurora replicas share cluster storage",
+			sku:        "db.r6g.large",
+			tags:       map[string]string{"engine": "aurora-postgresql", "aurora_storage_gb": "100", "read_replicas": "1"},
+			wantCost:   2*0.26*730 + 0.10*100,
+			wantDetail: "; 1 read replica $189.80 (instance hours only, cluster storage shared)",
+		},
+		{
+			name: "autoscaling upper bound covers replica storage",
+			sku:  "db.m5.large",
+			tags: map[string]string{"engine": "postgres", "storage_type": "gp3", "storage_size": "100",
+				"max_allocated_storage": "200", "read_replicas": "1"},
+			wantCost:   2 * (0.178*730 + 0.115*100),
+			wantDetail: "upper bound if storage autoscales to 200GB: $305.88/month",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := estimate(tt.sku, tt.tags)
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if tt.wantDetail != "" && !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+			if tt.wantDetail == "" && strings.Contains(resp.BillingDetail, "replica") {
+				t.Errorf("BillingDetail = %q, want no replica line", resp.BillingDetail)
+			}
+		})
+	}
+
+	t.Run("replicas add carbon", func(t *testing.T) {
+		base, err := estimate("db.m5.large", map[string]string{"engine": "postgres"})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		withReplica, err := estimate("db.m5.large", map[string]string{"engine": "postgres", "read_replicas": "1"})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		if len(base.ImpactMetrics) == 0 || len(withReplica.ImpactMetrics) == 0 {
+			t.Fatal("expected carbon impact metrics")
+		}
+		if got, want := withReplica.ImpactMetrics[0].Value, 2*base.ImpactMetrics[0].Value; math.Abs(got-want) > 1e-6 {
+			t.Errorf("carbon with 1 replica = %v, want %v", got, want)
+		}
+	})
+
+	for _, val := range []string{"-1", "16", "two"} {
+		_, err := estimate("db.m5.large", map[string]string{"engine": "postgres", "read_replicas": val})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("read_replicas %q: error = %v, want InvalidArgument", val, err)
+		}
+	}
+}
+
+// TestGetProjectedCost_RDS_InvalidStorageSize tests invalid storage size handling
+func TestGetProjectedCost_RDS_InvalidStorageSize(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	mock.rdsInstancePrices["db.t3.micro/MySQL"] = 0.017
+	mock.rdsStoragePrices["gp2"] = 0.115
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	tests := []struct {
+		name        string
+		storageSize string
+	}{
+		{"negative size", "-100"},
+		{"zero size", "0"},
+		{"non-numeric", "abc"},
+		{"empty string", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "rds",
+					Sku:          "db.t3.micro",
+					Region:       "us-east-1",
+					Tags: map[string]string{
+						"engine":       "mysql",
+						"storage_size": tt.storageSize,
+					},
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			// Should default to 20GB storage
+			// Instance cost: 0.017 * 730 = 12.41
+			// Storage cost: 0.115 * 20 = 2.30
+			expectedStorageCost := 0.115 * 20.0
+			expectedInstanceCost := 0.017 * 730.0
 			expectedTotal := expectedInstanceCost + expectedStorageCost
 
 			if resp.CostPerMonth != expectedTotal {
@@ -1277,6 +2066,132 @@ func TestGetProjectedCost_RDS_InvalidStorageSize(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_RDS_MaxAllocatedStorage verifies max_allocated_storage adds a labeled
+// upper-bound cost to the billing detail without changing the current-allocation estimate.
+func TestGetProjectedCost_RDS_MaxAllocatedStorage(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.rdsInstancePrices["db.t3.medium/MySQL"] = 0.068
+	mock.rdsStoragePrices["gp3"] = 0.10
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name      string
+		maxSize   string
+		wantUpper string // expected upper-bound segment, "" for none
+	}{
+		{name: "larger than allocation", maxSize: "500", wantUpper: "upper bound if storage autoscales to 500GB: $99.64/month"},
+		{name: "equal to allocation", maxSize: "100"},
+		{name: "smaller than allocation", maxSize: "50"},
+		{name: "invalid", maxSize: "lots"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "rds",
+					Sku:          "db.t3.medium",
+					Region:       "us-east-1",
+					Tags: map[string]string{
+						"engine":                "mysql",
+						"storage_type":          "gp3",
+						"storage_size":          "100",
+						"max_allocated_storage": tt.maxSize,
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			// The estimate always reflects the current 100GB allocation
+			expectedTotal := 0.068*730.0 + 0.10*100.0
+			if resp.CostPerMonth != expectedTotal {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, expectedTotal)
+			}
+
+			hasUpper := strings.Contains(resp.BillingDetail, "upper bound")
+			if hasUpper != (tt.wantUpper != "") {
+				t.Errorf("BillingDetail upper bound present = %v, want %v, got: %s", hasUpper, tt.wantUpper != "", resp.BillingDetail)
+			}
+			if tt.wantUpper != "" && !strings.Contains(resp.BillingDetail, tt.wantUpper) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantUpper)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_S3_StorageClasses verifies the one-zone and archive classes resolve
+// a price, itemize retrieval_gb separately from storage and note minimum storage durations.
+func TestGetProjectedCost_S3_StorageClasses(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.s3Prices["STANDARD"] = 0.023
+	mock.s3Prices["ONEZONE_IA"] = 0.01
+	mock.s3Prices["GLACIER_IR"] = 0.004
+	mock.s3Prices["GLACIER"] = 0.0036
+	mock.s3Prices["DEEP_ARCHIVE"] = 0.00099
+	mock.s3RetrievalPrices["ONEZONE_IA"] = 0.01
+	mock.s3RetrievalPrices["GLACIER_IR"] = 0.03
+	mock.s3RetrievalPrices["GLACIER"] = 0.01
+	mock.s3RetrievalPrices["DEEP_ARCHIVE"] = 0.02
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		storageClass  string
+		retrievalGB   string
+		wantCost      float64
+		wantRetrieval string // expected retrieval segment, "" for none
+		wantMinimum   string // expected minimum duration segment, "" for none
+	}{
+		{storageClass: "ONEZONE_IA", wantCost: 10, wantMinimum: "30-day minimum"},
+		{storageClass: "ONEZONE_IA", retrievalGB: "100", wantCost: 11, wantRetrieval: "retrieval 100 GB at $0.0100/GB ($1.00)", wantMinimum: "30-day minimum"},
+		{storageClass: "GLACIER_IR", retrievalGB: "100", wantCost: 7, wantRetrieval: "retrieval 100 GB at $0.0300/GB ($3.00)", wantMinimum: "90-day minimum"},
+		{storageClass: "GLACIER", retrievalGB: "100", wantCost: 4.6, wantRetrieval: "retrieval 100 GB at $0.0100/GB ($1.00)", wantMinimum: "90-day minimum"},
+		{storageClass: "DEEP_ARCHIVE", retrievalGB: "100", wantCost: 2.99, wantRetrieval: "retrieval 100 GB at $0.0200/GB ($2.00)", wantMinimum: "180-day minimum"},
+		{storageClass: "STANDARD", retrievalGB: "100", wantCost: 23},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.storageClass+"/"+tt.retrievalGB, func(t *testing.T) {
+			tags := map[string]string{"size": "1000"}
+			if tt.retrievalGB != "" {
+				tags["retrieval_gb"] = tt.retrievalGB
+			}
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "s3",
+					Sku:          tt.storageClass,
+					Region:       "us-east-1",
+					Tags:         tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			hasRetrieval := strings.Contains(resp.BillingDetail, "retrieval")
+			if hasRetrieval != (tt.wantRetrieval != "") {
+				t.Errorf("BillingDetail retrieval present = %v, want %v, got: %s", hasRetrieval, tt.wantRetrieval != "", resp.BillingDetail)
+			}
+			if tt.wantRetrieval != "" && !strings.Contains(resp.BillingDetail, tt.wantRetrieval) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantRetrieval)
+			}
+			hasMinimum := strings.Contains(resp.BillingDetail, "minimum storage duration")
+			if hasMinimum != (tt.wantMinimum != "") {
+				t.Errorf("BillingDetail minimum duration present = %v, want %v, got: %s", hasMinimum, tt.wantMinimum != "", resp.BillingDetail)
+			}
+			if tt.wantMinimum != "" && !strings.Contains(resp.BillingDetail, tt.wantMinimum) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantMinimum)
+			}
+		})
+	}
+}
+
 func TestDetectService(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1365,14 +2280,14 @@ func TestGetProjectedCost_EKS_StandardSupport(t *testing.T) {
 	}
 
 	// Verify billing detail mentions standard support and control plane only
-	expectedDetail := "EKS cluster (standard support), 730 hrs/month (control plane only, excludes worker nodes)"
+	expectedDetail := "EKS cluster (standard support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 	if resp.BillingDetail != expectedDetail {
 		t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 	}
 
 	// Verify pricing client was called
-	if mock.eksPriceCalled != 1 {
-		t.Errorf("EKSClusterPricePerHour called %d times, want 1", mock.eksPriceCalled)
+	if mock.eksPriceCalled.Load() != 1 {
+		t.Errorf("EKSClusterPricePerHour called %d times, want 1", mock.eksPriceCalled.Load())
 	}
 }
 
@@ -1408,7 +2323,7 @@ func TestGetProjectedCost_EKS_ExtendedSupport(t *testing.T) {
 	}
 
 	// Verify billing detail mentions extended support
-	expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes)"
+	expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 	if resp.BillingDetail != expectedDetail {
 		t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 	}
@@ -1485,7 +2400,7 @@ func TestGetProjectedCost_EKS_ExtendedSupportViaTags(t *testing.T) {
 	}
 
 	// Verify billing detail mentions extended support
-	expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes)"
+	expectedDetail := "EKS cluster (extended support), 730 hrs/month (control plane only, excludes worker nodes and Fargate pods)"
 	if resp.BillingDetail != expectedDetail {
 		t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, expectedDetail)
 	}
@@ -1548,15 +2463,90 @@ func TestGetProjectedCost_EKS_SupportTypeCaseInsensitive(t *testing.T) {
 	}
 }
 
-// TestExtractAWSSKU tests SDK-style SKU extraction with priority ordering
-func TestExtractAWSSKU(t *testing.T) {
+// TestGetProjectedCost_EKS_FargatePods verifies Fargate pod tags add pod compute cost
+// and that the billing detail states whether it was included or excluded.
+func TestGetProjectedCost_EKS_FargatePods(t *testing.T) {
 	tests := []struct {
-		name     string
-		tags     map[string]string
-		expected string
+		name        string
+		fargate     bool
+		tags        map[string]string
+		wantCost    float64
+		wantContain string
 	}{
 		{
-			name:     "nil tags",
+			name:        "included",
+			fargate:     true,
+			tags:        map[string]string{"fargate_vcpu": "0.5", "fargate_memory_gb": "1", "num_pods": "4"},
+			wantCost:    0.10*730 + 4*(0.5*0.04+1*0.005)*730,
+			wantContain: "control plane + Fargate pods included: 4 pods × 0.5 vCPU/1 GB",
+		},
+		{
+			name:        "num_pods defaults to 1",
+			fargate:     true,
+			tags:        map[string]string{"fargate_vcpu": "1", "fargate_memory_gb": "2"},
+			wantCost:    0.10*730 + (0.04+2*0.005)*730,
+			wantContain: "included: 1 pods × 1 vCPU/2 GB",
+		},
+		{
+			name:        "pricing unavailable",
+			fargate:     false,
+			tags:        map[string]string{"fargate_vcpu": "1", "fargate_memory_gb": "2"},
+			wantCost:    0.10 * 730,
+			wantContain: "Fargate pods excluded: pricing unavailable",
+		},
+		{
+			name:        "memory missing",
+			fargate:     true,
+			tags:        map[string]string{"fargate_vcpu": "1"},
+			wantCost:    0.10 * 730,
+			wantContain: "Fargate pods excluded: fargate_vcpu and fargate_memory_gb must both be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.eksStandardPrice = 0.10
+			if tt.fargate {
+				mock.eksFargateVCPUPrice = 0.04
+				mock.eksFargateGBPrice = 0.005
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "eks",
+					Sku:          "cluster",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.UnitPrice != 0.10 {
+				t.Errorf("UnitPrice = %v, want 0.10", resp.UnitPrice)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantContain) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantContain)
+			}
+		})
+	}
+}
+
+// TestExtractAWSSKU tests SDK-style SKU extraction with priority ordering
+func TestExtractAWSSKU(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     map[string]string
+		expected string
+	}{
+		{
+			name:     "nil tags",
 			tags:     nil,
 			expected: "",
 		},
@@ -1616,6 +2606,53 @@ func TestExtractAWSSKU(t *testing.T) {
 			},
 			expected: "t3.micro",
 		},
+		{
+			name: "instance_type snake_case",
+			tags: map[string]string{
+				"instance_type": "m5.large",
+			},
+			expected: "m5.large",
+		},
+		{
+			name: "instanceType priority over instance_type",
+			tags: map[string]string{
+				"instanceType":  "t3.micro",
+				"instance_type": "m5.large",
+			},
+			expected: "t3.micro",
+		},
+		{
+			name: "instance_type priority over instance_class and type",
+			tags: map[string]string{
+				"instance_type":  "m5.large",
+				"instance_class": "db.t3.micro",
+				"type":           "standard",
+			},
+			expected: "m5.large",
+		},
+		{
+			name: "node_type for ElastiCache",
+			tags: map[string]string{
+				"node_type": "cache.m5.large",
+			},
+			expected: "cache.m5.large",
+		},
+		{
+			name: "node_type priority over type",
+			tags: map[string]string{
+				"node_type": "cache.t3.micro",
+				"type":      "redis",
+			},
+			expected: "cache.t3.micro",
+		},
+		{
+			name: "instance_class priority over node_type",
+			tags: map[string]string{
+				"instance_class": "db.t3.micro",
+				"node_type":      "cache.t3.micro",
+			},
+			expected: "db.t3.micro",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2155,6 +3192,204 @@ func TestGetProjectedCost_Lambda_ARMFallbackToX86(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_Lambda_ARMFallbackDisclosure verifies the x86_64 substitution is
+// disclosed per region and that the configured ARM discount is applied.
+func TestGetProjectedCost_Lambda_ARMFallbackDisclosure(t *testing.T) {
+	const x86Rate = 0.0000166667
+
+	tests := []struct {
+		name       string
+		region     string
+		armRate    float64 // 0 means the region has no arm64 row
+		discount   string
+		wantRate   float64
+		wantDetail string
+	}{
+		{"region with arm64 pricing", "us-east-1", 0.0000133334, "", 0.0000133334, ""},
+		{"region without arm64 pricing", "ap-south-1", 0, "", x86Rate, "x86_64 rate used"},
+		{"region without arm64 pricing, discounted", "sa-east-1", 0, "0.2", x86Rate * 0.8, "x86_64 rate less 20% used"},
+		{"discount ignored when arm64 available", "eu-west-1", 0.0000133334, "0.2", 0.0000133334, ""},
+		{"invalid discount uses raw x86", "ap-south-1", 0, "1.5", x86Rate, "x86_64 rate used"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvLambdaARMFallbackDiscount, tt.discount)
+			mock := newMockPricingClient(tt.region, "USD")
+			mock.lambdaPrices["request"] = 0.0000002
+			mock.lambdaPrices["gb-second"] = x86Rate
+			if tt.armRate > 0 {
+				mock.lambdaPrices["gb-second-arm64"] = tt.armRate
+			}
+			plugin := NewAWSPublicPlugin(tt.region, "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "lambda",
+					Sku:          "1024",
+					Region:       tt.region,
+					Tags: map[string]string{
+						"requests_per_month": "1000000",
+						"avg_duration_ms":    "200",
+						"arch":               "arm64",
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.UnitPrice-tt.wantRate) > 1e-12 {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantRate)
+			}
+			hasNote := strings.Contains(resp.BillingDetail, "arm64 rate unavailable")
+			if tt.wantDetail == "" && hasNote {
+				t.Errorf("BillingDetail should not mention fallback, got: %s", resp.BillingDetail)
+			}
+			if tt.wantDetail != "" && !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail should contain %q, got: %s", tt.wantDetail, resp.BillingDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_DetailedMonitoring verifies the opt-in CloudWatch surcharge for
+// 1-minute monitoring is added to the instance cost and itemized.
+func TestGetProjectedCost_EC2_DetailedMonitoring(t *testing.T) {
+	const instanceMonthly = 0.0104 * 730.0
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		withTiers    bool
+		wantCost     float64
+		wantContains string
+		wantAbsent   string
+	}{
+		{
+			name:       "off by default",
+			withTiers:  true,
+			wantCost:   instanceMonthly,
+			wantAbsent: "detailed monitoring",
+		},
+		{
+			name:         "enabled adds 7 metrics",
+			tags:         map[string]string{"detailed_monitoring": "true"},
+			withTiers:    true,
+			wantCost:     instanceMonthly + 7*0.30,
+			wantContains: "detailed monitoring 7 CloudWatch metrics ($2.10/month)",
+		},
+		{
+			name:         "enabled without CloudWatch pricing",
+			tags:         map[string]string{"detailed_monitoring": "yes"},
+			wantCost:     instanceMonthly,
+			wantContains: "detailed monitoring excluded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			if tt.withTiers {
+				mock.cwMetricsTiers = []pricing.TierRate{
+					{UpTo: 10000, Rate: 0.30},
+					{UpTo: 1e18, Rate: 0.10},
+				}
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if tt.wantContains != "" && !strings.Contains(resp.BillingDetail, tt.wantContains) {
+				t.Errorf("BillingDetail = %q, want substring %q", resp.BillingDetail, tt.wantContains)
+			}
+			if tt.wantAbsent != "" && strings.Contains(resp.BillingDetail, tt.wantAbsent) {
+				t.Errorf("BillingDetail = %q, should not contain %q", resp.BillingDetail, tt.wantAbsent)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_CapacityReservation verifies the capacity_reservation tag bills the
+// full month at the On-Demand rate and labels the charge as reserved capacity.
+func TestGetProjectedCost_EC2_CapacityReservation(t *testing.T) {
+	const instanceMonthly = 0.0104 * 730.0
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		utilization  float64
+		wantContains string
+		wantAbsent   string
+	}{
+		{
+			name:         "absent keeps on-demand detail",
+			wantContains: "On-demand Linux, Shared tenancy, 730 hrs/month",
+			wantAbsent:   "capacity reservation",
+		},
+		{
+			name:         "reservation billed even when idle",
+			tags:         map[string]string{"capacity_reservation": "true"},
+			utilization:  0.01,
+			wantContains: "capacity reservation Linux, Shared tenancy, 730 hrs/month reserved-capacity charge (billed even when idle)",
+		},
+		{
+			name:         "false keeps on-demand detail",
+			tags:         map[string]string{"capacity_reservation": "false"},
+			wantContains: "On-demand Linux",
+			wantAbsent:   "capacity reservation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+				UtilizationPercentage: tt.utilization,
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-instanceMonthly) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, instanceMonthly)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantContains) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantContains)
+			}
+			if tt.wantAbsent != "" && strings.Contains(resp.BillingDetail, tt.wantAbsent) {
+				t.Errorf("BillingDetail = %q, should not contain %q", resp.BillingDetail, tt.wantAbsent)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // Carbon Estimation Tests (T017-T019)
 // ============================================================================
@@ -2216,6 +3451,119 @@ func TestGetProjectedCost_EC2_WithCarbonMetrics(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_EC2_ConfiguredDefaultUtilization verifies FINFOCUS_DEFAULT_UTILIZATION
+// shifts carbon for requests without utilization, while explicit values still win.
+func TestGetProjectedCost_EC2_ConfiguredDefaultUtilization(t *testing.T) {
+	perResource := 0.9
+
+	tests := []struct {
+		name            string
+		envDefault      string
+		requestUtil     float64
+		perResourceUtil *float64
+		wantUtil        float64
+	}{
+		{"unset uses CCF default", "", 0, nil, carbon.DefaultUtilization},
+		{"configured default applied", "0.2", 0, nil, 0.2},
+		{"request-level overrides configured default", "0.2", 0.7, nil, 0.7},
+		{"per-resource overrides configured default", "0.2", 0.7, &perResource, 0.9},
+		{"invalid configured default ignored", "1.5", 0, nil, carbon.DefaultUtilization},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvDefaultUtilization, tt.envDefault)
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:              "aws",
+					ResourceType:          "ec2",
+					Sku:                   "t3.micro",
+					Region:                "us-east-1",
+					UtilizationPercentage: tt.perResourceUtil,
+				},
+				UtilizationPercentage: tt.requestUtil,
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if len(resp.ImpactMetrics) == 0 {
+				t.Fatal("ImpactMetrics should not be empty for t3.micro")
+			}
+
+			want, ok := carbon.NewEstimator().EstimateCarbonGrams("t3.micro", "us-east-1", tt.wantUtil, carbon.HoursPerMonth)
+			if !ok {
+				t.Fatal("carbon estimator has no data for t3.micro")
+			}
+			if got := resp.ImpactMetrics[0].Value; math.Abs(got-want) > 1e-6 {
+				t.Errorf("carbon = %v gCO2e, want %v (utilization %v)", got, want, tt.wantUtil)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_TagUtilization verifies utilization tags are used only when
+// both proto fields are unset, and that percent and fraction scales are normalized.
+func TestGetProjectedCost_EC2_TagUtilization(t *testing.T) {
+	perResource := 0.9
+
+	tests := []struct {
+		name            string
+		tags            map[string]string
+		requestUtil     float64
+		perResourceUtil *float64
+		wantUtil        float64
+	}{
+		{"fraction tag", map[string]string{"utilization": "0.35"}, 0, nil, 0.35},
+		{"percent tag", map[string]string{"utilization": "35"}, 0, nil, 0.35},
+		{"one means full utilization", map[string]string{"utilization": "1"}, 0, nil, 1.0},
+		{"avg_cpu_utilization fallback", map[string]string{"avg_cpu_utilization": "80"}, 0, nil, 0.8},
+		{"utilization wins over avg_cpu_utilization", map[string]string{"utilization": "20", "avg_cpu_utilization": "80"}, 0, nil, 0.2},
+		{"invalid utilization falls through", map[string]string{"utilization": "high", "avg_cpu_utilization": "80"}, 0, nil, 0.8},
+		{"out of range ignored", map[string]string{"utilization": "150"}, 0, nil, carbon.DefaultUtilization},
+		{"zero ignored", map[string]string{"utilization": "0"}, 0, nil, carbon.DefaultUtilization},
+		{"request-level overrides tag", map[string]string{"utilization": "20"}, 0.7, nil, 0.7},
+		{"per-resource overrides tag", map[string]string{"utilization": "20"}, 0.7, &perResource, 0.9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:              "aws",
+					ResourceType:          "ec2",
+					Sku:                   "t3.micro",
+					Region:                "us-east-1",
+					Tags:                  tt.tags,
+					UtilizationPercentage: tt.perResourceUtil,
+				},
+				UtilizationPercentage: tt.requestUtil,
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if len(resp.ImpactMetrics) == 0 {
+				t.Fatal("ImpactMetrics should not be empty for t3.micro")
+			}
+
+			want, ok := carbon.NewEstimator().EstimateCarbonGrams("t3.micro", "us-east-1", tt.wantUtil, carbon.HoursPerMonth)
+			if !ok {
+				t.Fatal("carbon estimator has no data for t3.micro")
+			}
+			if got := resp.ImpactMetrics[0].Value; math.Abs(got-want) > 1e-6 {
+				t.Errorf("carbon = %v gCO2e, want %v (utilization %v)", got, want, tt.wantUtil)
+			}
+		})
+	}
+}
+
 // TestGetProjectedCost_EC2_CarbonZeroForUnknownInstance tests that carbon is 0 for unknown instance types (T018)
 func TestGetProjectedCost_EC2_CarbonZeroForUnknownInstance(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
@@ -2247,29 +3595,155 @@ func TestGetProjectedCost_EC2_CarbonZeroForUnknownInstance(t *testing.T) {
 	}
 }
 
-// TestGetProjectedCost_EC2_RegionAffectsCarbon tests that region affects carbon value (T019)
-func TestGetProjectedCost_EC2_RegionAffectsCarbon(t *testing.T) {
-	// Test with us-east-1 plugin
-	mockUSEast := newMockPricingClient("us-east-1", "USD")
-	mockUSEast.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
-	loggerUSEast := zerolog.New(nil).Level(zerolog.InfoLevel)
-	pluginUSEast := NewAWSPublicPlugin("us-east-1", "test-version", mockUSEast, loggerUSEast)
-
-	respUSEast, err := pluginUSEast.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
-		Resource: &pbc.ResourceDescriptor{
-			Provider:     "aws",
-			ResourceType: "ec2",
-			Sku:          "t3.micro",
-			Region:       "us-east-1",
-		},
-	})
-	if err != nil {
-		t.Fatalf("GetProjectedCost(us-east-1) error: %v", err)
-	}
+// TestGetProjectedCost_EC2_BareMetal verifies bare-metal SKUs are priced and carry a
+// carbon metric, including sized metal variants that have no CCF row of their own.
+func TestGetProjectedCost_EC2_BareMetal(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["c5.metal/Linux/Shared"] = 4.08
+	mock.ec2Prices["m7i.metal-48xl/Linux/Shared"] = 9.6768
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
 
-	// Test with eu-north-1 plugin (Sweden - very low carbon grid)
-	mockEUNorth := newMockPricingClient("eu-north-1", "USD")
-	mockEUNorth.ec2Prices["t3.micro/Linux/Shared"] = 0.0116
+	for _, sku := range []string{"c5.metal", "m7i.metal-48xl"} {
+		t.Run(sku, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          sku,
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if resp.CostPerMonth <= 0 {
+				t.Errorf("CostPerMonth = %v, want > 0", resp.CostPerMonth)
+			}
+			carbonGrams, ok := carbonFootprint(resp)
+			if !ok {
+				t.Fatal("ImpactMetrics should contain METRIC_KIND_CARBON_FOOTPRINT for bare-metal SKU")
+			}
+			if carbonGrams <= 0 {
+				t.Errorf("carbon = %v gCO2e, want > 0", carbonGrams)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_CarbonUnavailableAnnotation tests the opt-in annotation for
+// instance types that have pricing but no carbon data.
+func TestGetProjectedCost_EC2_CarbonUnavailableAnnotation(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["unknown.instance/Linux/Shared"] = 0.01
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		sku        string
+		wantUnit   string
+		wantDetail bool
+	}{
+		{"unknown instance annotated", "unknown.instance", carbonUnavailableUnit, true},
+		{"known instance unaffected", "t3.micro", "gCO2e", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         map[string]string{tagAnnotateMissingCarbon: "true"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if len(resp.ImpactMetrics) != 1 {
+				t.Fatalf("ImpactMetrics length = %d, want 1", len(resp.ImpactMetrics))
+			}
+			metric := resp.ImpactMetrics[0]
+			if metric.Kind != pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+				t.Errorf("metric kind = %v, want carbon footprint", metric.Kind)
+			}
+			if metric.Unit != tt.wantUnit {
+				t.Errorf("metric unit = %q, want %q", metric.Unit, tt.wantUnit)
+			}
+			if tt.wantDetail && metric.Value != 0 {
+				t.Errorf("metric value = %v, want 0 for unavailable carbon", metric.Value)
+			}
+			if got := strings.Contains(resp.BillingDetail, "carbon unavailable"); got != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want carbon unavailable note: %v", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_EC2_InstanceStore tests that instance-store families return cost,
+// carbon and a note that local storage is included in the instance price.
+func TestGetProjectedCost_EC2_InstanceStore(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["i3.large/Linux/Shared"] = 0.156
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Sku:          "i3.large",
+			Region:       "us-east-1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost() returned error: %v", err)
+	}
+
+	if resp.CostPerMonth == 0 {
+		t.Error("CostPerMonth should be non-zero for i3.large")
+	}
+	if !strings.Contains(resp.BillingDetail, "includes 475 GB SSD instance storage") {
+		t.Errorf("BillingDetail = %q, want instance storage note", resp.BillingDetail)
+	}
+
+	var carbonValue float64
+	for _, m := range resp.ImpactMetrics {
+		if m.Kind == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+			carbonValue = m.Value
+		}
+	}
+	if carbonValue == 0 {
+		t.Error("carbon footprint should be non-zero for i3.large")
+	}
+}
+
+// TestGetProjectedCost_EC2_RegionAffectsCarbon tests that region affects carbon value (T019)
+func TestGetProjectedCost_EC2_RegionAffectsCarbon(t *testing.T) {
+	// Test with us-east-1 plugin
+	mockUSEast := newMockPricingClient("us-east-1", "USD")
+	mockUSEast.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	loggerUSEast := zerolog.New(nil).Level(zerolog.InfoLevel)
+	pluginUSEast := NewAWSPublicPlugin("us-east-1", "test-version", mockUSEast, loggerUSEast)
+
+	respUSEast, err := pluginUSEast.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Sku:          "t3.micro",
+			Region:       "us-east-1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetProjectedCost(us-east-1) error: %v", err)
+	}
+
+	// Test with eu-north-1 plugin (Sweden - very low carbon grid)
+	mockEUNorth := newMockPricingClient("eu-north-1", "USD")
+	mockEUNorth.ec2Prices["t3.micro/Linux/Shared"] = 0.0116
 	loggerEUNorth := zerolog.New(nil).Level(zerolog.InfoLevel)
 	pluginEUNorth := NewAWSPublicPlugin("eu-north-1", "test-version", mockEUNorth, loggerEUNorth)
 
@@ -2536,6 +4010,59 @@ func TestGetProjectedCost_EC2_GPUInstance(t *testing.T) {
 	t.Logf("p3.2xlarge: $%.2f/month, carbon metrics present=%v", resp.CostPerMonth, hasCarbon)
 }
 
+// TestGetProjectedCost_EC2_MLAccelerator verifies Inferentia and Trainium instances are
+// priced and their carbon includes accelerator power, including sizes missing from the
+// CCF data.
+func TestGetProjectedCost_EC2_MLAccelerator(t *testing.T) {
+	tests := []struct {
+		sku   string
+		price float64
+	}{
+		{sku: "inf2.xlarge", price: 0.7582},
+		{sku: "inf1.6xlarge", price: 1.18}, // no CCF row
+		{sku: "trn1.2xlarge", price: 1.34375},
+		{sku: "trn2.48xlarge", price: 44.701}, // no CCF or exact accelerator row
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sku, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices[tt.sku+"/Linux/Shared"] = tt.price
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if want := tt.price * carbon.HoursPerMonth; math.Abs(resp.CostPerMonth-want) > 0.01 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, want)
+			}
+
+			var carbonGrams float64
+			for _, m := range resp.ImpactMetrics {
+				if m.Kind == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+					carbonGrams = m.Value
+				}
+			}
+			cpuOnly, ok := (&carbon.Estimator{IncludeGPU: false}).EstimateCarbonGrams(
+				tt.sku, "us-east-1", carbon.DefaultUtilization, carbon.HoursPerMonth)
+			if !ok {
+				t.Fatalf("no CPU carbon spec for %s", tt.sku)
+			}
+			if carbonGrams <= cpuOnly {
+				t.Errorf("carbon = %v gCO2e, want more than the CPU-only %v (accelerator power missing)", carbonGrams, cpuOnly)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // DynamoDB Tests
 // ============================================================================
@@ -2841,6 +4368,29 @@ func TestValidateNonNegativeInt64(t *testing.T) {
 			hasWarn:  true,
 			warnMsg:  "invalid integer value, defaulting to 0",
 		},
+		{
+			name:     "max int64",
+			tagName:  "test_tag",
+			value:    "9223372036854775807",
+			expected: math.MaxInt64,
+			hasWarn:  false,
+		},
+		{
+			name:     "overflow capped",
+			tagName:  "requests_per_month",
+			value:    "99999999999999999999",
+			expected: math.MaxInt64,
+			hasWarn:  true,
+			warnMsg:  "integer value overflows int64, capping at maximum",
+		},
+		{
+			name:     "negative overflow",
+			tagName:  "test_tag",
+			value:    "-99999999999999999999",
+			expected: 0,
+			hasWarn:  true,
+			warnMsg:  "negative value, defaulting to 0",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2921,6 +4471,46 @@ func TestValidateNonNegativeFloat64(t *testing.T) {
 			hasWarn:  true,
 			warnMsg:  "invalid float value, defaulting to 0",
 		},
+		{
+			name:     "max float64 capped",
+			tagName:  "test_tag",
+			value:    "1.7976931348623157e308",
+			expected: maxFloatUsage,
+			hasWarn:  true,
+			warnMsg:  "float value exceeds maximum usage quantity, capping",
+		},
+		{
+			name:     "overflow capped",
+			tagName:  "storage_gb",
+			value:    "1e400",
+			expected: maxFloatUsage,
+			hasWarn:  true,
+			warnMsg:  "float value exceeds maximum usage quantity, capping",
+		},
+		{
+			name:     "infinity capped",
+			tagName:  "storage_gb",
+			value:    "Inf",
+			expected: maxFloatUsage,
+			hasWarn:  true,
+			warnMsg:  "float value exceeds maximum usage quantity, capping",
+		},
+		{
+			name:     "negative overflow",
+			tagName:  "test_tag",
+			value:    "-1e400",
+			expected: 0.0,
+			hasWarn:  true,
+			warnMsg:  "negative value, defaulting to 0",
+		},
+		{
+			name:     "NaN",
+			tagName:  "test_tag",
+			value:    "NaN",
+			expected: 0.0,
+			hasWarn:  true,
+			warnMsg:  "invalid float value, defaulting to 0",
+		},
 	}
 
 	for _, tt := range tests {
@@ -2946,6 +4536,50 @@ func TestValidateNonNegativeFloat64(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_OverflowUsageStaysFinite verifies usage tags beyond the numeric
+// range are capped rather than producing an infinite cost or silently becoming 0.
+func TestGetProjectedCost_OverflowUsageStaysFinite(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.sesEmailPrice = 0.0001
+	mock.sesAttachmentGBPrice = 0.12
+	mock.lambdaPrices["request"] = 0.0000002
+	mock.lambdaPrices["gb-second"] = 0.0000166667
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+	}{
+		{name: "SES infinite data", resourceType: "ses", sku: "email", tags: map[string]string{"data_gb": "Inf", "count": "10"}},
+		{name: "SES max float data", resourceType: "ses", sku: "email", tags: map[string]string{"data_gb": "1.7976931348623157e308", "count": "10"}},
+		{
+			name: "Lambda requests overflow int64", resourceType: "lambda", sku: "128",
+			tags: map[string]string{"requests_per_month": "99999999999999999999"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider: "aws", ResourceType: tt.resourceType, Sku: tt.sku, Region: "us-east-1", Tags: tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.IsInf(resp.CostPerMonth, 0) || math.IsNaN(resp.CostPerMonth) || resp.CostPerMonth <= 0 {
+				t.Errorf("CostPerMonth = %v, want a finite positive cost", resp.CostPerMonth)
+			}
+			if strings.Contains(resp.BillingDetail, "requests defaulted") {
+				t.Errorf("BillingDetail = %q, overflowing requests must not be treated as defaulted", resp.BillingDetail)
+			}
+		})
+	}
+}
+
 // TestEstimateDynamoDB_NegativeCapacityUnits tests validation warnings for negative capacity units.
 func TestEstimateDynamoDB_NegativeCapacityUnits(t *testing.T) {
 	var logBuf bytes.Buffer
@@ -3337,6 +4971,82 @@ func TestGetProjectedCost_CloudWatch_LogsStorage(t *testing.T) {
 	}
 }
 
+// TestGetProjectedCost_CloudWatch_RetentionDays tests retention_days deriving the
+// steady-state stored GB from ingestion, and explicit log_storage_gb taking precedence.
+func TestGetProjectedCost_CloudWatch_RetentionDays(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.cwLogsIngestionTiers = []pricing.TierRate{
+		{UpTo: 1e18, Rate: 0.50},
+	}
+	mock.cwLogsStorageRate = 0.03
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+		wantErr    bool
+	}{
+		{
+			name:       "30-day retention",
+			tags:       map[string]string{"log_ingestion_gb": "100", "retention_days": "30"},
+			wantCost:   100*0.50 + (100*30/(730.0/24))*0.03,
+			wantDetail: "98.63 GB logs stored @ $0.0300/GB-mo ($2.96) (steady state for 30-day retention)",
+		},
+		{
+			name:       "1-year retention",
+			tags:       map[string]string{"log_ingestion_gb": "100", "retention_days": "365"},
+			wantCost:   100*0.50 + 1200*0.03,
+			wantDetail: "1200.00 GB logs stored @ $0.0300/GB-mo ($36.00) (steady state for 365-day retention)",
+		},
+		{
+			name:       "explicit storage wins",
+			tags:       map[string]string{"log_ingestion_gb": "100", "retention_days": "365", "log_storage_gb": "50"},
+			wantCost:   100*0.50 + 50*0.03,
+			wantDetail: "50.00 GB logs stored @ $0.0300/GB-mo ($1.50) (log_storage_gb set, retention_days ignored)",
+		},
+		{
+			name:     "retention without ingestion",
+			tags:     map[string]string{"retention_days": "30"},
+			wantCost: 0,
+		},
+		{name: "zero retention", tags: map[string]string{"log_ingestion_gb": "100", "retention_days": "0"}, wantErr: true},
+		{name: "invalid retention", tags: map[string]string{"log_ingestion_gb": "100", "retention_days": "forever"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "cloudwatch",
+					Sku:          "logs",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("error = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if abs(resp.CostPerMonth-tt.wantCost) > 1e-9 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
 // TestGetProjectedCost_CloudWatch_Metrics tests CloudWatch custom metrics cost estimation.
 func TestGetProjectedCost_CloudWatch_Metrics(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
@@ -4368,3 +6078,654 @@ func TestGetProjectedCost_IAM(t *testing.T) {
 		})
 	}
 }
+
+// TestGetProjectedCost_ECR verifies ECR storage and optional data transfer out estimation.
+func TestGetProjectedCost_ECR(t *testing.T) {
+	tests := []struct {
+		name         string
+		storagePrice float64
+		dtPrice      float64
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+	}{
+		{
+			name:         "storage only",
+			storagePrice: 0.10,
+			tags:         map[string]string{"storage_gb": "50"},
+			wantCost:     5.0,
+			wantDetail:   "ECR storage, 50.00 GB, $0.1000/GB-month",
+		},
+		{
+			name:         "storage defaulted",
+			storagePrice: 0.10,
+			wantCost:     0,
+			wantDetail:   "ECR storage, 0 GB (defaulted; set 'storage_gb' to estimate), $0.1000/GB-month",
+		},
+		{
+			name:         "with data transfer out",
+			storagePrice: 0.10,
+			dtPrice:      0.09,
+			tags:         map[string]string{"storage_gb": "50", "data_transfer_out_gb": "100"},
+			wantCost:     5.0 + 9.0,
+			wantDetail:   "ECR storage, 50.00 GB, $0.1000/GB-month + 100.00 GB data transfer out ($0.0900/GB)",
+		},
+		{
+			name:         "data transfer pricing missing",
+			storagePrice: 0.10,
+			tags:         map[string]string{"storage_gb": "50", "data_transfer_out_gb": "100"},
+			wantCost:     5.0,
+			wantDetail:   "ECR storage, 50.00 GB, $0.1000/GB-month (data transfer out excluded: pricing unavailable)",
+		},
+		{
+			name:       "pricing unavailable",
+			tags:       map[string]string{"storage_gb": "50"},
+			wantCost:   0,
+			wantDetail: "ECR pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ecrStoragePrice = tt.storagePrice
+			mock.ecrDataTransferPrice = tt.dtPrice
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:ecr/repository:Repository",
+					Sku:          "repository",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_SecretsManager verifies per-secret and API call itemization.
+func TestGetProjectedCost_SecretsManager(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:       "secret only",
+			priced:     true,
+			wantCost:   0.40,
+			wantDetail: "Secrets Manager, 1 secret ($0.4000/secret-month) + 0 API calls",
+		},
+		{
+			name:       "with API calls",
+			priced:     true,
+			tags:       map[string]string{"api_calls_per_month": "100000"},
+			wantCost:   0.40 + 0.50,
+			wantDetail: "Secrets Manager, 1 secret ($0.4000/secret-month) + 100000 API calls ($0.0500 per 10k)",
+		},
+		{
+			name:       "pricing unavailable",
+			wantCost:   0,
+			wantDetail: "Secrets Manager pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.secretPrice = 0.40
+				mock.secretAPICallPrice = 0.000005
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:secretsmanager/secret:Secret",
+					Sku:          "secret",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_KMS verifies per-key pricing, request billing and the opt-in
+// account-wide free tier.
+func TestGetProjectedCost_KMS(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:       "requests defaulted",
+			priced:     true,
+			wantCost:   1.0,
+			wantDetail: "KMS key, 1 key ($1.0000/key-month) + 0 requests (defaulted; set 'api_calls_per_month' to estimate)",
+		},
+		{
+			name:       "every request billed without free tier",
+			priced:     true,
+			tags:       map[string]string{"api_calls_per_month": "120000"},
+			wantCost:   1.0 + 0.36,
+			wantDetail: "KMS key, 1 key ($1.0000/key-month) + 120000 requests ($0.0300 per 10k)",
+		},
+		{
+			name:       "requests beyond free tier",
+			priced:     true,
+			tags:       map[string]string{"api_calls_per_month": "120000", "free_tier": "true"},
+			wantCost:   1.0 + 0.30,
+			wantDetail: "KMS key, 1 key ($1.0000/key-month) + 120000 requests (20000 free, 100000 billed at $0.0300 per 10k)",
+		},
+		{
+			name:       "requests within free tier",
+			priced:     true,
+			tags:       map[string]string{"api_calls_per_month": "15000", "free_tier": "true"},
+			wantCost:   1.0,
+			wantDetail: "KMS key, 1 key ($1.0000/key-month) + 15000 requests, within 20000 free",
+		},
+		{
+			name:       "pricing unavailable",
+			wantCost:   0,
+			wantDetail: "KMS pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.kmsKeyPrice = 1.0
+				mock.kmsRequestPrice = 0.000003
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:kms/key:Key",
+					Sku:          "key",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_WAF verifies web ACL, rule and request itemization and defaults.
+func TestGetProjectedCost_WAF(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:     "counts defaulted",
+			priced:   true,
+			wantCost: 5.0 + 1.0,
+			wantDetail: "WAF web ACL, 1 web ACL ($5.0000/web-ACL-month) + 1 rules (defaulted) ($1.0000/rule-month)" +
+				" + 0 requests (defaulted; set 'requests_per_month' to estimate)",
+		},
+		{
+			name:     "rules and requests",
+			priced:   true,
+			tags:     map[string]string{"rules": "10", "requests_per_month": "50000000"},
+			wantCost: 5.0 + 10.0 + 30.0,
+			wantDetail: "WAF web ACL, 1 web ACL ($5.0000/web-ACL-month) + 10 rules ($1.0000/rule-month)" +
+				" + 50000000 requests ($0.6000 per million)",
+		},
+		{
+			name:       "no rules",
+			priced:     true,
+			tags:       map[string]string{"rules": "0", "requests_per_month": "0"},
+			wantCost:   5.0,
+			wantDetail: "WAF web ACL, 1 web ACL ($5.0000/web-ACL-month) + 0 rules + 0 requests",
+		},
+		{
+			name:       "pricing unavailable",
+			wantCost:   0,
+			wantDetail: "WAF pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.wafWebACLPrice = 5.0
+				mock.wafRulePrice = 1.0
+				mock.wafRequestPrice = 0.0000006
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:wafv2/webAcl:WebAcl",
+					Sku:          "webacl",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_Athena verifies per-TB scanned pricing, the defaulted usage note and
+// the $0 response when pricing is missing.
+func TestGetProjectedCost_Athena(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:       "usage defaulted",
+			priced:     true,
+			wantCost:   0,
+			wantDetail: "Athena SQL queries, 0.00 TB scanned (defaulted; set 'data_scanned_tb' to estimate) ($5.00/TB)",
+		},
+		{
+			name:       "data scanned",
+			priced:     true,
+			tags:       map[string]string{"data_scanned_tb": "2.5"},
+			wantCost:   12.5,
+			wantDetail: "Athena SQL queries, 2.50 TB scanned ($5.00/TB)",
+		},
+		{
+			name:       "pricing unavailable",
+			tags:       map[string]string{"data_scanned_tb": "2.5"},
+			wantCost:   0,
+			wantDetail: "Athena pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.athenaTBPrice = 5.0
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:athena/workgroup:Workgroup",
+					Sku:          "workgroup",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_Glue verifies DPU-hour pricing per execution class, crawler
+// detection from the resource type, defaults and the $0 response when pricing is missing.
+func TestGetProjectedCost_Glue(t *testing.T) {
+	tests := []struct {
+		name         string
+		priced       bool
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+	}{
+		{
+			name:         "usage defaulted",
+			priced:       true,
+			resourceType: "aws:glue/job:Job",
+			sku:          "standard",
+			wantCost:     0,
+			wantDetail:   "Glue job, 0.00 DPU-hours (defaulted; set 'dpu_hours' to estimate) ($0.4400/DPU-hour)",
+		},
+		{
+			name:         "standard job",
+			priced:       true,
+			resourceType: "aws:glue/job:Job",
+			sku:          "STANDARD",
+			tags:         map[string]string{"dpu_hours": "100"},
+			wantCost:     44.0,
+			wantDetail:   "Glue job, 100.00 DPU-hours ($0.4400/DPU-hour)",
+		},
+		{
+			name:         "flex job",
+			priced:       true,
+			resourceType: "glue",
+			sku:          "flex",
+			tags:         map[string]string{"dpu_hours": "100"},
+			wantCost:     29.0,
+			wantDetail:   "Glue Flex job, 100.00 DPU-hours ($0.2900/DPU-hour)",
+		},
+		{
+			name:         "crawler",
+			priced:       true,
+			resourceType: "aws:glue/crawler:Crawler",
+			sku:          "standard",
+			tags:         map[string]string{"dpu_hours": "10"},
+			wantCost:     4.0,
+			wantDetail:   "Glue crawler, 10.00 DPU-hours ($0.4000/DPU-hour)",
+		},
+		{
+			name:         "unknown execution class",
+			priced:       true,
+			resourceType: "glue",
+			sku:          "turbo",
+			tags:         map[string]string{"dpu_hours": "10"},
+			wantCost:     4.4,
+			wantDetail:   "Glue job (unknown execution class \"turbo\", priced as standard), 10.00 DPU-hours ($0.4400/DPU-hour)",
+		},
+		{
+			name:         "pricing unavailable",
+			resourceType: "aws:glue/job:Job",
+			sku:          "standard",
+			tags:         map[string]string{"dpu_hours": "100"},
+			wantCost:     0,
+			wantDetail:   "Glue pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.glueDPUHourPrices["etl"] = 0.44
+				mock.glueDPUHourPrices["flex"] = 0.29
+				mock.glueDPUHourPrices["crawler"] = 0.40
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestEstimateGlue_ExecutionClassNotSet verifies a job without an execution class is
+// priced as standard and reported as defaulted rather than invalid.
+func TestEstimateGlue_ExecutionClassNotSet(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.glueDPUHourPrices["etl"] = 0.44
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	assumed := &assumptions{}
+	resp, err := plugin.estimateGlue("test-trace", &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: "aws:glue/job:Job",
+		Region:       "us-east-1",
+		Tags:         map[string]string{"dpu_hours": "10"},
+	}, assumed, nil)
+	if err != nil {
+		t.Fatalf("estimateGlue() returned error: %v", err)
+	}
+
+	if math.Abs(resp.CostPerMonth-4.4) > 0.0001 {
+		t.Errorf("CostPerMonth = %v, want 4.4", resp.CostPerMonth)
+	}
+	wantDetail := "Glue job (execution class defaulted to standard), 10.00 DPU-hours ($0.4400/DPU-hour)"
+	if resp.BillingDetail != wantDetail {
+		t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, wantDetail)
+	}
+	want := []Assumption{{Key: "sku", Value: "standard", Reason: assumptionNotSet}}
+	if !slices.Equal(assumed.items, want) {
+		t.Errorf("assumptions = %v, want %v", assumed.items, want)
+	}
+}
+
+// TestGetProjectedCost_SES verifies email and attachment pricing, the free tier
+// allowance, defaulted usage notes and the $0 response when pricing is missing.
+func TestGetProjectedCost_SES(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:     "usage defaulted",
+			priced:   true,
+			wantCost: 0,
+			wantDetail: "SES email, 0 emails (defaulted; set 'emails_per_month' to estimate) ($0.10 per 1,000)" +
+				" + 0 GB attachments (defaulted; set 'data_gb' to estimate)",
+		},
+		{
+			name:       "emails and attachments",
+			priced:     true,
+			tags:       map[string]string{"emails_per_month": "100000", "data_gb": "50"},
+			wantCost:   10.0 + 6.0,
+			wantDetail: "SES email, 100000 emails ($0.10 per 1,000) + 50.00 GB attachments ($0.12/GB)",
+		},
+		{
+			name:     "free tier",
+			priced:   true,
+			tags:     map[string]string{"emails_per_month": "100000", "data_gb": "50", "free_tier": "true"},
+			wantCost: 9.7 + 6.0,
+			wantDetail: "SES email, 100000 emails (3000 free, 97000 billed at $0.10 per 1,000)" +
+				" + 50.00 GB attachments ($0.12/GB)",
+		},
+		{
+			name:     "within free tier",
+			priced:   true,
+			tags:     map[string]string{"emails_per_month": "2000", "data_gb": "0", "free_tier": "true"},
+			wantCost: 0,
+			wantDetail: "SES email, 2000 emails (3000 free, 0 billed at $0.10 per 1,000)" +
+				" + 0 GB attachments",
+		},
+		{
+			name:       "pricing unavailable",
+			tags:       map[string]string{"emails_per_month": "100000"},
+			wantCost:   0,
+			wantDetail: "SES pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.sesEmailPrice = 0.0001
+				mock.sesAttachmentGBPrice = 0.12
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:sesv2/configurationSet:ConfigurationSet",
+					Sku:          "email",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_StepFunctions verifies Standard transition pricing, Express request
+// and duration pricing with AWS's rounding, type defaulting and the $0 response when
+// pricing is missing.
+func TestGetProjectedCost_StepFunctions(t *testing.T) {
+	tests := []struct {
+		name       string
+		priced     bool
+		sku        string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:       "standard usage defaulted",
+			priced:     true,
+			tags:       map[string]string{"type": "STANDARD"},
+			wantCost:   0,
+			wantDetail: "Step Functions Standard, 0 state transitions (defaulted; set 'state_transitions_per_month' to estimate) ($0.025 per 1,000)",
+		},
+		{
+			name:       "standard transitions",
+			priced:     true,
+			tags:       map[string]string{"type": "standard", "state_transitions_per_month": "1000000"},
+			wantCost:   25.0,
+			wantDetail: "Step Functions Standard, 1000000 state transitions ($0.025 per 1,000)",
+		},
+		{
+			name:       "unknown type",
+			priced:     true,
+			tags:       map[string]string{"type": "batch", "state_transitions_per_month": "1000"},
+			wantCost:   0.025,
+			wantDetail: "Step Functions Standard (unknown type \"batch\", priced as standard), 1000 state transitions ($0.025 per 1,000)",
+		},
+		{
+			name:       "type unset",
+			priced:     true,
+			tags:       map[string]string{"state_transitions_per_month": "1000"},
+			wantCost:   0.025,
+			wantDetail: "Step Functions Standard (type defaulted to standard), 1000 state transitions ($0.025 per 1,000)",
+		},
+		{
+			// 1M requests × $1/M + 1M × 64/1024 GB × 0.2 s × $0.00001667
+			name:   "express rounded",
+			priced: true,
+			tags: map[string]string{
+				"type": "EXPRESS", "requests_per_month": "1000000", "avg_duration_ms": "150", "memory_mb": "50",
+			},
+			wantCost:   1.0 + 12500*0.00001667,
+			wantDetail: "Step Functions Express, 1000000 requests/month, 200ms billed duration, 64MB billed memory, 12500 GB-seconds",
+		},
+		{
+			name:     "express defaults",
+			priced:   true,
+			sku:      "express",
+			wantCost: 0,
+			wantDetail: "Step Functions Express, 0 requests/month, 100ms billed duration, 64MB billed memory" +
+				" (requests defaulted, duration defaulted, memory defaulted), 0 GB-seconds",
+		},
+		{
+			name:       "pricing unavailable",
+			tags:       map[string]string{"type": "express", "requests_per_month": "1000"},
+			wantCost:   0,
+			wantDetail: "Step Functions pricing data not available for region us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			if tt.priced {
+				mock.sfnTransitionPrice = 0.000025
+				mock.sfnExpressRequest = 0.000001
+				mock.sfnExpressGBSecond = 0.00001667
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			sku := tt.sku
+			if sku == "" {
+				sku = "stateMachine"
+			}
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:sfn/stateMachine:StateMachine",
+					Sku:          sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.BillingDetail != tt.wantDetail {
+				t.Errorf("BillingDetail = %q, want %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// pricingSourceHeaderKey names the embedded AWS Price List offer and version used to
+	// price a response, e.g. "aws-price-list/AmazonEC2/20251218235654".
+	// GetProjectedCostResponse has no provenance fields, so it is sent as a response header.
+	pricingSourceHeaderKey = "finfocus-pricing-source"
+
+	// pricingDateHeaderKey carries the publication date of that embedded data.
+	pricingDateHeaderKey = "finfocus-pricing-date"
+
+	// pricingSourcePrefix identifies the public AWS Price List as the data origin.
+	pricingSourcePrefix = "aws-price-list"
+)
+
+// serviceOfferCodes maps routed service types to the AWS offer code of the
+// embedded file that prices them. Zero-cost resources have no entry.
+var serviceOfferCodes = map[string]string{
+	"ec2":            "AmazonEC2",
+	"ebs":            "AmazonEC2", // EBS volumes ship in the EC2 offer
+	"rds":            "AmazonRDS",
+	"eks":            "AmazonEKS",
+	"s3":             "AmazonS3",
+	"lambda":         "AWSLambda",
+	"dynamodb":       "AmazonDynamoDB",
+	"elb":            "AWSELB",
+	"natgw":          "AmazonVPC",
+	"cloudwatch":     "AmazonCloudWatch",
+	"elasticache":    "AmazonElastiCache",
+	"ecr":            "AmazonECR",
+	"secretsmanager": "AWSSecretsManager",
+	"kms":            "awskms",
+	"waf":            "AWSWAF",
+	"athena":         "AmazonAthena",
+	"glue":           "AWSGlue",
+	"ses":            "AmazonSES",
+	"stepfunctions":  "AmazonStates",
+}
+
+// pricingProvenance returns the pricing_source and pricing_date for a service type.
+// ok is false when the service is unpriced or its embedded data carried no metadata.
+func (p *AWSPublicPlugin) pricingProvenance(serviceType string) (source, date string, ok bool) {
+	offerCode, known := serviceOfferCodes[serviceType]
+	if !known {
+		return "", "", false
+	}
+	version, publicationDate, found := p.pricing.PricingMetadata(offerCode)
+	if !found {
+		return "", "", false
+	}
+	return fmt.Sprintf("%s/%s/%s", pricingSourcePrefix, offerCode, version), publicationDate, true
+}
+
+// setPricingProvenanceHeader stamps the pricing source and date of the service's embedded
+// data onto the response headers. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setPricingProvenanceHeader(ctx context.Context, traceID, serviceType string) {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	source, date, ok := p.pricingProvenance(serviceType)
+	if !ok {
+		return
+	}
+
+	md := metadata.Pairs(pricingSourceHeaderKey, source)
+	if date != "" {
+		md.Set(pricingDateHeaderKey, date)
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set pricing provenance header")
+	}
+}
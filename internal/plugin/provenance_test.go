@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_PricingProvenanceHeaders verifies the pricing source and date
+// headers reflect the embedded data of the service that priced the resource.
+func TestGetProjectedCost_PricingProvenanceHeaders(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		wantSource   string
+		wantDate     string
+	}{
+		{
+			name:         "ec2",
+			resourceType: "ec2",
+			sku:          "t3.micro",
+			wantSource:   "aws-price-list/AmazonEC2/20251218235654",
+			wantDate:     "2025-12-18T23:56:54Z",
+		},
+		{
+			name:         "ebs uses EC2 offer",
+			resourceType: "ebs",
+			sku:          "gp3",
+			wantSource:   "aws-price-list/AmazonEC2/20251218235654",
+			wantDate:     "2025-12-18T23:56:54Z",
+		},
+		{
+			name:         "service without metadata",
+			resourceType: "s3",
+			sku:          "STANDARD",
+		},
+		{
+			name:         "zero-cost resource",
+			resourceType: "vpc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			mock.ebsPrices["gp3"] = 0.08
+			mock.s3Prices["STANDARD"] = 0.023
+			mock.pricingVersions = map[string]string{"AmazonEC2": "20251218235654"}
+			mock.pricingDates = map[string]string{"AmazonEC2": "2025-12-18T23:56:54Z"}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			_, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			sources := stream.header.Get(pricingSourceHeaderKey)
+			dates := stream.header.Get(pricingDateHeaderKey)
+			if tt.wantSource == "" {
+				if len(sources) != 0 || len(dates) != 0 {
+					t.Errorf("provenance headers = %v / %v, want none", sources, dates)
+				}
+				return
+			}
+			if len(sources) != 1 || sources[0] != tt.wantSource {
+				t.Errorf("header %s = %v, want [%s]", pricingSourceHeaderKey, sources, tt.wantSource)
+			}
+			if len(dates) != 1 || dates[0] != tt.wantDate {
+				t.Errorf("header %s = %v, want [%s]", pricingDateHeaderKey, dates, tt.wantDate)
+			}
+		})
+	}
+}
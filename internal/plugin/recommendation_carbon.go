@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Recommendation metadata keys for the monthly carbon footprint (gCO2e) before and after
+	// the recommended change. RecommendationImpact has no impact metrics, so the carbon
+	// delta travels in metadata alongside the financial impact.
+	metaCarbonCurrent   = "carbon_current_gco2e"
+	metaCarbonProjected = "carbon_projected_gco2e"
+	metaCarbonSavings   = "carbon_savings_gco2e"
+
+	// carbonSavingsTrailerKey is the gRPC trailer key carrying the batch's total monthly
+	// carbon savings in gCO2e. RecommendationSummary has no impact metrics field.
+	carbonSavingsTrailerKey = "finfocus-total-carbon-savings-gco2e"
+)
+
+// recommendationCarbon returns the monthly carbon footprint of a recommendation's current
+// and proposed configurations. ok is false unless both are known; deleting a resource has
+// a known proposed footprint of zero.
+func (p *AWSPublicPlugin) recommendationCarbon(rec *pbc.Recommendation) (current, projected float64, ok bool) {
+	region := rec.GetResource().GetRegion()
+	resourceType := rec.GetResource().GetResourceType()
+
+	if rec.GetTerminate() != nil {
+		if resourceType != "ebs" {
+			return 0, 0, false
+		}
+		sizeGB, err := strconv.Atoi(rec.GetMetadata()["size_gb"])
+		if err != nil {
+			return 0, 0, false
+		}
+		current, ok = p.ebsCarbon(rec.GetResource().GetSku(), region, sizeGB)
+		return current, 0, ok
+	}
+
+	modify := rec.GetModify()
+	if modify == nil {
+		return 0, 0, false
+	}
+	currentCfg, recommendedCfg := modify.GetCurrentConfig(), modify.GetRecommendedConfig()
+
+	var currentOK, projectedOK bool
+	switch resourceType {
+	case "ec2":
+		current, currentOK = p.carbonEstimator.EstimateCarbonGrams(
+			currentCfg["instance_type"], region, p.baselineUtilization(), carbon.HoursPerMonth)
+		projected, projectedOK = p.carbonEstimator.EstimateCarbonGrams(
+			recommendedCfg["instance_type"], region, p.baselineUtilization(), carbon.HoursPerMonth)
+	case "rds":
+		// Storage is unchanged by instance class changes, so only compute is compared
+		current, currentOK = p.rdsComputeCarbon(currentCfg["instance_type"], region)
+		projected, projectedOK = p.rdsComputeCarbon(recommendedCfg["instance_type"], region)
+	case "ebs":
+		sizeGB, err := strconv.Atoi(currentCfg["size_gb"])
+		if err != nil {
+			return 0, 0, false
+		}
+		current, currentOK = p.ebsCarbon(currentCfg["volume_type"], region, sizeGB)
+		projected, projectedOK = p.ebsCarbon(recommendedCfg["volume_type"], region, sizeGB)
+	}
+	if !currentOK || !projectedOK {
+		return 0, 0, false
+	}
+	return current, projected, true
+}
+
+// ebsCarbon returns the monthly carbon footprint of an EBS volume.
+func (p *AWSPublicPlugin) ebsCarbon(volumeType, region string, sizeGB int) (float64, bool) {
+	return carbon.NewEBSEstimator().EstimateCarbonGrams(carbon.EBSVolumeConfig{
+		VolumeType: volumeType,
+		SizeGB:     float64(sizeGB),
+		Region:     region,
+		Hours:      carbon.HoursPerMonth,
+	})
+}
+
+// rdsComputeCarbon returns the monthly compute carbon footprint of a Single-AZ RDS instance.
+func (p *AWSPublicPlugin) rdsComputeCarbon(instanceType, region string) (float64, bool) {
+	return carbon.NewRDSEstimator().EstimateCarbonGrams(carbon.RDSInstanceConfig{
+		InstanceType: instanceType,
+		Region:       region,
+		Utilization:  p.baselineUtilization(),
+		Hours:        carbon.HoursPerMonth,
+	})
+}
+
+// annotateCarbonImpact records the carbon delta in each recommendation's metadata when
+// both configurations have known carbon. It returns the carbon savings for the resource,
+// taken from the largest dollar-savings alternative with known carbon so the rollup
+// matches addCostRollup; ok is false when no recommendation had known carbon.
+func (p *AWSPublicPlugin) annotateCarbonImpact(recs []*pbc.Recommendation) (savings float64, ok bool) {
+	bestDollars := 0.0
+	for _, rec := range recs {
+		current, projected, known := p.recommendationCarbon(rec)
+		if !known {
+			continue
+		}
+		if rec.Metadata == nil {
+			rec.Metadata = make(map[string]string)
+		}
+		rec.Metadata[metaCarbonCurrent] = strconv.FormatFloat(current, 'f', 2, 64)
+		rec.Metadata[metaCarbonProjected] = strconv.FormatFloat(projected, 'f', 2, 64)
+		rec.Metadata[metaCarbonSavings] = strconv.FormatFloat(current-projected, 'f', 2, 64)
+
+		if dollars := rec.GetImpact().GetEstimatedSavings(); !ok || dollars > bestDollars {
+			bestDollars = dollars
+			savings = current - projected
+			ok = true
+		}
+	}
+	return savings, ok
+}
+
+// setCarbonSavingsTrailer attaches the batch's total carbon savings to the gRPC response
+// trailer. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setCarbonSavingsTrailer(ctx context.Context, traceID string, total float64) {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	md := metadata.Pairs(carbonSavingsTrailerKey, strconv.FormatFloat(total, 'f', 2, 64))
+	if err := grpc.SetTrailer(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set carbon savings trailer")
+	}
+}
@@ -1,18 +1,23 @@
 package plugin
 
 import (
+	"cmp"
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
 
 	"github.com/google/uuid"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -21,14 +26,30 @@ const (
 	confidenceHigh = 0.9
 	// confidenceMedium is used for Graviton migrations (FR-007).
 	confidenceMedium = 0.7
+	// confidenceGravitonPortable is used for Graviton migrations of workloads tagged as ARM-portable.
+	confidenceGravitonPortable = 0.8
+	// confidenceLow is used for Graviton migrations of workloads that likely need recompilation.
+	confidenceLow = 0.4
 	// sourceAWSPublic identifies recommendations from this plugin.
 	sourceAWSPublic = "aws-public"
 	// modTypeGenUpgrade is the modification type for generation upgrades.
 	modTypeGenUpgrade = "generation_upgrade"
 	// modTypeGraviton is the modification type for Graviton migrations.
 	modTypeGraviton = "graviton_migration"
+	// modTypeGravitonSpot is the modification type for Graviton migrations run on Spot capacity.
+	modTypeGravitonSpot = "graviton_spot"
+	// spotDiscountFactor is the assumed Spot discount off the On-Demand rate. Actual Spot
+	// prices vary by pool and over time; 70% is a typical long-run average.
+	spotDiscountFactor = 0.70
 	// modTypeVolumeUpgrade is the modification type for EBS volume upgrades.
 	modTypeVolumeUpgrade = "volume_type_upgrade"
+	// modTypeIntelligentTiering is the modification type for S3 Intelligent-Tiering transitions.
+	modTypeIntelligentTiering = "intelligent_tiering"
+	// s3ITInfrequentShare is the assumed share of an unpredictably accessed bucket's data that
+	// goes unread for 30 days and moves to the Intelligent-Tiering Infrequent Access tier.
+	s3ITInfrequentShare = 0.5
+	// s3ITMinMonitoredObjectKB is the smallest object size Intelligent-Tiering monitors and charges for.
+	s3ITMinMonitoredObjectKB = 128
 	// defaultEBSVolumeGB is the default volume size when not specified in tags.
 	defaultEBSVolumeGB = 100
 	// defaultMaxBatchSize is the default maximum number of resources to process in GetRecommendations
@@ -47,6 +68,25 @@ const (
 	EnvStrictValidationDeprecated = "PULUMICOST_STRICT_VALIDATION"
 	// EnvStrictValidationLegacy is the legacy environment variable for additional backward compatibility
 	EnvStrictValidationLegacy = "STRICT_VALIDATION"
+	// batchWarningsTrailerKey is the gRPC trailer key carrying per-resource batch warnings.
+	// The RecommendationSummary message has no warnings field, so skipped resources are
+	// reported out-of-band: one JSON-encoded BatchWarning per trailer value.
+	batchWarningsTrailerKey = "finfocus-batch-warnings"
+	// totalCurrentCostTrailerKey and totalProjectedCostTrailerKey are the gRPC trailer keys
+	// carrying the batch's before/after monthly cost rollup. RecommendationSummary has
+	// only the savings total, so the two totals are sent out-of-band.
+	totalCurrentCostTrailerKey   = "finfocus-total-current-cost"
+	totalProjectedCostTrailerKey = "finfocus-total-projected-cost"
+	// EnvMinMonthlySavings is the environment variable for the plugin-wide minimum monthly savings
+	// a recommendation must offer to be returned. RecommendationFilter.MinEstimatedSavings overrides it.
+	EnvMinMonthlySavings = "FINFOCUS_MIN_MONTHLY_SAVINGS"
+	// EnvRecommendationWorkers is the environment variable for the number of batch resources
+	// GetRecommendations processes concurrently. The default of 1 processes them serially.
+	EnvRecommendationWorkers = "FINFOCUS_RECOMMENDATION_WORKERS"
+	// defaultRecommendationWorkers processes recommendation batches serially.
+	defaultRecommendationWorkers = 1
+	// maxRecommendationWorkers caps the recommendation worker pool.
+	maxRecommendationWorkers = 64
 )
 
 // Ensure AWSPublicPlugin implements RecommendationsProvider.
@@ -64,6 +104,70 @@ type BatchStats struct {
 	TotalResources   int
 	MatchedResources int
 	TotalSavings     float64
+	// TotalCurrentCost and TotalProjectedCost give the before/after picture across
+	// resources with recommendations. Alternatives for one resource are counted once,
+	// using the largest-savings option for the projected cost.
+	TotalCurrentCost    float64
+	TotalProjectedCost  float64
+	CostRollupResources int
+	// TotalCarbonSavings is the monthly gCO2e saved across resources whose recommendations
+	// have known carbon for both configurations, counted once per resource.
+	TotalCarbonSavings float64
+	CarbonResources    int
+	// SpotSavings is the monthly savings from running interruptible EC2 resources on Spot,
+	// taking each resource's best Spot option once.
+	SpotSavings   float64
+	SpotResources int
+	// CommittedComputeCost is the On-Demand monthly compute cost of the resources
+	// repriced at the committed coverage's blended rate, counted once per resource.
+	CommittedComputeCost      float64
+	CommittedComputeResources int
+	// RegionSkipped counts resources skipped because their region is not the plugin's;
+	// each also has a BatchWarning.
+	RegionSkipped int
+	Warnings      []BatchWarning
+}
+
+// addCostRollup adds one resource's current cost and best-case projected cost.
+// Recommendations for a resource are alternatives sharing the same current cost,
+// so summing them would double count. Cross-AZ transfer hints price a separate charge
+// and are skipped.
+func (s *BatchStats) addCostRollup(recs []*pbc.Recommendation) {
+	var current, bestSavings float64
+	found := false
+	for _, rec := range recs {
+		if rec.Impact == nil || rec.GetModify().GetModificationType() == modTypeCrossAZ {
+			continue
+		}
+		found = true
+		current = max(current, rec.Impact.GetCurrentCost())
+		bestSavings = max(bestSavings, rec.Impact.GetEstimatedSavings())
+	}
+	if !found {
+		return
+	}
+	s.TotalCurrentCost += current
+	s.TotalProjectedCost += max(current-bestSavings, 0)
+	s.CostRollupResources++
+}
+
+// BatchWarning explains why a resource in a GetRecommendations batch was skipped.
+// Index is the resource's position in the request's target_resources (0 in legacy mode).
+type BatchWarning struct {
+	Index        int    `json:"index"`
+	ResourceType string `json:"resource_type"`
+	Sku          string `json:"sku,omitempty"`
+	Reason       string `json:"reason"`
+}
+
+// addWarning records a skipped resource so partial failures are visible to the caller.
+func (s *BatchStats) addWarning(index int, resource *pbc.ResourceDescriptor, reason string) {
+	s.Warnings = append(s.Warnings, BatchWarning{
+		Index:        index,
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Reason:       reason,
+	})
 }
 
 // GetRecommendations generates cost optimization recommendations for the requested resources.
@@ -71,8 +175,13 @@ type BatchStats struct {
 // For each matching resource, it populates correlation info (Id and Name) in the recommendation
 // object by extracting the "resource_id" and "name" tags from the input ResourceDescriptor.
 // This allows the caller to correlate recommendations back to their infrastructure definitions.
-func (p *AWSPublicPlugin) GetRecommendations(ctx context.Context, req *pbc.GetRecommendationsRequest) (*pbc.GetRecommendationsResponse, error) {
-	start := time.Now()
+func (p *AWSPublicPlugin) GetRecommendations(
+	ctx context.Context, req *pbc.GetRecommendationsRequest,
+) (_ *pbc.GetRecommendationsResponse, err error) {
+	start := p.clock.Now()
+	defer func() {
+		p.metrics.observe("GetRecommendations", metricsResourceBatch, p.since(start), err)
+	}()
 	traceID := p.getTraceID(ctx)
 
 	// FR-009: Return ERROR_CODE_INVALID_RESOURCE when request is nil
@@ -83,7 +192,7 @@ func (p *AWSPublicPlugin) GetRecommendations(ctx context.Context, req *pbc.GetRe
 		return nil, err
 	}
 
-	// Validate batch size (max 100 resources per request)
+	// Validate batch size (default 100, FINFOCUS_MAX_BATCH_SIZE up to maxMaxBatchSize)
 	if len(req.TargetResources) > p.maxBatchSize {
 		err := p.newErrorWithID(traceID, codes.InvalidArgument,
 			fmt.Sprintf("batch size %d exceeds maximum of %d", len(req.TargetResources), p.maxBatchSize),
@@ -95,110 +204,44 @@ func (p *AWSPublicPlugin) GetRecommendations(ctx context.Context, req *pbc.GetRe
 	// Normalize input into ProcessingContext (T006)
 	pctx := p.normalizeInput(req)
 
-	// Generate recommendations by iterating over scope (T007)
-	var recommendations []*pbc.Recommendation
-	var skippedCount int
-	for _, resource := range pctx.Scope {
-		// Provider check: only process AWS resources (T011)
-		if resource.Provider != "" && resource.Provider != providerAWS {
-			skippedCount++
-			p.logger.Debug().
-				Str("trace_id", traceID).
-				Str("provider", resource.Provider).
-				Str("resource_type", resource.ResourceType).
-				Str("reason", "non-AWS provider").
-				Msg("skipping resource in recommendations batch")
-			if p.strictValidation {
-				err := p.newErrorWithID(traceID, codes.InvalidArgument,
-					fmt.Sprintf("strict validation: unsupported provider %q (only %q supported)",
-						resource.Provider, providerAWS),
-					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
-				return nil, err
-			}
-			continue
-		}
+	// Minimum savings threshold: request filter overrides the plugin-level default
+	minSavings := p.minMonthlySavings
+	if pctx.Filter != nil && pctx.Filter.MinEstimatedSavings > 0 {
+		minSavings = pctx.Filter.MinEstimatedSavings
+	}
 
-		// Apply filter criteria using AND logic (T010)
-		if !p.matchesFilter(resource, pctx.Filter) {
-			skippedCount++
-			p.logger.Debug().
-				Str("trace_id", traceID).
-				Str("resource_type", resource.ResourceType).
-				Str("sku", resource.Sku).
-				Str("reason", "filter mismatch").
-				Msg("skipping resource in recommendations batch")
-			continue
-		}
+	// Account-level commitment coverage from request metadata (default: all On-Demand)
+	commitment, commitmentWarnings := commitmentFromContext(ctx)
+	for _, warning := range commitmentWarnings {
+		p.traceLogger(traceID, "GetRecommendations").Warn().Msg(warning)
+	}
 
-		pctx.BatchStats.MatchedResources++
+	// Generate recommendations for each resource in scope (T007), concurrently when
+	// recommendationWorkers > 1, then merge the results in request order
+	results := p.processRecommendationScope(traceID, pctx.Scope, pctx.Filter, minSavings, commitment)
 
-		// Determine region (default to plugin's region if not specified)
-		region := resource.Region
-		if region == "" {
-			region = p.region
+	var recommendations []*pbc.Recommendation
+	var skippedCount, suppressedCount int
+	for i, result := range results {
+		if result.err != nil {
+			return nil, result.err
 		}
-
-		// Generate recommendations based on resource type.
-		// Use serviceResolver to cache normalized type (optimization: compute once per resource)
-		resolver := newServiceResolver(resource.ResourceType)
-		service := resolver.ServiceType()
-		var recs []*pbc.Recommendation
-
-		switch service {
-		case "ec2":
-			recs = p.generateEC2Recommendations(resource.Sku, region)
-		case "ebs":
-			recs = p.getEBSRecommendations(resource.Sku, region, resource.Tags)
-		case "rds":
-			engine := extractRDSEngine(resource.Tags)
-			recs = p.generateRDSRecommendations(resource.Sku, engine, region)
-		default:
-			// Log unsupported service types at debug level
-			p.logger.Debug().
-				Str("trace_id", traceID).
-				Str("resource_type", resource.ResourceType).
-				Str("detected_service", service).
-				Str("reason", "unsupported service for recommendations").
-				Msg("no recommendations generated for resource")
-			if p.strictValidation {
-				err := p.newErrorWithID(traceID, codes.InvalidArgument,
-					fmt.Sprintf("strict validation: service %q does not support recommendations (resource_type: %s)",
-						service, resource.ResourceType),
-					pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
-				return nil, err
-			}
+		if result.warning != "" {
+			pctx.BatchStats.addWarning(i, pctx.Scope[i], result.warning)
 		}
+		if result.matched {
+			pctx.BatchStats.MatchedResources++
+		}
+		if result.regionMismatch {
+			pctx.BatchStats.RegionSkipped++
+		}
+		if result.skipped {
+			skippedCount++
+			continue
+		}
+		suppressedCount += result.suppressed
 
-		// Populate correlation info: Native Id takes priority over tag (FR-001, FR-002, FR-003)
-		for _, rec := range recs {
-			if rec.Resource != nil {
-				// Priority 1: Use native Id field from ResourceDescriptor (FR-001, FR-002)
-				if id := strings.TrimSpace(resource.Id); id != "" {
-					rec.Resource.Id = id
-					p.logger.Trace().
-						Str(pluginsdk.FieldTraceID, traceID).
-						Str("id_source", "native").
-						Str("id", id).
-						Msg("using native ID for recommendation correlation")
-				} else if resourceID := resource.Tags["resource_id"]; resourceID != "" {
-					// Priority 2: Fall back to resource_id tag for backward compat (FR-003)
-					rec.Resource.Id = resourceID
-					p.logger.Trace().
-						Str(pluginsdk.FieldTraceID, traceID).
-						Str("id_source", "tag").
-						Str("id", resourceID).
-						Msg("using tag ID for recommendation correlation")
-				}
-				// Use name tag if available (FR-004 - unchanged)
-				if name := resource.Tags["name"]; name != "" {
-					rec.Resource.Name = name
-				}
-			} else { // Handle missing resource impact logging (rec.Resource is nil here)
-				p.logger.Warn().
-					Str("recommendation_id", rec.Id).
-					Msg("recommendation missing resource data")
-			}
-
+		for _, rec := range result.recs {
 			if rec.Impact != nil {
 				pctx.BatchStats.TotalSavings += rec.Impact.GetEstimatedSavings()
 			} else {
@@ -213,8 +256,30 @@ func (p *AWSPublicPlugin) GetRecommendations(ctx context.Context, req *pbc.GetRe
 			}
 		}
 
-		recommendations = append(recommendations, recs...)
+		pctx.BatchStats.addCostRollup(result.recs)
+		if result.carbonOK {
+			pctx.BatchStats.TotalCarbonSavings += result.carbonSavings
+			pctx.BatchStats.CarbonResources++
+		}
+		if spotSavings, ok := spotOpportunity(result.recs); ok {
+			pctx.BatchStats.SpotSavings += spotSavings
+			pctx.BatchStats.SpotResources++
+		}
+		if result.committedOK {
+			pctx.BatchStats.CommittedComputeCost += result.committedOnDemand
+			pctx.BatchStats.CommittedComputeResources++
+		}
+		recommendations = append(recommendations, result.recs...)
+	}
+
+	// Rank so the biggest wins come first (sort_by/sort_order from the filter, if set)
+	var sortBy pbc.RecommendationSortBy
+	var sortOrder pbc.SortOrder
+	if pctx.Filter != nil {
+		sortBy = pctx.Filter.SortBy
+		sortOrder = pctx.Filter.SortOrder
 	}
+	rankRecommendations(recommendations, sortBy, sortOrder)
 
 	// FR-010: Summary logging (one line per batch, not per resource)
 	p.traceLogger(traceID, "GetRecommendations").Info().
@@ -222,16 +287,417 @@ func (p *AWSPublicPlugin) GetRecommendations(ctx context.Context, req *pbc.GetRe
 		Int("matched_resources", pctx.BatchStats.MatchedResources).
 		Int("recommendation_count", len(recommendations)).
 		Int("skipped_resources", skippedCount).
+		Int("region_skipped_resources", pctx.BatchStats.RegionSkipped).
+		Int("suppressed_recommendations", suppressedCount).
+		Int("warning_count", len(pctx.BatchStats.Warnings)).
+		Float64("min_monthly_savings", minSavings).
 		Float64("total_savings", pctx.BatchStats.TotalSavings).
-		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+		Float64("total_current_cost", pctx.BatchStats.TotalCurrentCost).
+		Float64("total_projected_cost", pctx.BatchStats.TotalProjectedCost).
+		Float64("total_carbon_savings_gco2e", pctx.BatchStats.TotalCarbonSavings).
+		Float64("spot_opportunity_savings", pctx.BatchStats.SpotSavings).
+		Int("spot_opportunity_resources", pctx.BatchStats.SpotResources).
+		Float64("committed_coverage", commitment.Coverage).
+		Float64("committed_blended_rate", commitment.blendedRate()).
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 		Msg("batch recommendations generated")
 
+	p.setBatchWarningsTrailer(ctx, traceID, pctx.BatchStats.Warnings)
+	if pctx.BatchStats.CostRollupResources > 0 {
+		p.setCostRollupTrailer(ctx, traceID, pctx.BatchStats.TotalCurrentCost, pctx.BatchStats.TotalProjectedCost)
+	}
+	if pctx.BatchStats.CarbonResources > 0 {
+		p.setCarbonSavingsTrailer(ctx, traceID, pctx.BatchStats.TotalCarbonSavings)
+	}
+	if pctx.BatchStats.SpotResources > 0 {
+		p.setSpotOpportunityTrailer(ctx, traceID, pctx.BatchStats.SpotSavings)
+	}
+	if commitment.active() {
+		p.setCommitmentTrailer(ctx, traceID, commitment,
+			pctx.BatchStats.CommittedComputeCost, pctx.BatchStats.CommittedComputeResources)
+	}
+
 	return &pbc.GetRecommendationsResponse{
 		Recommendations: recommendations,
 		Summary:         pluginsdk.CalculateRecommendationSummary(recommendations, "monthly"),
 	}, nil
 }
 
+// resourceRecommendations is the outcome of processing one resource of a
+// GetRecommendations batch. Workers fill these independently and GetRecommendations
+// merges them into BatchStats in request order, so no state is shared between workers.
+type resourceRecommendations struct {
+	recs    []*pbc.Recommendation
+	matched bool // resource passed the provider and filter checks
+	skipped bool // resource was filtered out or could not be processed
+	// regionMismatch marks a resource skipped because its region is not the plugin's
+	regionMismatch bool
+	warning        string // batch warning for the resource, "" for none
+	suppressed     int    // recommendations dropped by the minimum savings threshold
+	carbonSavings  float64
+	carbonOK       bool // carbonSavings is known for the resource
+	// committedOnDemand is the resource's On-Demand compute cost before blending;
+	// committedOK is false when the commitment repriced none of its recommendations.
+	committedOnDemand float64
+	committedOK       bool
+	err               error // strict validation failure; fails the whole batch
+}
+
+// processRecommendationScope processes every resource in scope with up to
+// recommendationWorkers goroutines. Results are indexed like scope regardless of
+// completion order, keeping the response deterministic.
+func (p *AWSPublicPlugin) processRecommendationScope(
+	traceID string, scope []*pbc.ResourceDescriptor, filter *pbc.RecommendationFilter, minSavings float64,
+	commitment commitmentCoverage,
+) []resourceRecommendations {
+	results := make([]resourceRecommendations, len(scope))
+
+	workers := min(p.recommendationWorkers, len(scope))
+	if workers <= 1 {
+		for i, resource := range scope {
+			results[i] = p.recommendForResource(traceID, resource, filter, minSavings, commitment)
+			// Strict validation fails the batch, so later resources need no work
+			if results[i].err != nil {
+				return results[:i+1]
+			}
+		}
+		return results
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = p.recommendForResource(traceID, scope[i], filter, minSavings, commitment)
+			}
+		}()
+	}
+	for i := range scope {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// recommendForResource generates the recommendations for one batch resource: provider
+// and filter checks, the per-service generators, committed coverage, the minimum savings
+// threshold, correlation info, carbon impact and display currency. It is safe to call
+// concurrently.
+func (p *AWSPublicPlugin) recommendForResource(
+	traceID string, resource *pbc.ResourceDescriptor, filter *pbc.RecommendationFilter, minSavings float64,
+	commitment commitmentCoverage,
+) resourceRecommendations {
+	var result resourceRecommendations
+
+	// Provider check: only process AWS resources (T011)
+	if resource.Provider != "" && resource.Provider != providerAWS {
+		p.logger.Debug().
+			Str("trace_id", traceID).
+			Str("provider", resource.Provider).
+			Str("resource_type", resource.ResourceType).
+			Str("reason", "non-AWS provider").
+			Msg("skipping resource in recommendations batch")
+		if p.features.StrictValidation {
+			result.err = p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("strict validation: unsupported provider %q (only %q supported)",
+					resource.Provider, providerAWS),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+			return result
+		}
+		result.skipped = true
+		result.warning = fmt.Sprintf("unsupported provider %q (only %q supported)", resource.Provider, providerAWS)
+		return result
+	}
+
+	// Apply filter criteria using AND logic (T010)
+	if !p.matchesFilter(resource, filter) {
+		p.logger.Debug().
+			Str("trace_id", traceID).
+			Str("resource_type", resource.ResourceType).
+			Str("sku", resource.Sku).
+			Str("reason", "filter mismatch").
+			Msg("skipping resource in recommendations batch")
+		result.skipped = true
+		return result
+	}
+	result.matched = true
+
+	// Determine region (default to plugin's region if not specified)
+	region := resource.Region
+	if region == "" {
+		region = p.region
+	}
+
+	// Recommendations are priced from this binary's embedded region data, so a resource
+	// in another region is skipped rather than mispriced (fallback builds may answer for it)
+	if region != p.region && !p.usesRegionFallback(region) {
+		p.logger.Debug().
+			Str("trace_id", traceID).
+			Str("resource_type", resource.ResourceType).
+			Str("resource_region", region).
+			Str("plugin_region", p.region).
+			Str("reason", "region mismatch").
+			Msg("skipping resource in recommendations batch")
+		if p.features.StrictValidation {
+			result.err = p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("strict validation: resource region %q differs from plugin region %q", region, p.region),
+				pbc.ErrorCode_ERROR_CODE_UNSUPPORTED_REGION)
+			return result
+		}
+		result.skipped = true
+		result.regionMismatch = true
+		result.warning = fmt.Sprintf("region %q differs from plugin region %q; use the %s plugin binary", region, p.region, region)
+		return result
+	}
+
+	// Generate recommendations based on resource type.
+	// Use serviceResolver to cache normalized type (optimization: compute once per resource)
+	resolver := newServiceResolver(resource.ResourceType)
+	service := resolver.ServiceType()
+	var recs []*pbc.Recommendation
+
+	switch service {
+	case "ec2":
+		recs = p.generateEC2Recommendations(resource.Sku, region)
+		// Spot is only suggested for resources explicitly tagged interruptible
+		if parseBoolVal(resource.Tags["interruptible"]) {
+			if rec := p.getSpotRecommendation(resource.Sku, region); rec != nil {
+				recs = append(recs, rec)
+			}
+			if rec := p.getGravitonSpotRecommendation(resource.Sku, region); rec != nil {
+				recs = append(recs, rec)
+			}
+		}
+		recs = p.applyGravitonWorkload(traceID, recs, resource.Tags["workload"])
+		p.annotateInstanceCapabilities(recs)
+	case "ebs":
+		if reason := invalidEBSSizeTag(resource.Tags); reason != "" {
+			p.logger.Debug().
+				Str("trace_id", traceID).
+				Str("resource_type", resource.ResourceType).
+				Str("reason", reason).
+				Msg("skipping resource in recommendations batch")
+			result.skipped = true
+			result.warning = reason
+			return result
+		}
+		recs = p.getEBSRecommendations(resource.Sku, region, resource.Tags)
+	case "s3":
+		recs = p.getS3Recommendations(traceID, resource.Sku, region, resource.Tags)
+	case "rds":
+		engine := extractRDSEngine(resource.Tags)
+		recs = p.generateRDSRecommendations(resource.Sku, engine, region)
+	default:
+		// Log unsupported service types at debug level
+		p.logger.Debug().
+			Str("trace_id", traceID).
+			Str("resource_type", resource.ResourceType).
+			Str("detected_service", service).
+			Str("reason", "unsupported service for recommendations").
+			Msg("no recommendations generated for resource")
+		if p.features.StrictValidation {
+			result.err = p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("strict validation: service %q does not support recommendations (resource_type: %s)",
+					service, resource.ResourceType),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+			return result
+		}
+		result.warning = fmt.Sprintf("service %q does not support recommendations", service)
+	}
+
+	if rec := p.getCrossAZRecommendation(traceID, resource, service, region); rec != nil {
+		recs = append(recs, rec)
+	}
+
+	// Reprice compute at the blended committed rate, so the threshold sees real savings
+	result.committedOnDemand, result.committedOK = commitment.apply(recs)
+
+	// Drop low-value recommendations before they reach savings aggregation
+	if minSavings > 0 {
+		recs, result.suppressed = filterByMinSavings(recs, minSavings)
+	}
+
+	// Populate correlation info: Native Id takes priority over tag (FR-001, FR-002, FR-003)
+	for _, rec := range recs {
+		if rec.Resource != nil {
+			// Priority 1: Use native Id field from ResourceDescriptor (FR-001, FR-002)
+			if id := strings.TrimSpace(resource.Id); id != "" {
+				rec.Resource.Id = id
+				p.logger.Trace().
+					Str(pluginsdk.FieldTraceID, traceID).
+					Str("id_source", "native").
+					Str("id", id).
+					Msg("using native ID for recommendation correlation")
+			} else if resourceID := resource.Tags["resource_id"]; resourceID != "" {
+				// Priority 2: Fall back to resource_id tag for backward compat (FR-003)
+				rec.Resource.Id = resourceID
+				p.logger.Trace().
+					Str(pluginsdk.FieldTraceID, traceID).
+					Str("id_source", "tag").
+					Str("id", resourceID).
+					Msg("using tag ID for recommendation correlation")
+			}
+			// Use name tag if available (FR-004 - unchanged)
+			if name := resource.Tags["name"]; name != "" {
+				rec.Resource.Name = name
+			}
+		} else { // Handle missing resource impact logging (rec.Resource is nil here)
+			p.logger.Warn().
+				Str("recommendation_id", rec.Id).
+				Msg("recommendation missing resource data")
+		}
+	}
+
+	result.carbonSavings, result.carbonOK = p.annotateCarbonImpact(recs)
+
+	// Stamp each item with the resource's display currency (currency tag, else the batch
+	// default); unsupported codes fall back to the pricing currency with a batch warning
+	code, currencyWarning := resolveCurrency(resource.Tags)
+	stampCurrency(recs, code)
+	if currencyWarning != "" {
+		if result.warning != "" {
+			result.warning += "; "
+		}
+		result.warning += currencyWarning
+	}
+
+	result.recs = recs
+	return result
+}
+
+// setBatchWarningsTrailer attaches batch warnings to the gRPC response trailer.
+// Outside a gRPC server stream (e.g. direct calls in tests) there is no trailer to set,
+// so the warnings are only logged.
+func (p *AWSPublicPlugin) setBatchWarningsTrailer(ctx context.Context, traceID string, warnings []BatchWarning) {
+	if len(warnings) == 0 {
+		return
+	}
+
+	values := make([]string, 0, len(warnings))
+	for _, w := range warnings {
+		encoded, err := json.Marshal(w)
+		if err != nil {
+			continue
+		}
+		values = append(values, string(encoded))
+	}
+
+	p.logger.Warn().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Strs("batch_warnings", values).
+		Msg("some resources in recommendations batch were skipped")
+
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(appendKeyValues(batchWarningsTrailerKey, values)...)); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set batch warnings trailer")
+	}
+}
+
+// setCostRollupTrailer attaches the batch's total current and projected monthly cost to
+// the gRPC response trailer. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setCostRollupTrailer(ctx context.Context, traceID string, current, projected float64) {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	md := metadata.Pairs(
+		totalCurrentCostTrailerKey, strconv.FormatFloat(current, 'f', 2, 64),
+		totalProjectedCostTrailerKey, strconv.FormatFloat(projected, 'f', 2, 64),
+	)
+	if err := grpc.SetTrailer(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set cost rollup trailer")
+	}
+}
+
+// appendKeyValues expands key and values into the alternating form expected by metadata.Pairs.
+func appendKeyValues(key string, values []string) []string {
+	kv := make([]string, 0, len(values)*2)
+	for _, v := range values {
+		kv = append(kv, key, v)
+	}
+	return kv
+}
+
+// invalidEBSSizeTag returns a skip reason when the EBS size tag is present but not a
+// positive integer. Missing size tags are not an error (the default size applies).
+func invalidEBSSizeTag(tags map[string]string) string {
+	for _, key := range []string{"size", "volume_size"} {
+		sizeStr, ok := tags[key]
+		if !ok {
+			continue
+		}
+		if parsed, err := strconv.Atoi(sizeStr); err != nil || parsed <= 0 {
+			return fmt.Sprintf("invalid %s tag %q: must be a positive integer (GB)", key, sizeStr)
+		}
+		return ""
+	}
+	return ""
+}
+
+// rankRecommendations sorts recommendations in place.
+//
+// The primary key is chosen by sortBy: estimated savings (default), confidence, or
+// priority. Descending order is used unless sortOrder is SORT_ORDER_ASC.
+// Ties are broken by savings (desc), confidence (desc), resource ID, and
+// modification type so that output is deterministic for equal values.
+func rankRecommendations(recs []*pbc.Recommendation, sortBy pbc.RecommendationSortBy, sortOrder pbc.SortOrder) {
+	primary := func(rec *pbc.Recommendation) float64 {
+		switch sortBy {
+		case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_CONFIDENCE:
+			return rec.GetConfidenceScore()
+		case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_PRIORITY:
+			return float64(rec.GetPriority())
+		default:
+			return rec.GetImpact().GetEstimatedSavings()
+		}
+	}
+
+	slices.SortStableFunc(recs, func(a, b *pbc.Recommendation) int {
+		if c := cmp.Compare(primary(a), primary(b)); c != 0 {
+			if sortOrder == pbc.SortOrder_SORT_ORDER_ASC {
+				return c
+			}
+			return -c
+		}
+		if c := cmp.Compare(b.GetImpact().GetEstimatedSavings(), a.GetImpact().GetEstimatedSavings()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.GetConfidenceScore(), a.GetConfidenceScore()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.GetResource().GetId(), b.GetResource().GetId()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GetModify().GetModificationType(), b.GetModify().GetModificationType())
+	})
+}
+
+// filterByMinSavings removes recommendations whose estimated monthly savings fall below
+// threshold. Recommendations without impact data cannot prove their savings and are removed.
+// Returns the retained recommendations and the number suppressed.
+func filterByMinSavings(recs []*pbc.Recommendation, threshold float64) ([]*pbc.Recommendation, int) {
+	kept := recs[:0]
+	for _, rec := range recs {
+		if rec.GetImpact() == nil || rec.GetImpact().GetEstimatedSavings() < threshold {
+			continue
+		}
+		kept = append(kept, rec)
+	}
+	return kept, len(recs) - len(kept)
+}
+
 // generateEC2Recommendations creates recommendations for an EC2 instance.
 // Returns up to 2 recommendations: generation upgrade and/or Graviton migration.
 func (p *AWSPublicPlugin) generateEC2Recommendations(
@@ -422,18 +888,169 @@ func (p *AWSPublicPlugin) getGravitonRecommendation(
 	}
 }
 
+// getGravitonSpotRecommendation returns a recommendation to run an interruptible workload
+// on Graviton Spot capacity, combining the ARM price difference with spotDiscountFactor.
+// Callers must only request it for resources tagged interruptible; the Spot price is an
+// assumption, so confidence is low and the caveats are spelled out in the reasoning.
+func (p *AWSPublicPlugin) getGravitonSpotRecommendation(
+	instanceType, region string,
+) *pbc.Recommendation {
+	family, size := parseInstanceType(instanceType)
+	if family == "" {
+		return nil
+	}
+
+	gravitonFamily, exists := gravitonMap[family]
+	if !exists {
+		return nil
+	}
+
+	gravitonType := gravitonFamily + "." + size
+
+	currentPrice, found := p.pricing.EC2OnDemandPricePerHour(instanceType, "Linux", "Shared")
+	if !found {
+		return nil
+	}
+
+	gravitonPrice, found := p.pricing.EC2OnDemandPricePerHour(gravitonType, "Linux", "Shared")
+	if !found {
+		return nil
+	}
+
+	currentMonthly := currentPrice * carbon.HoursPerMonth
+	spotMonthly := gravitonPrice * (1 - spotDiscountFactor) * carbon.HoursPerMonth
+	savings := currentMonthly - spotMonthly
+	if savings <= 0 {
+		return nil
+	}
+	savingsPercent := 0.0
+	if currentMonthly > 0 {
+		savingsPercent = (savings / currentMonthly) * 100
+	}
+
+	confidence := confidenceLow
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: "ec2",
+			Region:       region,
+			Sku:          instanceType,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType: modTypeGravitonSpot,
+				CurrentConfig: map[string]string{
+					"instance_type": instanceType, "architecture": "x86_64", "purchase_option": "on-demand",
+				},
+				RecommendedConfig: map[string]string{
+					"instance_type": gravitonType, "architecture": "arm64", "purchase_option": "spot",
+				},
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  savings,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     spotMonthly,
+			SavingsPercentage: savingsPercent,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW,
+		ConfidenceScore: &confidence,
+		Description: fmt.Sprintf("Run interruptible workload on %s Spot (Graviton) for ~%.0f%% cost savings",
+			gravitonType, savingsPercent),
+		Reasoning: []string{
+			fmt.Sprintf("Assumes a %.0f%% Spot discount off the %s On-Demand rate; actual Spot prices vary",
+				spotDiscountFactor*100, gravitonType),
+			"Spot instances can be interrupted with a two-minute notice when AWS reclaims capacity",
+			"Only suitable for fault-tolerant, stateless or checkpointed workloads",
+			"Requires validation that application supports ARM architecture",
+		},
+		Metadata: map[string]string{
+			"architecture_change":    "x86_64 -> arm64",
+			"purchase_option_change": "on-demand -> spot",
+			"assumed_spot_discount":  fmt.Sprintf("%.2f", spotDiscountFactor),
+			"requires_validation":    "Application must support ARM architecture and tolerate interruption",
+		},
+		Source: sourceAWSPublic,
+	}
+}
+
+// applyGravitonWorkload adjusts Graviton recommendations using the resource's "workload" tag.
+// Incompatible workloads (e.g. native-x86) drop the Graviton and Graviton Spot
+// recommendations entirely; limited workloads lower the Graviton confidence and portable
+// runtimes raise it. The reason is recorded in metadata. Unknown or empty workloads leave
+// recommendations unchanged.
+func (p *AWSPublicPlugin) applyGravitonWorkload(
+	traceID string,
+	recs []*pbc.Recommendation,
+	workload string,
+) []*pbc.Recommendation {
+	workload = strings.ToLower(strings.TrimSpace(workload))
+	info, known := gravitonWorkloads[workload]
+	if !known {
+		return recs
+	}
+
+	kept := recs[:0]
+	for _, rec := range recs {
+		modType := rec.GetModify().GetModificationType()
+		if modType != modTypeGraviton && modType != modTypeGravitonSpot {
+			kept = append(kept, rec)
+			continue
+		}
+
+		if info.compatibility == workloadIncompatible {
+			p.logger.Debug().
+				Str(pluginsdk.FieldTraceID, traceID).
+				Str("resource_sku", rec.GetResource().GetSku()).
+				Str("workload", workload).
+				Str("reason", info.reason).
+				Msg("suppressing Graviton recommendation for incompatible workload")
+			continue
+		}
+
+		// Spot recommendations stay at low confidence regardless of the workload
+		if modType == modTypeGraviton {
+			confidence := confidenceGravitonPortable
+			if info.compatibility == workloadLimited {
+				confidence = confidenceLow
+			}
+			rec.ConfidenceScore = &confidence
+		}
+		if rec.Metadata == nil {
+			rec.Metadata = make(map[string]string)
+		}
+		rec.Metadata["workload"] = workload
+		rec.Metadata["workload_compatibility"] = string(info.compatibility)
+		rec.Metadata["workload_note"] = info.reason
+		rec.Reasoning = append(rec.Reasoning, info.reason)
+		kept = append(kept, rec)
+	}
+	return kept
+}
+
+const (
+	// ebsMigrationSnapshotMonths is how long the rollback snapshot taken before a volume
+	// type migration is assumed to be kept.
+	ebsMigrationSnapshotMonths = 1.0
+
+	// ebsMigrationRestoreHours is how long the restored volume is assumed to be billed
+	// alongside the original while it is hydrated from the snapshot and verified.
+	ebsMigrationRestoreHours = 24.0
+)
+
 // getEBSRecommendations returns recommendations for EBS volume optimization.
-// Currently supports gp2 to gp3 migration.
+// Currently supports gp2 to gp3 migration. st1 and sc1 are never proposed as targets:
+// their 125GB minimum (ebsMinimumSizeGB) makes them a poor fit for small volumes.
 // Implements FR-004, FR-006 from spec.md.
 func (p *AWSPublicPlugin) getEBSRecommendations(
 	volumeType, region string,
 	tags map[string]string,
 ) []*pbc.Recommendation {
-	// Only recommend for gp2 volumes
-	if volumeType != "gp2" {
-		return nil
-	}
-
 	// Extract size from tags, default to defaultEBSVolumeGB per edge case spec
 	sizeGB := defaultEBSVolumeGB
 	if sizeStr, ok := tags["size"]; ok {
@@ -445,6 +1062,22 @@ func (p *AWSPublicPlugin) getEBSRecommendations(
 			sizeGB = parsed
 		}
 	}
+	// Volumes below the st1/sc1 minimum are billed, and so saved on deletion, at the minimum
+	if minGB, ok := ebsMinimumSizeGB[volumeType]; ok {
+		sizeGB = max(sizeGB, minGB)
+	}
+
+	// Deleting an unattached volume supersedes any volume type change
+	if isEBSUnattached(tags) {
+		if rec := p.getIdleEBSRecommendation(volumeType, region, sizeGB); rec != nil {
+			return []*pbc.Recommendation{rec}
+		}
+	}
+
+	// Only recommend type upgrades for gp2 volumes
+	if volumeType != "gp2" {
+		return nil
+	}
 
 	gp2Price, found := p.pricing.EBSPricePerGBMonth("gp2")
 	if !found {
@@ -465,6 +1098,38 @@ func (p *AWSPublicPlugin) getEBSRecommendations(
 		savingsPercent = (savings / currentMonthly) * 100
 	}
 
+	// One-time snapshot/restore cost: a full snapshot kept for rollback, plus the
+	// restored gp3 volume overlapping the gp2 one during cutover.
+	restoreCost := gp3Monthly * ebsMigrationRestoreHours / carbon.HoursPerMonth
+	snapshotCost := 0.0
+	snapshotRate, snapshotFound := p.pricing.EBSSnapshotPricePerGBMonth()
+	if snapshotFound {
+		snapshotCost = snapshotRate * float64(sizeGB) * ebsMigrationSnapshotMonths
+	}
+	implementationCost := snapshotCost + restoreCost
+	metadata := map[string]string{
+		"baseline_iops":       "gp2: 100 IOPS/GB, gp3: 3000 IOPS (included)",
+		"baseline_throughput": "gp2: 128-250 MB/s, gp3: 125 MB/s (included)",
+		"snapshot_cost":       fmt.Sprintf("%.2f", snapshotCost),
+		"restore_cost":        fmt.Sprintf("%.2f", restoreCost),
+	}
+	if !snapshotFound {
+		metadata["snapshot_cost"] = "unavailable (no EBS snapshot pricing)"
+	}
+	reasoning := []string{
+		"gp3 volumes are ~20% cheaper than gp2",
+		"gp3 provides better baseline performance (3000 IOPS, 125 MB/s)",
+		"API-compatible change via ModifyVolume; snapshot first so the change can be rolled back",
+	}
+	if savings > 0 {
+		paybackMonths := implementationCost / savings
+		metadata["payback_months"] = fmt.Sprintf("%.2f", paybackMonths)
+		reasoning = append(reasoning, fmt.Sprintf(
+			"A snapshot/restore migration costs ~$%.2f once (%dGB snapshot kept %.0f month, %.0fh volume overlap), "+
+				"paid back in %.1f months", implementationCost, sizeGB, ebsMigrationSnapshotMonths,
+			ebsMigrationRestoreHours, paybackMonths))
+	}
+
 	// FR-006: Set confidence level to 0.9 (high) for EBS volume changes
 	confidence := confidenceHigh
 	return []*pbc.Recommendation{{
@@ -484,26 +1149,181 @@ func (p *AWSPublicPlugin) getEBSRecommendations(
 				RecommendedConfig: map[string]string{"volume_type": "gp3", "size_gb": strconv.Itoa(sizeGB)},
 			},
 		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:   savings,
+			Currency:           "USD",
+			ProjectionPeriod:   "monthly",
+			CurrentCost:        currentMonthly,
+			ProjectedCost:      gp3Monthly,
+			SavingsPercentage:  savingsPercent,
+			ImplementationCost: &implementationCost,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM,
+		ConfidenceScore: &confidence,
+		Description:     fmt.Sprintf("Upgrade %dGB gp2 volume to gp3 for ~%.0f%% cost savings", sizeGB, savingsPercent),
+		Reasoning:       reasoning,
+		// FR-012: Include relevant metadata (performance info, migration cost breakdown)
+		Metadata: metadata,
+		Source:   sourceAWSPublic,
+	}}
+}
+
+// isEBSUnattached reports whether the "attached" tag explicitly marks a volume as
+// unattached. A missing or unrecognized value means the attachment state is unknown.
+func isEBSUnattached(tags map[string]string) bool {
+	val, ok := tags["attached"]
+	if !ok {
+		return false
+	}
+	val = strings.ToLower(strings.TrimSpace(val))
+	return val == "false" || val == "0" || val == "no" || val == "off"
+}
+
+// getIdleEBSRecommendation returns a delete recommendation for an unattached EBS volume.
+// Savings equal the full monthly storage cost. Returns nil if the volume type has no pricing.
+func (p *AWSPublicPlugin) getIdleEBSRecommendation(volumeType, region string, sizeGB int) *pbc.Recommendation {
+	price, found := p.pricing.EBSPricePerGBMonth(volumeType)
+	if !found {
+		return nil
+	}
+
+	currentMonthly := price * float64(sizeGB)
+	confidence := confidenceHigh
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_DELETE_UNUSED,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: "ebs",
+			Region:       region,
+			Sku:          volumeType,
+		},
+		ActionDetail: &pbc.Recommendation_Terminate{
+			Terminate: &pbc.TerminateAction{
+				TerminationReason: "volume is not attached to any instance",
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  currentMonthly,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     0,
+			SavingsPercentage: 100,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_HIGH,
+		ConfidenceScore: &confidence,
+		Description:     fmt.Sprintf("Delete unattached %dGB %s volume", sizeGB, volumeType),
+		Reasoning: []string{
+			"Unattached volumes are billed for provisioned storage but serve no workload",
+			"Snapshot the volume before deleting if the data may be needed later",
+		},
+		Metadata: map[string]string{
+			"volume_type":      volumeType,
+			"size_gb":          strconv.Itoa(sizeGB),
+			"attachment_state": "unattached",
+		},
+		Source: sourceAWSPublic,
+	}
+}
+
+// getS3Recommendations recommends moving a STANDARD bucket tagged
+// access_pattern=unpredictable to Intelligent-Tiering. Savings assume
+// s3ITInfrequentShare of the data moves to the Infrequent Access tier (priced like
+// STANDARD_IA) and are net of the per-object monitoring fee; nothing is recommended
+// unless that is positive. Without an object_count tag, every object is assumed to be
+// the smallest monitored size, which overstates the fee.
+func (p *AWSPublicPlugin) getS3Recommendations(
+	traceID, storageClass, region string,
+	tags map[string]string,
+) []*pbc.Recommendation {
+	if storageClass == "" {
+		storageClass = "STANDARD"
+	}
+	if !strings.EqualFold(storageClass, "STANDARD") ||
+		!strings.EqualFold(strings.TrimSpace(tags["access_pattern"]), "unpredictable") {
+		return nil
+	}
+
+	sizeGB := 1.0
+	if size, err := strconv.ParseFloat(tags["size"], 64); err == nil && size > 0 {
+		sizeGB = size
+	}
+
+	standardPrice, found := p.pricing.S3PricePerGBMonth("STANDARD")
+	if !found {
+		return nil
+	}
+	infrequentPrice, found := p.pricing.S3PricePerGBMonth("STANDARD_IA")
+	if !found || infrequentPrice >= standardPrice {
+		return nil
+	}
+	monitoringPrice, found := p.pricing.S3IntelligentTieringMonitoringPricePerObject()
+	if !found {
+		return nil
+	}
+
+	objects := sizeGB * 1024 * 1024 / s3ITMinMonitoredObjectKB
+	objectCountSource := "assumed"
+	if val, ok := tags["object_count"]; ok {
+		objects = float64(p.validateNonNegativeInt64(traceID, "object_count", val))
+		objectCountSource = "tag"
+	}
+
+	currentMonthly := standardPrice * sizeGB
+	monitoringMonthly := monitoringPrice * objects
+	savings := sizeGB*s3ITInfrequentShare*(standardPrice-infrequentPrice) - monitoringMonthly
+	if savings <= 0 {
+		return nil
+	}
+	projectedMonthly := currentMonthly - savings
+	savingsPercent := (savings / currentMonthly) * 100
+
+	sizeStr := strconv.FormatFloat(sizeGB, 'f', -1, 64)
+	confidence := confidenceMedium
+	return []*pbc.Recommendation{{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: "s3",
+			Region:       region,
+			Sku:          storageClass,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modTypeIntelligentTiering,
+				CurrentConfig:     map[string]string{"storage_class": "STANDARD", "size_gb": sizeStr},
+				RecommendedConfig: map[string]string{"storage_class": "INTELLIGENT_TIERING", "size_gb": sizeStr},
+			},
+		},
 		Impact: &pbc.RecommendationImpact{
 			EstimatedSavings:  savings,
 			Currency:          "USD",
 			ProjectionPeriod:  "monthly",
 			CurrentCost:       currentMonthly,
-			ProjectedCost:     gp3Monthly,
+			ProjectedCost:     projectedMonthly,
 			SavingsPercentage: savingsPercent,
 		},
 		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM,
 		ConfidenceScore: &confidence,
-		Description:     fmt.Sprintf("Upgrade %dGB gp2 volume to gp3 for ~%.0f%% cost savings", sizeGB, savingsPercent),
+		Description: fmt.Sprintf("Move %sGB STANDARD bucket to Intelligent-Tiering for ~%.0f%% cost savings",
+			sizeStr, savingsPercent),
 		Reasoning: []string{
-			"gp3 volumes are ~20% cheaper than gp2",
-			"gp3 provides better baseline performance (3000 IOPS, 125 MB/s)",
-			"API-compatible change with no data migration required",
+			"Intelligent-Tiering moves objects not accessed for 30 days to a cheaper tier with no retrieval fees",
+			fmt.Sprintf("Assumes %.0f%% of the data moves to the Infrequent Access tier; savings are net of the monitoring fee",
+				s3ITInfrequentShare*100),
+			fmt.Sprintf("Objects smaller than %d KB are not monitored and always billed at the Frequent Access rate",
+				s3ITMinMonitoredObjectKB),
 		},
-		// FR-012: Include relevant metadata (performance info)
 		Metadata: map[string]string{
-			"baseline_iops":       "gp2: 100 IOPS/GB, gp3: 3000 IOPS (included)",
-			"baseline_throughput": "gp2: 128-250 MB/s, gp3: 125 MB/s (included)",
+			"monitoring_fee_per_object": strconv.FormatFloat(monitoringPrice, 'f', -1, 64),
+			"monitoring_fee_monthly":    strconv.FormatFloat(monitoringMonthly, 'f', 2, 64),
+			"monitored_objects":         strconv.FormatFloat(objects, 'f', 0, 64),
+			"object_count_source":       objectCountSource,
+			"infrequent_access_share":   strconv.FormatFloat(s3ITInfrequentShare, 'f', -1, 64),
 		},
 		Source: sourceAWSPublic,
 	}}
@@ -793,9 +1613,14 @@ func (p *AWSPublicPlugin) normalizeInput(req *pbc.GetRecommendationsRequest) *Pr
 		for i, res := range req.TargetResources {
 			pctx.Scope[i] = proto.Clone(res).(*pbc.ResourceDescriptor)
 		}
-		// Normalize resource types (Issue #124) - now safe to mutate our copies
+		// Normalize resource types (Issue #124) - now safe to mutate our copies.
+		// Resources that carry the SKU only in tags (e.g. tags["instanceType"]) get the
+		// same extractAWSSKU fallback used by the cost estimators.
 		for _, res := range pctx.Scope {
 			res.ResourceType = normalizeResourceType(res.ResourceType)
+			if res.Sku == "" {
+				res.Sku = extractAWSSKU(res.Tags)
+			}
 		}
 	} else if req.Filter != nil && req.Filter.Sku != "" {
 		// Legacy mode: construct single-item scope from Filter (already cloned above)
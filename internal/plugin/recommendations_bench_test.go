@@ -2,6 +2,7 @@ package plugin
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"testing"
 
@@ -135,3 +136,33 @@ func BenchmarkGetRecommendations_SingleResource(b *testing.B) {
 		}
 	}
 }
+
+// BenchmarkGetRecommendations_Workers compares serial processing of a 100-resource
+// batch that yields recommendations and carbon annotations against the worker pool.
+//
+// Run with: go test -bench=BenchmarkGetRecommendations_Workers -benchmem ./internal/plugin/...
+func BenchmarkGetRecommendations_Workers(b *testing.B) {
+	mock, base := newMinSavingsTestBatch()
+	logger := zerolog.New(io.Discard).Level(zerolog.Disabled)
+
+	resources := make([]*pbc.ResourceDescriptor, 0, 100)
+	for len(resources) < 100 {
+		resources = append(resources, base[len(resources)%len(base)])
+	}
+	req := &pbc.GetRecommendationsRequest{TargetResources: resources}
+	ctx := context.Background()
+
+	for _, workers := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+			plugin.recommendationWorkers = workers
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := plugin.GetRecommendations(ctx, req); err != nil {
+					b.Fatalf("GetRecommendations failed: %v", err)
+				}
+			}
+		})
+	}
+}
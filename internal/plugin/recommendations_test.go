@@ -5,17 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // parseLastLogEntry parses the last non-empty JSON line from a log buffer.
@@ -611,6 +616,64 @@ func TestGetEBSRecommendations_Gp2ToGp3(t *testing.T) {
 	}
 }
 
+// TestGetEBSRecommendations_MigrationCost verifies the gp2→gp3 recommendation carries the
+// one-time snapshot/restore cost and its payback period.
+func TestGetEBSRecommendations_MigrationCost(t *testing.T) {
+	tests := []struct {
+		name         string
+		snapshotRate float64
+		wantCost     float64
+		wantSnapshot string
+		wantPayback  string
+	}{
+		{
+			name:         "snapshot and restore",
+			snapshotRate: 0.05,
+			wantCost:     0.05*500 + 40*24/730.0, // $25 snapshot + ~$1.32 restore overlap
+			wantSnapshot: "25.00",
+			wantPayback:  "2.63",
+		},
+		{
+			name:         "snapshot pricing unavailable",
+			wantCost:     40 * 24 / 730.0,
+			wantSnapshot: "unavailable (no EBS snapshot pricing)",
+			wantPayback:  "0.13",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp2"] = 0.10
+			mock.ebsPrices["gp3"] = 0.08
+			mock.ebsSnapshotPrice = tt.snapshotRate
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			recs := plugin.getEBSRecommendations("gp2", "us-east-1", map[string]string{"size": "500"})
+			if len(recs) != 1 {
+				t.Fatalf("got %d recommendations, want 1", len(recs))
+			}
+			rec := recs[0]
+
+			if rec.Impact.ImplementationCost == nil {
+				t.Fatal("ImplementationCost is nil, want the snapshot/restore cost")
+			}
+			if got := rec.Impact.GetImplementationCost(); math.Abs(got-tt.wantCost) > 1e-9 {
+				t.Errorf("ImplementationCost = %v, want %v", got, tt.wantCost)
+			}
+			if got := rec.Metadata["snapshot_cost"]; got != tt.wantSnapshot {
+				t.Errorf("Metadata[snapshot_cost] = %q, want %q", got, tt.wantSnapshot)
+			}
+			if got := rec.Metadata["restore_cost"]; got != "1.32" {
+				t.Errorf("Metadata[restore_cost] = %q, want %q", got, "1.32")
+			}
+			if got := rec.Metadata["payback_months"]; got != tt.wantPayback {
+				t.Errorf("Metadata[payback_months] = %q, want %q", got, tt.wantPayback)
+			}
+		})
+	}
+}
+
 // TestGetEBSRecommendations_DefaultSize verifies default size of 100GB is used
 // when size is not specified in tags (edge case from spec.md).
 func TestGetEBSRecommendations_DefaultSize(t *testing.T) {
@@ -695,6 +758,95 @@ func TestGetEBSRecommendations_NoRecommendationForIo1(t *testing.T) {
 	}
 }
 
+// TestGetEBSRecommendations_Unattached verifies unattached volumes get a delete
+// recommendation and unknown attachment state falls back to type upgrades only.
+func TestGetEBSRecommendations_Unattached(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	mock.ebsPrices["io1"] = 0.125
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	tests := []struct {
+		name        string
+		volumeType  string
+		tags        map[string]string
+		wantDelete  bool
+		wantRecs    int
+		wantSavings float64
+	}{
+		{"gp2 unattached", "gp2", map[string]string{"size": "500", "attached": "false"}, true, 1, 50.0},
+		{"io1 unattached", "io1", map[string]string{"size": "200", "attached": "no"}, true, 1, 25.0},
+		{"gp2 attached", "gp2", map[string]string{"size": "500", "attached": "true"}, false, 1, 10.0},
+		{"gp2 unknown attachment", "gp2", map[string]string{"size": "500"}, false, 1, 10.0},
+		{"io1 unknown attachment", "io1", map[string]string{"size": "200", "attached": "maybe"}, false, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs := plugin.getEBSRecommendations(tt.volumeType, "us-east-1", tt.tags)
+			if len(recs) != tt.wantRecs {
+				t.Fatalf("got %d recommendations, want %d", len(recs), tt.wantRecs)
+			}
+			if tt.wantRecs == 0 {
+				return
+			}
+
+			rec := recs[0]
+			isDelete := rec.ActionType == pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_DELETE_UNUSED
+			if isDelete != tt.wantDelete {
+				t.Errorf("ActionType = %v, want delete: %v", rec.ActionType, tt.wantDelete)
+			}
+			if math.Abs(rec.Impact.EstimatedSavings-tt.wantSavings) > 0.0001 {
+				t.Errorf("EstimatedSavings = %v, want %v", rec.Impact.EstimatedSavings, tt.wantSavings)
+			}
+			if !tt.wantDelete {
+				return
+			}
+
+			if rec.GetTerminate() == nil {
+				t.Error("Expected Terminate action detail")
+			}
+			if rec.Impact.ProjectedCost != 0 {
+				t.Errorf("ProjectedCost = %v, want 0", rec.Impact.ProjectedCost)
+			}
+			if rec.ConfidenceScore == nil || *rec.ConfidenceScore != confidenceHigh {
+				t.Errorf("ConfidenceScore = %v, want %v", rec.ConfidenceScore, confidenceHigh)
+			}
+			if rec.Metadata["volume_type"] != tt.volumeType || rec.Metadata["size_gb"] != tt.tags["size"] {
+				t.Errorf("Metadata = %v, want volume_type %q and size_gb %q", rec.Metadata, tt.volumeType, tt.tags["size"])
+			}
+		})
+	}
+}
+
+// TestGetEBSRecommendations_MinimumSize verifies small volumes are never steered to
+// st1/sc1, and deleting an undersized st1 volume saves its 125GB minimum charge.
+func TestGetEBSRecommendations_MinimumSize(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	mock.ebsPrices["st1"] = 0.045
+	mock.ebsPrices["sc1"] = 0.015
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	for _, rec := range plugin.getEBSRecommendations("gp2", "us-east-1", map[string]string{"size": "10"}) {
+		target := rec.GetModify().GetRecommendedConfig()["volume_type"]
+		if _, ok := ebsMinimumSizeGB[target]; ok {
+			t.Errorf("10GB gp2 volume recommended %s, which has a minimum size", target)
+		}
+	}
+
+	recs := plugin.getEBSRecommendations("st1", "us-east-1", map[string]string{"size": "10", "attached": "false"})
+	if len(recs) != 1 {
+		t.Fatalf("got %d recommendations for unattached st1, want 1", len(recs))
+	}
+	if want := 0.045 * 125; math.Abs(recs[0].Impact.EstimatedSavings-want) > 0.0001 {
+		t.Errorf("EstimatedSavings = %v, want %v (125GB minimum)", recs[0].Impact.EstimatedSavings, want)
+	}
+}
+
 // TestGenerateEC2Recommendations_InvalidInstanceType verifies no recommendations
 // for invalid instance type formats.
 func TestGenerateEC2Recommendations_InvalidInstanceType(t *testing.T) {
@@ -750,6 +902,7 @@ func TestGetRecommendations_LogsContainDurationMs(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
 	logger := zerolog.New(&logBuf).Level(zerolog.InfoLevel)
 	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+	plugin.clock = newFakeClock(17 * time.Millisecond)
 
 	req := &pbc.GetRecommendationsRequest{}
 	_, err := plugin.GetRecommendations(context.Background(), req)
@@ -767,8 +920,9 @@ func TestGetRecommendations_LogsContainDurationMs(t *testing.T) {
 		t.Fatal("duration_ms not found in log entry")
 	}
 
-	if durationMs < 0 {
-		t.Errorf("duration_ms = %v, should be non-negative", durationMs)
+	// One fake clock step elapses between the start of the call and the summary log
+	if durationMs != 17 {
+		t.Errorf("duration_ms = %v, want 17", durationMs)
 	}
 }
 
@@ -1093,6 +1247,102 @@ func TestGetRecommendations_SummaryLogging(t *testing.T) {
 	if _, ok := logEntry["total_savings"]; !ok {
 		t.Error("Summary log should contain total_savings")
 	}
+
+	// Before/after rollup: 2 × t2.medium ($33.872 → $30.368) + 100GB gp2 ($10 → $8)
+	wantCurrent := 2*0.0464*730 + 10.0
+	wantProjected := 2*0.0416*730 + 8.0
+	if got, ok := logEntry["total_current_cost"].(float64); !ok || math.Abs(got-wantCurrent) > 0.01 {
+		t.Errorf("total_current_cost = %v, want %v", logEntry["total_current_cost"], wantCurrent)
+	}
+	if got, ok := logEntry["total_projected_cost"].(float64); !ok || math.Abs(got-wantProjected) > 0.01 {
+		t.Errorf("total_projected_cost = %v, want %v", logEntry["total_projected_cost"], wantProjected)
+	}
+}
+
+// TestGetRecommendations_CostRollupTrailer verifies the before/after cost totals reach
+// the client as gRPC trailers, and are omitted when no resource has a recommendation.
+func TestGetRecommendations_CostRollupTrailer(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws", Tags: map[string]string{"size": "100"}},
+			{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	// 2 × t2.medium ($33.872 → $30.368) + 100GB gp2 ($10 → $8)
+	for key, want := range map[string]float64{
+		totalCurrentCostTrailerKey:   2*0.0464*730 + 10.0,
+		totalProjectedCostTrailerKey: 2*0.0416*730 + 8.0,
+	} {
+		values := stream.trailer.Get(key)
+		if len(values) != 1 {
+			t.Fatalf("got %d %s trailer values, want 1", len(values), key)
+		}
+		got, err := strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			t.Fatalf("%s trailer is not a number: %q", key, values[0])
+		}
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	stream = &captureTransportStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ebs:Volume", Sku: "gp3", Region: "us-east-1", Provider: "aws", Tags: map[string]string{"size": "100"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	if values := stream.trailer.Get(totalCurrentCostTrailerKey); len(values) != 0 {
+		t.Errorf("%s = %v, want none without recommendations", totalCurrentCostTrailerKey, values)
+	}
+}
+
+// TestBatchStats_AddCostRollup verifies alternatives for one resource are counted once.
+func TestBatchStats_AddCostRollup(t *testing.T) {
+	rec := func(current, savings float64) *pbc.Recommendation {
+		return &pbc.Recommendation{Impact: &pbc.RecommendationImpact{
+			CurrentCost:      current,
+			ProjectedCost:    current - savings,
+			EstimatedSavings: savings,
+		}}
+	}
+
+	var stats BatchStats
+	stats.addCostRollup([]*pbc.Recommendation{rec(70, 7), rec(70, 14)}) // generation upgrade + Graviton
+	stats.addCostRollup([]*pbc.Recommendation{rec(10, 2)})
+	stats.addCostRollup([]*pbc.Recommendation{{}}) // missing impact is ignored
+	crossAZ := rec(500, 500)
+	crossAZ.ActionDetail = &pbc.Recommendation_Modify{Modify: &pbc.ModifyAction{ModificationType: modTypeCrossAZ}}
+	stats.addCostRollup([]*pbc.Recommendation{crossAZ}) // transfer hints are not alternatives
+	stats.addCostRollup(nil)
+
+	if math.Abs(stats.TotalCurrentCost-80) > 0.0001 {
+		t.Errorf("TotalCurrentCost = %v, want 80", stats.TotalCurrentCost)
+	}
+	if math.Abs(stats.TotalProjectedCost-64) > 0.0001 {
+		t.Errorf("TotalProjectedCost = %v, want 64", stats.TotalProjectedCost)
+	}
+	if stats.CostRollupResources != 2 {
+		t.Errorf("CostRollupResources = %d, want 2", stats.CostRollupResources)
+	}
 }
 
 // TestGetRecommendations_EmptyBothModes verifies empty response for no context.
@@ -1459,6 +1709,47 @@ func TestGetRecommendations_BatchIDCorrelation(t *testing.T) {
 	}
 }
 
+// TestGetRecommendations_Batch_SKUFromTags verifies that a batch resource with an empty
+// Sku falls back to the instance type in its tags, as the cost estimators do.
+func TestGetRecommendations_Batch_SKUFromTags(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	req := &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{{
+			ResourceType: "aws:ec2:Instance",
+			Region:       "us-east-1",
+			Provider:     "aws",
+			Tags:         map[string]string{"instanceType": "t2.medium"},
+		}},
+	}
+
+	resp, err := plugin.GetRecommendations(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	var foundGenUpgrade bool
+	for _, rec := range resp.Recommendations {
+		if rec.GetModify() == nil || rec.GetModify().ModificationType != modTypeGenUpgrade {
+			continue
+		}
+		foundGenUpgrade = true
+		if got := rec.GetModify().RecommendedConfig["instance_type"]; got != "t3.medium" {
+			t.Errorf("recommended instance_type = %q, want %q", got, "t3.medium")
+		}
+		if rec.Resource.GetSku() != "t2.medium" {
+			t.Errorf("Resource.Sku = %q, want %q", rec.Resource.GetSku(), "t2.medium")
+		}
+	}
+	if !foundGenUpgrade {
+		t.Errorf("expected generation upgrade recommendation, got %d recommendations", len(resp.Recommendations))
+	}
+}
+
 // TestGetRecommendations_Batch_EBSDefaultSize verifies that EBS volumes with empty or nil tags
 // in batch mode correctly fall back to the default 100GB size and cost calculation (Issue #127).
 func TestGetRecommendations_Batch_EBSDefaultSize(t *testing.T) {
@@ -1744,6 +2035,55 @@ func TestInit_MaxBatchSizeFromEnv(t *testing.T) {
 	}
 }
 
+// TestGetRecommendations_ConfiguredBatchSizeBoundary verifies an operator-raised limit
+// accepts exactly that many resources, rejects one more with the configured value in the
+// message, and is capped at maxMaxBatchSize.
+func TestGetRecommendations_ConfiguredBatchSizeBoundary(t *testing.T) {
+	batch := func(n int) *pbc.GetRecommendationsRequest {
+		resources := make([]*pbc.ResourceDescriptor, n)
+		for i := range resources {
+			resources[i] = &pbc.ResourceDescriptor{
+				ResourceType: "aws:ec2:Instance",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Provider:     "aws",
+			}
+		}
+		return &pbc.GetRecommendationsRequest{TargetResources: resources}
+	}
+
+	tests := []struct {
+		name      string
+		envValue  string
+		wantLimit int
+	}{
+		{name: "raised above default", envValue: "250", wantLimit: 250},
+		{name: "capped", envValue: "100000", wantLimit: maxMaxBatchSize},
+		{name: "invalid keeps default", envValue: "lots", wantLimit: defaultMaxBatchSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvMaxBatchSize, tt.envValue)
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+
+			if _, err := plugin.GetRecommendations(context.Background(), batch(tt.wantLimit)); err != nil {
+				t.Fatalf("GetRecommendations(%d resources) error: %v", tt.wantLimit, err)
+			}
+
+			_, err := plugin.GetRecommendations(context.Background(), batch(tt.wantLimit+1))
+			st, ok := status.FromError(err)
+			if !ok || st.Code() != codes.InvalidArgument {
+				t.Fatalf("GetRecommendations(%d resources) error = %v, want InvalidArgument", tt.wantLimit+1, err)
+			}
+			want := fmt.Sprintf("batch size %d exceeds maximum of %d", tt.wantLimit+1, tt.wantLimit)
+			if !strings.Contains(st.Message(), want) {
+				t.Errorf("Message = %q, want it to contain %q", st.Message(), want)
+			}
+		})
+	}
+}
+
 // TestInit_StrictValidationFromEnv verifies that strict validation can be enabled via environment variable.
 // It tests "true", "1", and "yes" values.
 func TestInit_StrictValidationFromEnv(t *testing.T) {
@@ -1812,13 +2152,13 @@ func TestInit_StrictValidation_UnsupportedService(t *testing.T) {
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
 	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
 
-	// Request with supported provider (AWS) but unsupported service (e.g. S3 bucket, assuming not implemented yet)
-	// Note: Currently EC2, EBS, RDS are implemented. S3 is not in the switch case in GetRecommendations.
+	// Request with supported provider (AWS) but unsupported service (Lambda function)
+	// Note: Currently EC2, EBS, S3, RDS are implemented. Lambda is not in the switch case in GetRecommendations.
 	req := &pbc.GetRecommendationsRequest{
 		TargetResources: []*pbc.ResourceDescriptor{
 			{
-				ResourceType: "aws:s3:Bucket",
-				Sku:          "Standard",
+				ResourceType: "aws:lambda:Function",
+				Sku:          "x86_64",
 				Region:       "us-east-1",
 				Provider:     "aws",
 			},
@@ -1835,3 +2175,1020 @@ func TestInit_StrictValidation_UnsupportedService(t *testing.T) {
 		t.Errorf("Unexpected error message: %s", st.Message())
 	}
 }
+
+// newMinSavingsTestBatch returns a batch whose recommendations have savings of
+// ~$3.50 (t2→t3), $2.00 (gp2→gp3), $0 (m5→m6i), and ~$13.87 (m5→m6g) per month.
+func newMinSavingsTestBatch() (*mockPricingClient, []*pbc.ResourceDescriptor) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m6i.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+
+	resources := []*pbc.ResourceDescriptor{
+		{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+		{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws", Tags: map[string]string{"size": "100"}},
+		{ResourceType: "aws:ec2:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws"},
+	}
+	return mock, resources
+}
+
+// TestGetRecommendations_MinEstimatedSavings verifies recommendations below the filter's
+// min_estimated_savings are suppressed and excluded from the summary totals.
+func TestGetRecommendations_MinEstimatedSavings(t *testing.T) {
+	tests := []struct {
+		name        string
+		minSavings  float64
+		wantCount   int
+		wantSavings float64
+	}{
+		{name: "zero keeps everything", minSavings: 0, wantCount: 4, wantSavings: 3.504 + 2.0 + 0 + 13.87},
+		{name: "threshold drops small savings", minSavings: 2.5, wantCount: 2, wantSavings: 3.504 + 13.87},
+		{name: "threshold above all savings", minSavings: 100, wantCount: 0, wantSavings: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, resources := newMinSavingsTestBatch()
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: resources,
+				Filter:          &pbc.RecommendationFilter{MinEstimatedSavings: tt.minSavings},
+			})
+			if err != nil {
+				t.Fatalf("GetRecommendations() error: %v", err)
+			}
+
+			if len(resp.Recommendations) != tt.wantCount {
+				t.Fatalf("got %d recommendations, want %d", len(resp.Recommendations), tt.wantCount)
+			}
+			for _, rec := range resp.Recommendations {
+				if rec.GetImpact().GetEstimatedSavings() < tt.minSavings {
+					t.Errorf("recommendation %s has savings %.4f below threshold %.2f",
+						rec.Id, rec.GetImpact().GetEstimatedSavings(), tt.minSavings)
+				}
+			}
+			if got := resp.Summary.GetTotalEstimatedSavings(); got < tt.wantSavings-0.01 || got > tt.wantSavings+0.01 {
+				t.Errorf("Summary.TotalEstimatedSavings = %.4f, want %.4f", got, tt.wantSavings)
+			}
+			if int(resp.Summary.GetTotalRecommendations()) != tt.wantCount {
+				t.Errorf("Summary.TotalRecommendations = %d, want %d", resp.Summary.GetTotalRecommendations(), tt.wantCount)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_MinMonthlySavingsFromEnv verifies the plugin-level default threshold
+// applies when the filter does not set one, and that the filter value overrides it.
+func TestGetRecommendations_MinMonthlySavingsFromEnv(t *testing.T) {
+	t.Setenv(EnvMinMonthlySavings, "2.5")
+
+	mock, resources := newMinSavingsTestBatch()
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+		TargetResources: resources,
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	if len(resp.Recommendations) != 2 {
+		t.Errorf("env default: got %d recommendations, want 2", len(resp.Recommendations))
+	}
+
+	resp, err = plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+		TargetResources: resources,
+		Filter:          &pbc.RecommendationFilter{MinEstimatedSavings: 10},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	if len(resp.Recommendations) != 1 {
+		t.Errorf("filter override: got %d recommendations, want 1", len(resp.Recommendations))
+	}
+}
+
+// TestInit_MinMonthlySavingsInvalid verifies invalid threshold values fall back to 0.
+func TestInit_MinMonthlySavingsInvalid(t *testing.T) {
+	for _, val := range []string{"abc", "-5", "NaN"} {
+		t.Run(val, func(t *testing.T) {
+			t.Setenv(EnvMinMonthlySavings, val)
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+			if plugin.minMonthlySavings != 0 {
+				t.Errorf("minMonthlySavings = %v, want 0", plugin.minMonthlySavings)
+			}
+		})
+	}
+}
+
+// TestInit_RecommendationWorkers verifies the worker count is read from the environment,
+// capped at the maximum, and falls back to serial processing for invalid values.
+func TestInit_RecommendationWorkers(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "", want: defaultRecommendationWorkers},
+		{value: "8", want: 8},
+		{value: "1000", want: maxRecommendationWorkers},
+		{value: "0", want: defaultRecommendationWorkers},
+		{value: "many", want: defaultRecommendationWorkers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(EnvRecommendationWorkers, tt.value)
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+			if plugin.recommendationWorkers != tt.want {
+				t.Errorf("recommendationWorkers = %d, want %d", plugin.recommendationWorkers, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_ConcurrentMatchesSerial verifies a worker pool returns the same
+// recommendations, in the same order, with the same summary as serial processing.
+func TestGetRecommendations_ConcurrentMatchesSerial(t *testing.T) {
+	mock, base := newMinSavingsTestBatch()
+	var resources []*pbc.ResourceDescriptor
+	for i := range 30 {
+		for _, r := range base {
+			r = proto.Clone(r).(*pbc.ResourceDescriptor)
+			r.Id = fmt.Sprintf("res-%d-%s", i, r.Sku)
+			resources = append(resources, r)
+		}
+	}
+	resources = append(resources,
+		&pbc.ResourceDescriptor{Provider: "gcp", ResourceType: "compute", Sku: "n1-standard-1"},
+		&pbc.ResourceDescriptor{Provider: "aws", ResourceType: "lambda", Sku: "128"},
+	)
+
+	run := func(workers int) *pbc.GetRecommendationsResponse {
+		plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+		plugin.recommendationWorkers = workers
+		resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+			TargetResources: resources,
+		})
+		if err != nil {
+			t.Fatalf("GetRecommendations(workers=%d) error: %v", workers, err)
+		}
+		return resp
+	}
+	serial := run(1)
+	concurrent := run(8)
+
+	if len(concurrent.Recommendations) != len(serial.Recommendations) {
+		t.Fatalf("concurrent returned %d recommendations, serial %d",
+			len(concurrent.Recommendations), len(serial.Recommendations))
+	}
+	for i, want := range serial.Recommendations {
+		got := concurrent.Recommendations[i]
+		if got.GetResource().GetId() != want.GetResource().GetId() ||
+			got.GetModify().GetModificationType() != want.GetModify().GetModificationType() ||
+			got.GetImpact().GetEstimatedSavings() != want.GetImpact().GetEstimatedSavings() {
+			t.Errorf("recommendation %d = %s/%s, want %s/%s", i,
+				got.GetResource().GetId(), got.GetModify().GetModificationType(),
+				want.GetResource().GetId(), want.GetModify().GetModificationType())
+		}
+	}
+	if concurrent.Summary.GetTotalEstimatedSavings() != serial.Summary.GetTotalEstimatedSavings() {
+		t.Errorf("concurrent TotalEstimatedSavings = %v, serial %v",
+			concurrent.Summary.GetTotalEstimatedSavings(), serial.Summary.GetTotalEstimatedSavings())
+	}
+}
+
+// TestGetRecommendations_ConcurrentStrictValidation verifies strict validation still
+// fails the batch when resources are processed concurrently.
+func TestGetRecommendations_ConcurrentStrictValidation(t *testing.T) {
+	mock, resources := newMinSavingsTestBatch()
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	plugin.recommendationWorkers = 4
+	plugin.features.StrictValidation = true
+
+	resources = append(resources, &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "lambda", Sku: "128"})
+	_, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+		TargetResources: resources,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("GetRecommendations() code = %v, want InvalidArgument (err: %v)", status.Code(err), err)
+	}
+}
+
+// TestGetRecommendations_RankedBySavings verifies the default ranking puts the
+// largest estimated savings first.
+func TestGetRecommendations_RankedBySavings(t *testing.T) {
+	mock, resources := newMinSavingsTestBatch()
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+		TargetResources: resources,
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	if len(resp.Recommendations) != 4 {
+		t.Fatalf("got %d recommendations, want 4", len(resp.Recommendations))
+	}
+
+	wantSKUs := []string{"m5.large", "t2.medium", "gp2", "m5.large"}
+	wantModTypes := []string{modTypeGraviton, modTypeGenUpgrade, modTypeVolumeUpgrade, modTypeGenUpgrade}
+	for i, rec := range resp.Recommendations {
+		if rec.Resource.Sku != wantSKUs[i] || rec.GetModify().GetModificationType() != wantModTypes[i] {
+			t.Errorf("rank %d = %s/%s (savings %.2f), want %s/%s", i,
+				rec.Resource.Sku, rec.GetModify().GetModificationType(), rec.Impact.EstimatedSavings,
+				wantSKUs[i], wantModTypes[i])
+		}
+	}
+}
+
+// TestRankRecommendations verifies sort_by/sort_order handling and deterministic tie-breaking.
+func TestRankRecommendations(t *testing.T) {
+	high, medium := confidenceHigh, confidenceMedium
+	newRec := func(id, modType string, savings float64, confidence *float64, priority pbc.RecommendationPriority) *pbc.Recommendation {
+		return &pbc.Recommendation{
+			Id:              id,
+			Resource:        &pbc.ResourceRecommendationInfo{Id: id},
+			ActionDetail:    &pbc.Recommendation_Modify{Modify: &pbc.ModifyAction{ModificationType: modType}},
+			Impact:          &pbc.RecommendationImpact{EstimatedSavings: savings},
+			ConfidenceScore: confidence,
+			Priority:        priority,
+		}
+	}
+	ids := func(recs []*pbc.Recommendation) []string {
+		out := make([]string, len(recs))
+		for i, r := range recs {
+			out[i] = r.Id
+		}
+		return out
+	}
+
+	tests := []struct {
+		name      string
+		sortBy    pbc.RecommendationSortBy
+		sortOrder pbc.SortOrder
+		want      []string
+	}{
+		{
+			name: "default savings desc with confidence and id tiebreak",
+			want: []string{"a", "b", "c", "d"},
+		},
+		{
+			name:      "savings ascending",
+			sortBy:    pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_ESTIMATED_SAVINGS,
+			sortOrder: pbc.SortOrder_SORT_ORDER_ASC,
+			want:      []string{"d", "b", "c", "a"},
+		},
+		{
+			name:   "confidence desc",
+			sortBy: pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_CONFIDENCE,
+			want:   []string{"a", "b", "d", "c"},
+		},
+		{
+			name:   "priority desc",
+			sortBy: pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_PRIORITY,
+			want:   []string{"c", "a", "b", "d"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs := []*pbc.Recommendation{
+				newRec("d", modTypeVolumeUpgrade, 1, &high, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW),
+				newRec("c", modTypeGraviton, 5, &medium, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_HIGH),
+				newRec("b", modTypeGenUpgrade, 5, &high, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM),
+				newRec("a", modTypeGenUpgrade, 10, &high, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM),
+			}
+			rankRecommendations(recs, tt.sortBy, tt.sortOrder)
+			got := ids(recs)
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Fatalf("order = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_GravitonWorkload verifies the "workload" tag suppresses Graviton
+// for x86-bound workloads and adjusts confidence for portable or limited ones.
+func TestGetRecommendations_GravitonWorkload(t *testing.T) {
+	tests := []struct {
+		name               string
+		workload           string
+		wantGraviton       bool
+		wantConfidence     float64
+		wantCompatibility  string
+		wantGenUpgradeKept bool
+	}{
+		{name: "no workload tag", workload: "", wantGraviton: true, wantConfidence: confidenceMedium, wantGenUpgradeKept: true},
+		{name: "unknown workload unchanged", workload: "cobol", wantGraviton: true, wantConfidence: confidenceMedium, wantGenUpgradeKept: true},
+		{name: "java raises confidence", workload: "Java", wantGraviton: true, wantConfidence: confidenceGravitonPortable, wantCompatibility: "portable", wantGenUpgradeKept: true},
+		{name: "dotnet lowers confidence", workload: "dotnet", wantGraviton: true, wantConfidence: confidenceLow, wantCompatibility: "limited", wantGenUpgradeKept: true},
+		{name: "native-x86 suppresses graviton", workload: "native-x86", wantGraviton: false, wantGenUpgradeKept: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+			mock.ec2Prices["m6i.large/Linux/Shared"] = 0.096
+			mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			tags := map[string]string{}
+			if tt.workload != "" {
+				tags["workload"] = tt.workload
+			}
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{
+					{ResourceType: "aws:ec2/instance:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws", Tags: tags},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetRecommendations() error: %v", err)
+			}
+
+			var graviton, genUpgrade *pbc.Recommendation
+			for _, rec := range resp.Recommendations {
+				switch rec.GetModify().GetModificationType() {
+				case modTypeGraviton:
+					graviton = rec
+				case modTypeGenUpgrade:
+					genUpgrade = rec
+				}
+			}
+
+			if (genUpgrade != nil) != tt.wantGenUpgradeKept {
+				t.Errorf("generation upgrade present = %v, want %v", genUpgrade != nil, tt.wantGenUpgradeKept)
+			}
+			if (graviton != nil) != tt.wantGraviton {
+				t.Fatalf("graviton present = %v, want %v", graviton != nil, tt.wantGraviton)
+			}
+			if graviton == nil {
+				return
+			}
+			if graviton.GetConfidenceScore() != tt.wantConfidence {
+				t.Errorf("ConfidenceScore = %v, want %v", graviton.GetConfidenceScore(), tt.wantConfidence)
+			}
+			if got := graviton.Metadata["workload_compatibility"]; got != tt.wantCompatibility {
+				t.Errorf("workload_compatibility = %q, want %q", got, tt.wantCompatibility)
+			}
+			if tt.wantCompatibility != "" && graviton.Metadata["workload_note"] == "" {
+				t.Error("workload_note should explain the confidence adjustment")
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_GravitonSpot verifies the Graviton Spot recommendation is only
+// emitted for resources tagged interruptible.
+func TestGetRecommendations_GravitonSpot(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     map[string]string
+		wantSpot bool
+	}{
+		{name: "no tag", tags: nil, wantSpot: false},
+		{name: "interruptible false", tags: map[string]string{"interruptible": "false"}, wantSpot: false},
+		{name: "interruptible true", tags: map[string]string{"interruptible": "true"}, wantSpot: true},
+		{name: "incompatible workload suppresses spot", tags: map[string]string{"interruptible": "true", "workload": "native-x86"}, wantSpot: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+			mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{
+					{ResourceType: "aws:ec2/instance:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws", Tags: tt.tags},
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetRecommendations() error: %v", err)
+			}
+
+			var spot *pbc.Recommendation
+			for _, rec := range resp.Recommendations {
+				if rec.GetModify().GetModificationType() == modTypeGravitonSpot {
+					spot = rec
+				}
+			}
+			if (spot != nil) != tt.wantSpot {
+				t.Fatalf("graviton spot present = %v, want %v", spot != nil, tt.wantSpot)
+			}
+			if spot == nil {
+				return
+			}
+
+			// 0.096 × 730 = 70.08 current; 0.077 × 0.30 × 730 = 16.863 projected
+			wantProjected := 0.077 * (1 - spotDiscountFactor) * 730
+			if math.Abs(spot.Impact.ProjectedCost-wantProjected) > 0.001 {
+				t.Errorf("ProjectedCost = %v, want %v", spot.Impact.ProjectedCost, wantProjected)
+			}
+			if math.Abs(spot.Impact.EstimatedSavings-(0.096*730-wantProjected)) > 0.001 {
+				t.Errorf("EstimatedSavings = %v, want %v", spot.Impact.EstimatedSavings, 0.096*730-wantProjected)
+			}
+			if spot.GetConfidenceScore() != confidenceLow {
+				t.Errorf("ConfidenceScore = %v, want %v", spot.GetConfidenceScore(), confidenceLow)
+			}
+			if got := spot.GetModify().RecommendedConfig["purchase_option"]; got != "spot" {
+				t.Errorf("RecommendedConfig purchase_option = %q, want spot", got)
+			}
+			if !strings.Contains(strings.Join(spot.Reasoning, " "), "interrupted") {
+				t.Errorf("Reasoning should warn about interruption, got %v", spot.Reasoning)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_SpotOpportunity verifies same-type Spot recommendations are only
+// emitted for interruptible EC2 resources and that the batch trailer totals each
+// resource's best Spot option once.
+func TestGetRecommendations_SpotOpportunity(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+	mock.ec2Prices["c5.large/Linux/Shared"] = 0.085
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	interruptible := map[string]string{"interruptible": "true"}
+	resp, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2/instance:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws", Tags: interruptible},
+			{ResourceType: "aws:ec2/instance:Instance", Sku: "c5.large", Region: "us-east-1", Provider: "aws", Tags: interruptible},
+			{ResourceType: "aws:ec2/instance:Instance", Sku: "t3.micro", Region: "us-east-1", Provider: "aws"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	spotBySKU := make(map[string]*pbc.Recommendation)
+	for _, rec := range resp.Recommendations {
+		if rec.GetModify().GetModificationType() == modTypeSpot {
+			spotBySKU[rec.GetResource().GetSku()] = rec
+		}
+	}
+	if len(spotBySKU) != 2 || spotBySKU["m5.large"] == nil || spotBySKU["c5.large"] == nil {
+		t.Fatalf("spot recommendations for %v, want m5.large and c5.large only", spotBySKU)
+	}
+	c5 := spotBySKU["c5.large"]
+	if math.Abs(c5.Impact.EstimatedSavings-0.085*730*spotDiscountFactor) > 0.001 {
+		t.Errorf("c5.large spot savings = %v, want %v", c5.Impact.EstimatedSavings, 0.085*730*spotDiscountFactor)
+	}
+	if c5.GetConfidenceScore() != confidenceLow {
+		t.Errorf("ConfidenceScore = %v, want %v", c5.GetConfidenceScore(), confidenceLow)
+	}
+	if !strings.Contains(strings.Join(c5.Reasoning, " "), "interrupted") {
+		t.Errorf("Reasoning should warn about interruption, got %v", c5.Reasoning)
+	}
+
+	// m5.large's best Spot option is Graviton Spot; c5.large has no Graviton pricing
+	wantTotal := (0.096*730 - 0.077*(1-spotDiscountFactor)*730) + 0.085*730*spotDiscountFactor
+	values := stream.trailer.Get(spotOpportunityTrailerKey)
+	if len(values) != 1 {
+		t.Fatalf("got %d spot opportunity trailer values, want 1", len(values))
+	}
+	total, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		t.Fatalf("spot opportunity trailer is not a number: %q", values[0])
+	}
+	if math.Abs(total-wantTotal) > 0.01 {
+		t.Errorf("spot opportunity total = %v, want %v", total, wantTotal)
+	}
+}
+
+// captureTransportStream is a grpc.ServerTransportStream that records headers and trailers.
+type captureTransportStream struct {
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *captureTransportStream) Method() string {
+	return "/finfocus.v1.CostSourceService/GetRecommendations"
+}
+
+func (s *captureTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *captureTransportStream) SendHeader(metadata.MD) error { return nil }
+
+func (s *captureTransportStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+// TestGetRecommendations_BatchPartialFailure verifies that malformed or unsupported
+// resources are skipped with per-index warnings while valid ones still produce
+// recommendations.
+func TestGetRecommendations_BatchPartialFailure(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	req := &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws", Tags: map[string]string{"size": "huge"}},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws", Tags: map[string]string{"size": "50"}},
+			{ResourceType: "gcp:compute:Instance", Sku: "n1-standard-1", Region: "us-central1", Provider: "gcp"},
+			{ResourceType: "aws:lambda:Function", Sku: "", Region: "us-east-1", Provider: "aws"},
+		},
+	}
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	resp, err := plugin.GetRecommendations(ctx, req)
+	if err != nil {
+		t.Fatalf("GetRecommendations() should not fail the whole batch: %v", err)
+	}
+
+	// Valid EC2 (index 0) and valid EBS (index 2) still produce recommendations
+	if len(resp.Recommendations) != 2 {
+		t.Errorf("got %d recommendations, want 2", len(resp.Recommendations))
+	}
+
+	values := stream.trailer.Get(batchWarningsTrailerKey)
+	if len(values) != 3 {
+		t.Fatalf("got %d batch warnings, want 3: %v", len(values), values)
+	}
+
+	wantIndexes := []int{1, 3, 4}
+	wantReasons := []string{"invalid size tag", "unsupported provider", "does not support recommendations"}
+	for i, v := range values {
+		var w BatchWarning
+		if err := json.Unmarshal([]byte(v), &w); err != nil {
+			t.Fatalf("warning %d is not valid JSON: %v", i, err)
+		}
+		if w.Index != wantIndexes[i] {
+			t.Errorf("warning %d index = %d, want %d", i, w.Index, wantIndexes[i])
+		}
+		if !strings.Contains(w.Reason, wantReasons[i]) {
+			t.Errorf("warning %d reason = %q, want substring %q", i, w.Reason, wantReasons[i])
+		}
+	}
+}
+
+// TestGetRecommendations_NoWarningsNoTrailer verifies a clean batch sets no trailer.
+func TestGetRecommendations_NoWarningsNoTrailer(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	_, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+	if len(stream.trailer.Get(batchWarningsTrailerKey)) != 0 {
+		t.Errorf("expected no batch warnings, got %v", stream.trailer)
+	}
+}
+
+// TestGetRecommendations_RegionMismatch verifies resources outside the plugin's region are
+// skipped with a batch warning instead of being priced from this region's data.
+func TestGetRecommendations_RegionMismatch(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t2.medium/Linux/Shared"] = 0.0464
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	resp, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "us-east-1", Provider: "aws"},
+			{ResourceType: "aws:ec2:Instance", Sku: "t2.medium", Region: "eu-west-1", Provider: "aws"},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "", Provider: "aws"},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "ap-southeast-2", Provider: "aws"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	for _, rec := range resp.Recommendations {
+		if region := rec.GetResource().GetRegion(); region != "us-east-1" {
+			t.Errorf("got recommendation for region %q, want only us-east-1", region)
+		}
+	}
+	if len(resp.Recommendations) != 2 {
+		t.Errorf("got %d recommendations, want 2 (in-region EC2 and EBS)", len(resp.Recommendations))
+	}
+
+	values := stream.trailer.Get(batchWarningsTrailerKey)
+	if len(values) != 2 {
+		t.Fatalf("got %d batch warnings, want 2: %v", len(values), values)
+	}
+	wantIndexes := []int{1, 3}
+	wantRegions := []string{"eu-west-1", "ap-southeast-2"}
+	for i, v := range values {
+		var w BatchWarning
+		if err := json.Unmarshal([]byte(v), &w); err != nil {
+			t.Fatalf("warning %d is not valid JSON: %v", i, err)
+		}
+		if w.Index != wantIndexes[i] {
+			t.Errorf("warning %d index = %d, want %d", i, w.Index, wantIndexes[i])
+		}
+		if !strings.Contains(w.Reason, wantRegions[i]) || !strings.Contains(w.Reason, "differs from plugin region") {
+			t.Errorf("warning %d reason = %q, want region mismatch for %s", i, w.Reason, wantRegions[i])
+		}
+	}
+}
+
+// TestGetRecommendations_CarbonImpact verifies recommendations carry the carbon delta in
+// metadata and the batch total is sent as a trailer.
+func TestGetRecommendations_CarbonImpact(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+	mock.ebsPrices["gp2"] = 0.10
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	stream := &captureTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	resp, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws"},
+			{ResourceType: "aws:ebs:Volume", Sku: "gp2", Region: "us-east-1", Provider: "aws",
+				Tags: map[string]string{"size": "500", "attached": "false"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	estimator := carbon.NewEstimator()
+	m5, _ := estimator.EstimateCarbonGrams("m5.large", "us-east-1", carbon.DefaultUtilization, carbon.HoursPerMonth)
+	m6g, _ := estimator.EstimateCarbonGrams("m6g.large", "us-east-1", carbon.DefaultUtilization, carbon.HoursPerMonth)
+	gp2, ok := carbon.NewEBSEstimator().EstimateCarbonGrams(carbon.EBSVolumeConfig{
+		VolumeType: "gp2", SizeGB: 500, Region: "us-east-1", Hours: carbon.HoursPerMonth,
+	})
+	if !ok {
+		t.Fatal("EBS estimator has no data for gp2")
+	}
+
+	var wantTotal float64
+	for _, rec := range resp.Recommendations {
+		var want float64
+		switch {
+		case rec.GetModify().GetModificationType() == modTypeGraviton:
+			want = m5 - m6g
+		case rec.GetTerminate() != nil:
+			want = gp2
+		default:
+			continue
+		}
+		wantTotal += want
+
+		got, err := strconv.ParseFloat(rec.Metadata[metaCarbonSavings], 64)
+		if err != nil {
+			t.Fatalf("%s: missing %s metadata: %v", rec.Description, metaCarbonSavings, rec.Metadata)
+		}
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("%s: carbon savings = %v, want %v", rec.Description, got, want)
+		}
+		if rec.Metadata[metaCarbonCurrent] == "" || rec.Metadata[metaCarbonProjected] == "" {
+			t.Errorf("%s: missing current/projected carbon metadata: %v", rec.Description, rec.Metadata)
+		}
+	}
+
+	values := stream.trailer.Get(carbonSavingsTrailerKey)
+	if len(values) != 1 {
+		t.Fatalf("got %d carbon savings trailer values, want 1", len(values))
+	}
+	total, err := strconv.ParseFloat(values[0], 64)
+	if err != nil {
+		t.Fatalf("carbon savings trailer is not a number: %q", values[0])
+	}
+	if math.Abs(total-wantTotal) > 0.01 {
+		t.Errorf("total carbon savings = %v, want %v", total, wantTotal)
+	}
+}
+
+// TestAnnotateCarbonImpact_UnknownCarbon verifies no carbon delta is recorded unless both
+// configurations have known carbon.
+func TestAnnotateCarbonImpact_UnknownCarbon(t *testing.T) {
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+
+	rec := &pbc.Recommendation{
+		Resource: &pbc.ResourceRecommendationInfo{ResourceType: "ec2", Region: "us-east-1", Sku: "m5.large"},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modTypeGenUpgrade,
+				CurrentConfig:     map[string]string{"instance_type": "m5.large"},
+				RecommendedConfig: map[string]string{"instance_type": "unknown.large"},
+			},
+		},
+	}
+
+	if _, ok := plugin.annotateCarbonImpact([]*pbc.Recommendation{rec}); ok {
+		t.Error("annotateCarbonImpact() ok = true, want false for unknown proposed instance")
+	}
+	if _, found := rec.Metadata[metaCarbonSavings]; found {
+		t.Errorf("unexpected carbon metadata: %v", rec.Metadata)
+	}
+}
+
+// TestGetRecommendations_InstanceCapabilities verifies EC2 instance swaps carry vCPU and
+// network metadata for both the current and the recommended instance.
+func TestGetRecommendations_InstanceCapabilities(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m6g.large/Linux/Shared"] = 0.077
+	mock.ec2Network = map[string]string{
+		"m5.large":  "Up to 10 Gigabit",
+		"m6g.large": "Up to 10 Gigabit",
+	}
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{ResourceType: "aws:ec2:Instance", Sku: "m5.large", Region: "us-east-1", Provider: "aws"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error: %v", err)
+	}
+
+	var graviton *pbc.Recommendation
+	for _, rec := range resp.Recommendations {
+		if rec.GetModify().GetModificationType() == modTypeGraviton {
+			graviton = rec
+		}
+	}
+	if graviton == nil {
+		t.Fatal("expected a Graviton recommendation for m5.large")
+	}
+
+	want := map[string]string{
+		metaCurrentVCPU:        "2",
+		metaCurrentNetwork:     "Up to 10 Gigabit",
+		metaRecommendedVCPU:    "2",
+		metaRecommendedNetwork: "Up to 10 Gigabit",
+		"architecture_change":  "x86_64 -> arm64",
+	}
+	for key, value := range want {
+		if got := graviton.Metadata[key]; got != value {
+			t.Errorf("Metadata[%q] = %q, want %q", key, got, value)
+		}
+	}
+}
+
+// TestApplyInstanceCapabilities verifies memory metadata and the reasoning warnings when
+// the recommended instance is smaller than the current one.
+func TestApplyInstanceCapabilities(t *testing.T) {
+	tests := []struct {
+		name        string
+		cur, next   instanceCapabilities
+		wantMeta    map[string]string
+		wantWarning string
+	}{
+		{
+			name: "equivalent",
+			cur:  instanceCapabilities{VCPU: 2, MemoryGB: 8, Network: "Up to 10 Gigabit"},
+			next: instanceCapabilities{VCPU: 2, MemoryGB: 8, Network: "Up to 12.5 Gigabit"},
+			wantMeta: map[string]string{
+				metaCurrentMemoryGB:    "8",
+				metaRecommendedMemory:  "8",
+				metaRecommendedNetwork: "Up to 12.5 Gigabit",
+			},
+		},
+		{
+			name:        "less memory",
+			cur:         instanceCapabilities{VCPU: 2, MemoryGB: 8},
+			next:        instanceCapabilities{VCPU: 2, MemoryGB: 4},
+			wantMeta:    map[string]string{metaCurrentMemoryGB: "8", metaRecommendedMemory: "4"},
+			wantWarning: "less memory than m5.large (4 GB vs 8 GB)",
+		},
+		{
+			name:        "fewer vCPUs",
+			cur:         instanceCapabilities{VCPU: 4},
+			next:        instanceCapabilities{VCPU: 2},
+			wantMeta:    map[string]string{metaCurrentVCPU: "4", metaRecommendedVCPU: "2"},
+			wantWarning: "fewer vCPUs than m5.large (2 vs 4)",
+		},
+		{
+			name:     "unknown capabilities omitted",
+			wantMeta: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &pbc.Recommendation{}
+			applyInstanceCapabilities(rec, "m5.large", "m6g.large", tt.cur, tt.next)
+
+			for key, value := range tt.wantMeta {
+				if got := rec.Metadata[key]; got != value {
+					t.Errorf("Metadata[%q] = %q, want %q", key, got, value)
+				}
+			}
+			if tt.cur == (instanceCapabilities{}) && len(rec.Metadata) != 0 {
+				t.Errorf("Metadata = %v, want empty for unknown capabilities", rec.Metadata)
+			}
+
+			warning := strings.Join(rec.Reasoning, "; ")
+			if tt.wantWarning == "" && warning != "" {
+				t.Errorf("Reasoning = %q, want no warning", warning)
+			}
+			if tt.wantWarning != "" && !strings.Contains(warning, tt.wantWarning) {
+				t.Errorf("Reasoning = %q, want it to contain %q", warning, tt.wantWarning)
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_CrossAZHint verifies a cross_az_data_gb tag adds an advisory
+// recommendation priced at the inter-AZ rate in both directions, for any resource type,
+// and that resources without the tag get none.
+func TestGetRecommendations_CrossAZHint(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		interAZPrice float64
+		wantCost     float64
+		wantHint     bool
+	}{
+		{name: "ec2 with tag", resourceType: "aws:ec2/instance:Instance", sku: "t3.medium",
+			tags: map[string]string{tagCrossAZDataGB: "500"}, interAZPrice: 0.01, wantCost: 10, wantHint: true},
+		{name: "unsupported service with tag", resourceType: "aws:lambda/function:Function",
+			tags: map[string]string{tagCrossAZDataGB: "50"}, interAZPrice: 0.01, wantCost: 1, wantHint: true},
+		{name: "tag absent", resourceType: "aws:ec2/instance:Instance", sku: "t3.medium", interAZPrice: 0.01},
+		{name: "zero volume", resourceType: "aws:ec2/instance:Instance", sku: "t3.medium",
+			tags: map[string]string{tagCrossAZDataGB: "0"}, interAZPrice: 0.01},
+		{name: "pricing unavailable", resourceType: "aws:ec2/instance:Instance", sku: "t3.medium",
+			tags: map[string]string{tagCrossAZDataGB: "500"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2InterAZPrice = tt.interAZPrice
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				}},
+			})
+			if err != nil {
+				t.Fatalf("GetRecommendations() error: %v", err)
+			}
+
+			var hint *pbc.Recommendation
+			for _, rec := range resp.Recommendations {
+				if rec.GetModify().GetModificationType() == modTypeCrossAZ {
+					hint = rec
+				}
+			}
+			if !tt.wantHint {
+				if hint != nil {
+					t.Errorf("unexpected cross-AZ recommendation: %v", hint)
+				}
+				return
+			}
+			if hint == nil {
+				t.Fatal("expected a cross-AZ recommendation")
+			}
+			if hint.Source != sourceAWSPublic {
+				t.Errorf("Source = %q, want %q", hint.Source, sourceAWSPublic)
+			}
+			if hint.Category != pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST {
+				t.Errorf("Category = %v, want COST", hint.Category)
+			}
+			if math.Abs(hint.Impact.GetCurrentCost()-tt.wantCost) > 0.0001 {
+				t.Errorf("CurrentCost = %v, want %v", hint.Impact.GetCurrentCost(), tt.wantCost)
+			}
+			if math.Abs(hint.Impact.GetEstimatedSavings()-tt.wantCost) > 0.0001 {
+				t.Errorf("EstimatedSavings = %v, want %v", hint.Impact.GetEstimatedSavings(), tt.wantCost)
+			}
+			if len(hint.Reasoning) == 0 {
+				t.Error("expected reasoning suggesting co-location or VPC endpoints")
+			}
+		})
+	}
+}
+
+// TestGetRecommendations_S3IntelligentTiering verifies STANDARD buckets with unpredictable
+// access get an Intelligent-Tiering recommendation only when savings exceed the monitoring fee.
+func TestGetRecommendations_S3IntelligentTiering(t *testing.T) {
+	tests := []struct {
+		name        string
+		sku         string
+		tags        map[string]string
+		wantSavings float64 // 0 means no recommendation
+		wantSource  string
+	}{
+		{
+			// 1000 GB * 0.5 * (0.023 - 0.0125) = 5.25, minus 1M objects * 0.0000025 = 2.50
+			name:        "net positive with object count",
+			sku:         "STANDARD",
+			tags:        map[string]string{"access_pattern": "unpredictable", "size": "1000", "object_count": "1000000"},
+			wantSavings: 2.75,
+			wantSource:  "tag",
+		},
+		{
+			// 8,192,000 assumed 128 KB objects cost 20.48/month in monitoring
+			name: "assumed small objects outweigh savings",
+			sku:  "STANDARD",
+			tags: map[string]string{"access_pattern": "unpredictable", "size": "1000"},
+		},
+		{
+			name: "predictable access",
+			sku:  "STANDARD",
+			tags: map[string]string{"access_pattern": "steady", "size": "1000", "object_count": "10"},
+		},
+		{
+			name: "not STANDARD",
+			sku:  "STANDARD_IA",
+			tags: map[string]string{"access_pattern": "unpredictable", "size": "1000", "object_count": "10"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.s3Prices["STANDARD"] = 0.023
+			mock.s3Prices["STANDARD_IA"] = 0.0125
+			mock.s3ITMonitoringPrice = 0.0000025
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{{
+					Provider:     "aws",
+					ResourceType: "aws:s3/bucket:Bucket",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				}},
+			})
+			if err != nil {
+				t.Fatalf("GetRecommendations() error: %v", err)
+			}
+
+			if tt.wantSavings == 0 {
+				if len(resp.Recommendations) != 0 {
+					t.Errorf("expected no recommendations, got %v", resp.Recommendations)
+				}
+				return
+			}
+			if len(resp.Recommendations) != 1 {
+				t.Fatalf("expected 1 recommendation, got %d", len(resp.Recommendations))
+			}
+			rec := resp.Recommendations[0]
+			if rec.GetModify().GetModificationType() != modTypeIntelligentTiering {
+				t.Errorf("ModificationType = %q, want %q", rec.GetModify().GetModificationType(), modTypeIntelligentTiering)
+			}
+			if got := rec.GetModify().GetRecommendedConfig()["storage_class"]; got != "INTELLIGENT_TIERING" {
+				t.Errorf("recommended storage_class = %q, want INTELLIGENT_TIERING", got)
+			}
+			if math.Abs(rec.Impact.GetEstimatedSavings()-tt.wantSavings) > 0.0001 {
+				t.Errorf("EstimatedSavings = %v, want %v", rec.Impact.GetEstimatedSavings(), tt.wantSavings)
+			}
+			if rec.GetConfidenceScore() != confidenceMedium {
+				t.Errorf("ConfidenceScore = %v, want %v", rec.GetConfidenceScore(), confidenceMedium)
+			}
+			if got := rec.Metadata["monitoring_fee_monthly"]; got != "2.50" {
+				t.Errorf("monitoring_fee_monthly = %q, want %q", got, "2.50")
+			}
+			if got := rec.Metadata["object_count_source"]; got != tt.wantSource {
+				t.Errorf("object_count_source = %q, want %q", got, tt.wantSource)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"fmt"
+	"regexp"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	// EnvAllowRegionFallback enables approximate estimates for regions the binary has no
+	// pricing for. Only fallback builds (region "unknown") honor it: their embedded data
+	// is treated as us-east-1 reference pricing and scaled by regionUpliftFactors.
+	EnvAllowRegionFallback = "FINFOCUS_ALLOW_REGION_FALLBACK"
+
+	// EnvDefaultRegion sets the region a fallback build answers for. Requests for it are
+	// estimated from reference pricing (with its regional uplift when known) even when
+	// EnvAllowRegionFallback is off. Regional builds ignore it.
+	EnvDefaultRegion = "FINFOCUS_DEFAULT_REGION"
+	// EnvDefaultRegionDeprecated is the deprecated name for EnvDefaultRegion.
+	EnvDefaultRegionDeprecated = "PULUMICOST_DEFAULT_REGION"
+
+	// fallbackBuildRegion is the region reported by binaries built without a region tag.
+	fallbackBuildRegion = "unknown"
+
+	// referencePricingRegion is the region the fallback build's pricing approximates.
+	referencePricingRegion = "us-east-1"
+)
+
+// regionCodeRE matches AWS region codes such as us-east-1, ap-southeast-2 and us-gov-west-1.
+var regionCodeRE = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+// regionUpliftFactors approximates each region's on-demand price level relative to
+// us-east-1, based on typical EC2/EBS list price ratios. Regions not listed here are
+// only estimated via fallback when configured as the default region.
+var regionUpliftFactors = map[string]float64{
+	"us-east-1":      1.00,
+	"us-east-2":      1.00,
+	"us-west-2":      1.00,
+	"us-west-1":      1.17,
+	"ca-central-1":   1.08,
+	"eu-west-1":      1.11,
+	"eu-central-1":   1.15,
+	"eu-west-2":      1.16,
+	"ap-south-1":     1.05,
+	"ap-southeast-1": 1.22,
+	"ap-southeast-2": 1.24,
+	"ap-northeast-1": 1.26,
+	"sa-east-1":      1.55,
+	"us-gov-west-1":  1.20,
+	"us-gov-east-1":  1.20,
+}
+
+// usesRegionFallback reports whether a request for resourceRegion should be estimated
+// from reference pricing instead of being rejected as a region mismatch.
+func (p *AWSPublicPlugin) usesRegionFallback(resourceRegion string) bool {
+	if p.region != fallbackBuildRegion || resourceRegion == p.region {
+		return false
+	}
+	if p.defaultRegion != "" && resourceRegion == p.defaultRegion {
+		return true
+	}
+	if !p.features.AllowRegionFallback {
+		return false
+	}
+	_, ok := regionUpliftFactors[resourceRegion]
+	return ok
+}
+
+// DefaultRegion returns the region this fallback build answers for, or "" when none is
+// configured.
+func (p *AWSPublicPlugin) DefaultRegion() string {
+	return p.defaultRegion
+}
+
+// SetDefaultRegion sets the region this fallback build answers for, overriding
+// FINFOCUS_DEFAULT_REGION. It must be called before serving. An empty region clears it;
+// a malformed one, or any region on a regional build, is rejected.
+func (p *AWSPublicPlugin) SetDefaultRegion(region string) error {
+	if region == "" {
+		p.defaultRegion = ""
+		return nil
+	}
+	if !regionCodeRE.MatchString(region) {
+		return fmt.Errorf("invalid default region %q: want an AWS region code such as us-east-1", region)
+	}
+	if p.region != fallbackBuildRegion {
+		return fmt.Errorf("default region only applies to fallback builds (this binary prices %s)", p.region)
+	}
+	p.defaultRegion = region
+	return nil
+}
+
+// applyRegionFallback scales a reference-priced response to resourceRegion and labels it
+// as approximate. A region without a known uplift (only reachable as the configured
+// default region) is priced at the reference rate. $0 responses are left unchanged so
+// their explanation is preserved.
+func applyRegionFallback(resp *pbc.GetProjectedCostResponse, resourceRegion string) {
+	if resp == nil || resp.CostPerMonth == 0 {
+		return
+	}
+	uplift, ok := regionUpliftFactors[resourceRegion]
+	if !ok {
+		resp.BillingDetail += fmt.Sprintf(
+			" (approximate: %s reference pricing, no regional uplift known for %s)",
+			referencePricingRegion, resourceRegion)
+		return
+	}
+
+	resp.CostPerMonth *= uplift
+	resp.UnitPrice *= uplift
+	resp.BillingDetail += fmt.Sprintf(
+		" (approximate: %s reference pricing × %.2f regional uplift for %s)",
+		referencePricingRegion, uplift, resourceRegion)
+}
@@ -0,0 +1,240 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_RegionFallback verifies the opt-in reference pricing fallback.
+func TestGetProjectedCost_RegionFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		pluginRegion string
+		allow        string
+		sku          string
+		region       string
+		wantCode     codes.Code
+		wantCost     float64
+		wantContains string
+	}{
+		{
+			name:         "disabled by default",
+			pluginRegion: "unknown",
+			sku:          "t3.micro",
+			region:       "ap-southeast-1",
+			wantCode:     codes.FailedPrecondition,
+		},
+		{
+			name:         "fallback build applies uplift",
+			pluginRegion: "unknown",
+			allow:        "true",
+			sku:          "t3.micro",
+			region:       "ap-southeast-1",
+			wantCost:     0.0104 * 730 * 1.22,
+			wantContains: "approximate: us-east-1 reference pricing × 1.22 regional uplift for ap-southeast-1",
+		},
+		{
+			name:         "region without uplift factor is rejected",
+			pluginRegion: "unknown",
+			allow:        "true",
+			sku:          "t3.micro",
+			region:       "me-central-1",
+			wantCode:     codes.FailedPrecondition,
+		},
+		{
+			name:         "regional build ignores the flag",
+			pluginRegion: "us-east-1",
+			allow:        "true",
+			sku:          "t3.micro",
+			region:       "ap-southeast-1",
+			wantCode:     codes.FailedPrecondition,
+		},
+		{
+			name:         "missing SKU keeps $0 explanation",
+			pluginRegion: "unknown",
+			allow:        "true",
+			sku:          "m5.large",
+			region:       "eu-west-1",
+			wantCost:     0,
+			wantContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvAllowRegionFallback, tt.allow)
+			mock := newMockPricingClient(tt.pluginRegion, "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin(tt.pluginRegion, "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          tt.sku,
+					Region:       tt.region,
+				},
+			})
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("GetProjectedCost() error code = %v, want %v (err: %v)", status.Code(err), tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantContains) {
+				t.Errorf("BillingDetail = %q, want substring %q", resp.BillingDetail, tt.wantContains)
+			}
+		})
+	}
+}
+
+// TestSupports_RegionFallback verifies fallback regions are advertised as partial support.
+func TestSupports_RegionFallback(t *testing.T) {
+	t.Setenv(EnvAllowRegionFallback, "true")
+	plugin := NewAWSPublicPlugin("unknown", "test-version", newMockPricingClient("unknown", "USD"), zerolog.Nop())
+
+	resp, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Sku:          "t3.micro",
+			Region:       "eu-west-1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Supports() returned error: %v", err)
+	}
+	if !resp.Supported {
+		t.Fatalf("Supported = false, want true (reason: %s)", resp.Reason)
+	}
+	if !resp.Capabilities[supportLevelCapabilityPrefix+string(SupportLevelPartial)] {
+		t.Errorf("Capabilities = %v, want partial support level", resp.Capabilities)
+	}
+}
+
+// TestGetProjectedCost_DefaultRegion verifies a fallback build answers for the region
+// configured via FINFOCUS_DEFAULT_REGION without enabling region fallback.
+func TestGetProjectedCost_DefaultRegion(t *testing.T) {
+	tests := []struct {
+		name          string
+		pluginRegion  string
+		defaultRegion string
+		region        string
+		wantCode      codes.Code
+		wantCost      float64
+		wantContains  string
+	}{
+		{
+			name:          "default region applies uplift",
+			pluginRegion:  "unknown",
+			defaultRegion: "eu-west-1",
+			region:        "eu-west-1",
+			wantCost:      0.0104 * 730 * 1.11,
+			wantContains:  "approximate: us-east-1 reference pricing × 1.11 regional uplift for eu-west-1",
+		},
+		{
+			name:          "default region without uplift factor uses reference rate",
+			pluginRegion:  "unknown",
+			defaultRegion: "me-central-1",
+			region:        "me-central-1",
+			wantCost:      0.0104 * 730,
+			wantContains:  "no regional uplift known for me-central-1",
+		},
+		{
+			name:          "other regions stay rejected",
+			pluginRegion:  "unknown",
+			defaultRegion: "eu-west-1",
+			region:        "ap-southeast-1",
+			wantCode:      codes.FailedPrecondition,
+		},
+		{
+			name:          "malformed default region is ignored",
+			pluginRegion:  "unknown",
+			defaultRegion: "Europe",
+			region:        "Europe",
+			wantCode:      codes.FailedPrecondition,
+		},
+		{
+			name:          "regional build ignores default region",
+			pluginRegion:  "us-east-1",
+			defaultRegion: "eu-west-1",
+			region:        "eu-west-1",
+			wantCode:      codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvAllowRegionFallback, "")
+			t.Setenv(EnvDefaultRegion, tt.defaultRegion)
+			mock := newMockPricingClient(tt.pluginRegion, "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			plugin := NewAWSPublicPlugin(tt.pluginRegion, "test-version", mock, zerolog.Nop())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       tt.region,
+				},
+			})
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("GetProjectedCost() error code = %v, want %v (err: %v)", status.Code(err), tt.wantCode, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 0.0001 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantContains) {
+				t.Errorf("BillingDetail = %q, want substring %q", resp.BillingDetail, tt.wantContains)
+			}
+		})
+	}
+}
+
+// TestSetDefaultRegion verifies the programmatic override validates its input.
+func TestSetDefaultRegion(t *testing.T) {
+	t.Setenv(EnvDefaultRegion, "")
+	fallback := NewAWSPublicPlugin("unknown", "test-version", newMockPricingClient("unknown", "USD"), zerolog.Nop())
+	regional := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+
+	if err := fallback.SetDefaultRegion("ap-northeast-1"); err != nil {
+		t.Fatalf("SetDefaultRegion() returned error: %v", err)
+	}
+	if got := fallback.DefaultRegion(); got != "ap-northeast-1" {
+		t.Errorf("DefaultRegion() = %q, want %q", got, "ap-northeast-1")
+	}
+	if regions := fallback.ListRegions(); len(regions) != 2 || regions[1].Region != "ap-northeast-1" {
+		t.Errorf("ListRegions() = %+v, want the build region and ap-northeast-1", regions)
+	}
+	if err := fallback.SetDefaultRegion("us_east_1"); err == nil {
+		t.Error("SetDefaultRegion(\"us_east_1\") returned nil, want a format error")
+	}
+	if err := fallback.SetDefaultRegion(""); err != nil || fallback.DefaultRegion() != "" {
+		t.Errorf("SetDefaultRegion(\"\") = %v, DefaultRegion() = %q, want the default cleared", err, fallback.DefaultRegion())
+	}
+	if err := regional.SetDefaultRegion("eu-west-1"); err == nil {
+		t.Error("SetDefaultRegion() on a regional build returned nil, want an error")
+	}
+}
@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// RegionStatus reports how a region's pricing is available in this binary.
+type RegionStatus string
+
+const (
+	// RegionStatusLoaded means the region's own pricing data is embedded and parsed.
+	RegionStatusLoaded RegionStatus = "loaded"
+
+	// RegionStatusApproximate means the region is estimated from reference pricing
+	// scaled by a regional uplift (fallback builds with FINFOCUS_ALLOW_REGION_FALLBACK).
+	RegionStatusApproximate RegionStatus = "approximate"
+
+	// RegionStatusUnavailable means the binary targets the region but no pricing
+	// data was loaded for it.
+	RegionStatusUnavailable RegionStatus = "unavailable"
+)
+
+// regionsMetadataKey is the GetPluginInfo metadata key carrying the JSON region listing.
+const regionsMetadataKey = "regions"
+
+// schemaDriftMetadataKey is the GetPluginInfo metadata key listing expected AWS product
+// families ("offerCode/family") that the embedded pricing data no longer contains.
+// It is omitted when every parser found its families.
+const schemaDriftMetadataKey = "schema_drift"
+
+// priceInconsistenciesMetadataKey is the GetPluginInfo metadata key listing parsed rates
+// that failed a cross-service sanity check ("offerCode: detail"). It is omitted when all
+// checks passed.
+const priceInconsistenciesMetadataKey = "price_inconsistencies"
+
+// RegionInfo describes one region this plugin can price.
+type RegionInfo struct {
+	Region          string       `json:"region"`
+	Status          RegionStatus `json:"status"`
+	PricingVersion  string       `json:"pricing_version,omitempty"`
+	PricingDate     string       `json:"pricing_date,omitempty"`
+	PricingVintages []string     `json:"pricing_vintages"`
+}
+
+// ListRegions returns the regions this binary can price, with their load status and the
+// vintage of the pricing data used. A single-region binary returns just its region; a
+// fallback build also lists its default region and, with region fallback enabled, every
+// approximated region.
+// Requests for any other region are rejected as a region mismatch.
+//
+// The CostSourceService proto has no region RPC, so gRPC clients receive the same data
+// as JSON in the "regions" metadata entry of GetPluginInfo.
+func (p *AWSPublicPlugin) ListRegions() []RegionInfo {
+	version, date, found := p.pricing.PricingMetadata(serviceOfferCodes["ec2"])
+	vintages := append([]string{pricing.LatestVintage}, p.pricing.Vintages()...)
+
+	own := RegionInfo{
+		Region:          p.region,
+		Status:          RegionStatusLoaded,
+		PricingVersion:  version,
+		PricingDate:     date,
+		PricingVintages: vintages,
+	}
+	if !found {
+		own.Status = RegionStatusUnavailable
+	}
+
+	if p.region != fallbackBuildRegion {
+		return []RegionInfo{own}
+	}
+
+	approximated := make([]string, 0, len(regionUpliftFactors)+1)
+	if p.features.AllowRegionFallback {
+		for region := range regionUpliftFactors {
+			approximated = append(approximated, region)
+		}
+	}
+	if _, listed := regionUpliftFactors[p.defaultRegion]; p.defaultRegion != "" &&
+		(!listed || !p.features.AllowRegionFallback) {
+		approximated = append(approximated, p.defaultRegion)
+	}
+	sort.Strings(approximated)
+
+	regions := []RegionInfo{own}
+	for _, region := range approximated {
+		info := own
+		info.Region = region
+		if found {
+			info.Status = RegionStatusApproximate
+		}
+		regions = append(regions, info)
+	}
+	return regions
+}
+
+// regionsJSON returns the JSON-encoded region listing, or "" if it cannot be encoded.
+func (p *AWSPublicPlugin) regionsJSON() string {
+	data, err := json.Marshal(p.ListRegions())
+	if err != nil {
+		p.logger.Warn().Err(err).Msg("failed to encode region listing")
+		return ""
+	}
+	return string(data)
+}
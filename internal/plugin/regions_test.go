@@ -0,0 +1,139 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestListRegions verifies a single-region binary lists only its own region with its load
+// status and pricing vintages, and that GetPluginInfo carries the same listing as JSON.
+func TestListRegions(t *testing.T) {
+	mock := newMockPricingClient("eu-west-1", "USD")
+	mock.pricingVersions = map[string]string{"AmazonEC2": "20251218235654"}
+	mock.pricingDates = map[string]string{"AmazonEC2": "2025-12-18T23:56:54Z"}
+	mock.vintages = map[string]*mockPricingClient{"2024-01": newMockPricingClient("eu-west-1", "USD")}
+	plugin := NewAWSPublicPlugin("eu-west-1", "test-version", mock, zerolog.Nop())
+
+	want := []RegionInfo{{
+		Region:          "eu-west-1",
+		Status:          RegionStatusLoaded,
+		PricingVersion:  "20251218235654",
+		PricingDate:     "2025-12-18T23:56:54Z",
+		PricingVintages: []string{"latest", "2024-01"},
+	}}
+	if got := plugin.ListRegions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ListRegions() = %+v, want %+v", got, want)
+	}
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() returned error: %v", err)
+	}
+	var got []RegionInfo
+	if err := json.Unmarshal([]byte(resp.Metadata[regionsMetadataKey]), &got); err != nil {
+		t.Fatalf("regions metadata is not valid JSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("regions metadata = %+v, want %+v", got, want)
+	}
+}
+
+// TestListRegions_Status verifies regions without loaded pricing are reported unavailable
+// and that fallback builds list the approximated regions only when fallback is enabled.
+func TestListRegions_Status(t *testing.T) {
+	t.Run("no pricing loaded", func(t *testing.T) {
+		plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+
+		regions := plugin.ListRegions()
+		if len(regions) != 1 || regions[0].Status != RegionStatusUnavailable {
+			t.Errorf("ListRegions() = %+v, want one unavailable region", regions)
+		}
+	})
+
+	for _, allow := range []string{"false", "true"} {
+		t.Run("fallback build allow="+allow, func(t *testing.T) {
+			t.Setenv(EnvAllowRegionFallback, allow)
+			mock := newMockPricingClient(fallbackBuildRegion, "USD")
+			mock.pricingVersions = map[string]string{"AmazonEC2": "20251218235654"}
+			plugin := NewAWSPublicPlugin(fallbackBuildRegion, "test-version", mock, zerolog.Nop())
+
+			regions := plugin.ListRegions()
+			wantLen := 1
+			if allow == "true" {
+				wantLen += len(regionUpliftFactors)
+			}
+			if len(regions) != wantLen {
+				t.Fatalf("ListRegions() returned %d regions, want %d", len(regions), wantLen)
+			}
+			if regions[0].Region != fallbackBuildRegion || regions[0].Status != RegionStatusLoaded {
+				t.Errorf("regions[0] = %+v, want loaded %q", regions[0], fallbackBuildRegion)
+			}
+			for _, r := range regions[1:] {
+				if r.Status != RegionStatusApproximate {
+					t.Errorf("region %s status = %q, want %q", r.Region, r.Status, RegionStatusApproximate)
+				}
+			}
+		})
+	}
+}
+
+// TestGetPluginInfo_SchemaDrift verifies product families missing from the embedded data
+// are listed in the plugin metadata, and the key is omitted when nothing drifted.
+func TestGetPluginInfo_SchemaDrift(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() returned error: %v", err)
+	}
+	if val, ok := resp.Metadata[schemaDriftMetadataKey]; ok {
+		t.Errorf("metadata %s = %q, want omitted", schemaDriftMetadataKey, val)
+	}
+
+	mock.schemaDrift = []string{"AmazonEC2/Compute Instance"}
+	resp, err = plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() returned error: %v", err)
+	}
+	var got []string
+	if err := json.Unmarshal([]byte(resp.Metadata[schemaDriftMetadataKey]), &got); err != nil {
+		t.Fatalf("schema drift metadata is not valid JSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, mock.schemaDrift) {
+		t.Errorf("schema drift metadata = %v, want %v", got, mock.schemaDrift)
+	}
+}
+
+// TestGetPluginInfo_PriceInconsistencies verifies rates failing the cross-service sanity
+// checks are listed in the plugin metadata, and the key is omitted when all checks pass.
+func TestGetPluginInfo_PriceInconsistencies(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() returned error: %v", err)
+	}
+	if val, ok := resp.Metadata[priceInconsistenciesMetadataKey]; ok {
+		t.Errorf("metadata %s = %q, want omitted", priceInconsistenciesMetadataKey, val)
+	}
+
+	mock.priceInconsistencies = []string{"AmazonRDS: gp3 storage $0.04/GB-month is 0.50x EBS gp3 $0.08/GB-month"}
+	resp, err = plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetPluginInfo() returned error: %v", err)
+	}
+	var got []string
+	if err := json.Unmarshal([]byte(resp.Metadata[priceInconsistenciesMetadataKey]), &got); err != nil {
+		t.Fatalf("price inconsistencies metadata is not valid JSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, mock.priceInconsistencies) {
+		t.Errorf("price inconsistencies metadata = %v, want %v", got, mock.priceInconsistencies)
+	}
+}
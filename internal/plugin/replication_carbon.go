@@ -0,0 +1,166 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// tagReplicaRegions lists, comma-separated, the other regions holding a full copy of a
+// resource's storage: DynamoDB global table replicas or S3 Cross-Region Replication
+// destinations. Each copy adds storage carbon at its own region's grid factor; cost is
+// unchanged.
+const tagReplicaRegions = "replica_regions"
+
+// carbonRegionsHeaderKey lists the per-region contributions to a replicated carbon
+// estimate. ImpactMetric has no metadata field, so each region is sent as one
+// JSON-encoded value of this response header, source region first.
+const carbonRegionsHeaderKey = "finfocus-carbon-regions"
+
+// carbonRegions collects the per-region carbon of a replicated estimate, source region
+// first. A nil collector discards it, like assumptions.
+type carbonRegions struct {
+	items []carbon.RegionalCarbon
+}
+
+// set records the per-region carbon. It is a no-op on a nil collector.
+func (c *carbonRegions) set(items []carbon.RegionalCarbon) {
+	if c == nil {
+		return
+	}
+	c.items = items
+}
+
+// source returns the source region's carbon in gCO2e; ok is false when the estimate was
+// not replicated.
+func (c *carbonRegions) source() (grams float64, ok bool) {
+	if c == nil || len(c.items) == 0 {
+		return 0, false
+	}
+	return c.items[0].CarbonGrams, true
+}
+
+// scaleSource multiplies the source region's carbon by scale, leaving the replicas,
+// which sit on their own regions' grids, unchanged.
+func (c *carbonRegions) scaleSource(scale float64) {
+	if c == nil || len(c.items) == 0 {
+		return
+	}
+	c.items[0].CarbonGrams *= scale
+}
+
+// resolve scales the per-region carbon so it sums to resp's carbon metric, carrying over
+// resource_count and any other adjustment made to the metric after estimation. Call it
+// after every adjustment and before display rounding. The regions are dropped when resp
+// has no usable carbon metric.
+func (c *carbonRegions) resolve(resp *pbc.GetProjectedCostResponse) {
+	if c == nil || len(c.items) == 0 {
+		return
+	}
+	var sum float64
+	for _, item := range c.items {
+		sum += item.CarbonGrams
+	}
+	for _, m := range resp.GetImpactMetrics() {
+		if m.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT && m.GetUnit() != carbonUnavailableUnit && sum > 0 {
+			for i := range c.items {
+				c.items[i].CarbonGrams *= m.GetValue() / sum
+			}
+			return
+		}
+	}
+	c.items = nil
+}
+
+// round rounds each region's carbon to places decimal places, like metric_round_to does
+// for the carbon metric.
+func (c *carbonRegions) round(places int) {
+	if c == nil {
+		return
+	}
+	for i := range c.items {
+		c.items[i].CarbonGrams = roundHalfUp(c.items[i].CarbonGrams, places)
+	}
+}
+
+// replicaRegions parses the replica_regions tag, skipping malformed codes (logged),
+// duplicates, and the resource's own region.
+func (p *AWSPublicPlugin) replicaRegions(traceID string, resource *pbc.ResourceDescriptor) []string {
+	seen := map[string]bool{resource.Region: true}
+	var regions []string
+	for _, region := range splitTagKeys(p.tagValue(resource, tagReplicaRegions)) {
+		if !regionCodeRE.MatchString(region) {
+			p.logger.Warn().
+				Str(pluginsdk.FieldTraceID, traceID).
+				Str("tag", tagReplicaRegions).
+				Str("region", region).
+				Msg("ignoring malformed replica region")
+			continue
+		}
+		if !seen[region] {
+			seen[region] = true
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
+// replicationCarbon returns the storage carbon of a resource and its replica_regions
+// copies, each of sizeGB, with a billing-detail note naming the replicas. primaryGrams is
+// the source region's carbon as already estimated. ok is false when no replica regions
+// are set, leaving the estimate unchanged.
+func (p *AWSPublicPlugin) replicationCarbon(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	serviceType, storageClass string,
+	sizeGB, primaryGrams float64,
+	regions *carbonRegions,
+) (total float64, note string, ok bool) {
+	replicas := p.replicaRegions(traceID, resource)
+	if len(replicas) == 0 {
+		return 0, "", false
+	}
+
+	copies := make([]carbon.RegionalStorageConfig, 0, len(replicas))
+	for _, region := range replicas {
+		copies = append(copies, carbon.RegionalStorageConfig{Region: region, SizeGB: sizeGB})
+	}
+	replicaGrams, perRegion := carbon.CalculateReplicatedStorageCarbonGrams(
+		copies, HoursPerMonthProd, serviceType, storageClass)
+
+	regions.set(append([]carbon.RegionalCarbon{{Region: resource.Region, CarbonGrams: primaryGrams}}, perRegion...))
+	return primaryGrams + replicaGrams,
+		fmt.Sprintf(", carbon includes replicas in %s", strings.Join(replicas, ", ")), true
+}
+
+// setCarbonRegionsHeader sends the per-region carbon, as resolved against the final
+// carbon metric, as response headers. It is a no-op outside a gRPC server stream or when
+// the estimate was not replicated.
+func (p *AWSPublicPlugin) setCarbonRegionsHeader(ctx context.Context, traceID string, regions *carbonRegions) {
+	if regions == nil || len(regions.items) == 0 || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+
+	md := metadata.MD{}
+	for _, item := range regions.items {
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			continue
+		}
+		md.Append(carbonRegionsHeaderKey, string(encoded))
+	}
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set carbon regions header")
+	}
+}
@@ -0,0 +1,200 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// TestGetProjectedCost_ReplicaRegions verifies replica_regions adds each copy's storage
+// carbon at its own grid factor, reports the per-region split in a header, and leaves
+// cost unchanged.
+func TestGetProjectedCost_ReplicaRegions(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.s3Prices["STANDARD"] = 0.023
+	mock.dynamoDBPrices["on-demand-read"] = 0.25 / 1_000_000
+	mock.dynamoDBPrices["on-demand-write"] = 1.25 / 1_000_000
+	mock.dynamoDBPrices["storage"] = 0.25
+	mock.dynamoDBPrices["provisioned-rcu"] = 0.00013
+	mock.dynamoDBPrices["provisioned-wcu"] = 0.00065
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(t *testing.T, resourceType, sku string, tags map[string]string) (*pbc.GetProjectedCostResponse, []carbon.RegionalCarbon) {
+		t.Helper()
+		stream := &captureTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: resourceType,
+				Sku:          sku,
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		if len(resp.ImpactMetrics) == 0 {
+			t.Fatal("expected a carbon impact metric")
+		}
+		var regions []carbon.RegionalCarbon
+		for _, v := range stream.header.Get(carbonRegionsHeaderKey) {
+			var rc carbon.RegionalCarbon
+			if err := json.Unmarshal([]byte(v), &rc); err != nil {
+				t.Fatalf("header value %q is not JSON: %v", v, err)
+			}
+			regions = append(regions, rc)
+		}
+		return resp, regions
+	}
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantRegions  []string
+	}{
+		{
+			name: "DynamoDB global table", resourceType: "dynamodb", sku: "on-demand",
+			tags:        map[string]string{"storage_gb": "500"},
+			wantRegions: []string{"us-east-1", "eu-west-1", "ap-southeast-2"},
+		},
+		{
+			name: "DynamoDB provisioned", resourceType: "dynamodb", sku: "provisioned",
+			tags:        map[string]string{"storage_gb": "500", "read_capacity_units": "10", "write_capacity_units": "10"},
+			wantRegions: []string{"us-east-1", "eu-west-1", "ap-southeast-2"},
+		},
+		{
+			name: "S3 cross-region replication", resourceType: "s3", sku: "STANDARD",
+			tags:        map[string]string{"size": "1024"},
+			wantRegions: []string{"us-east-1", "eu-west-1", "ap-southeast-2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, baseRegions := estimate(t, tt.resourceType, tt.sku, tt.tags)
+			if len(baseRegions) != 0 {
+				t.Errorf("header %s = %v, want none without replicas", carbonRegionsHeaderKey, baseRegions)
+			}
+
+			tags := map[string]string{tagReplicaRegions: "eu-west-1, ap-southeast-2,us-east-1,eu-west-1,not a region"}
+			for k, v := range tt.tags {
+				tags[k] = v
+			}
+			resp, regions := estimate(t, tt.resourceType, tt.sku, tags)
+
+			if resp.CostPerMonth != base.CostPerMonth {
+				t.Errorf("CostPerMonth = %v, want unchanged %v", resp.CostPerMonth, base.CostPerMonth)
+			}
+			if len(regions) != len(tt.wantRegions) {
+				t.Fatalf("header regions = %+v, want %v", regions, tt.wantRegions)
+			}
+			var sum float64
+			for i, rc := range regions {
+				if rc.Region != tt.wantRegions[i] {
+					t.Errorf("region[%d] = %q, want %q", i, rc.Region, tt.wantRegions[i])
+				}
+				sum += rc.CarbonGrams
+			}
+			if math.Abs(regions[0].CarbonGrams-base.ImpactMetrics[0].Value) > 1e-9 {
+				t.Errorf("source region carbon = %v, want unreplicated %v", regions[0].CarbonGrams, base.ImpactMetrics[0].Value)
+			}
+			if regions[2].CarbonGrams <= regions[0].CarbonGrams {
+				t.Errorf("Sydney carbon %v should exceed Virginia %v at the same size", regions[2].CarbonGrams, regions[0].CarbonGrams)
+			}
+			if got := resp.ImpactMetrics[0].Value; math.Abs(got-sum) > 1e-9 {
+				t.Errorf("carbon = %v, want per-region sum %v", got, sum)
+			}
+			if !strings.HasSuffix(resp.BillingDetail, ", carbon includes replicas in eu-west-1, ap-southeast-2") {
+				t.Errorf("BillingDetail = %q, want replica note", resp.BillingDetail)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_ReplicaRegionsAdjusted verifies the per-region carbon header
+// follows the adjustments made to the carbon metric: grid_factor rescales only the
+// source region, resource_count scales every region, and metric_round_to rounds each.
+func TestGetProjectedCost_ReplicaRegionsAdjusted(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.s3Prices["STANDARD"] = 0.023
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(t *testing.T, extra map[string]string) (float64, []carbon.RegionalCarbon) {
+		t.Helper()
+		tags := map[string]string{"size": "1024", tagReplicaRegions: "eu-west-1"}
+		for k, v := range extra {
+			tags[k] = v
+		}
+		stream := &captureTransportStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "s3", Sku: "STANDARD", Region: "us-east-1", Tags: tags,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		var regions []carbon.RegionalCarbon
+		for _, v := range stream.header.Get(carbonRegionsHeaderKey) {
+			var rc carbon.RegionalCarbon
+			if err := json.Unmarshal([]byte(v), &rc); err != nil {
+				t.Fatalf("header value %q is not JSON: %v", v, err)
+			}
+			regions = append(regions, rc)
+		}
+		if len(regions) != 2 {
+			t.Fatalf("header regions = %+v, want source and one replica", regions)
+		}
+		return resp.ImpactMetrics[0].Value, regions
+	}
+
+	_, base := estimate(t, nil)
+	regional := carbon.GetGridFactor("us-east-1")
+
+	t.Run("grid factor", func(t *testing.T) {
+		total, regions := estimate(t, map[string]string{tagGridFactor: "0.0001"})
+		if want := base[0].CarbonGrams * 0.0001 / regional; math.Abs(regions[0].CarbonGrams-want) > 1e-6 {
+			t.Errorf("source carbon = %v, want rescaled %v", regions[0].CarbonGrams, want)
+		}
+		if math.Abs(regions[1].CarbonGrams-base[1].CarbonGrams) > 1e-6 {
+			t.Errorf("replica carbon = %v, want unchanged %v", regions[1].CarbonGrams, base[1].CarbonGrams)
+		}
+		if sum := regions[0].CarbonGrams + regions[1].CarbonGrams; math.Abs(total-sum) > 1e-6 {
+			t.Errorf("carbon = %v, want per-region sum %v", total, sum)
+		}
+	})
+
+	t.Run("resource count", func(t *testing.T) {
+		total, regions := estimate(t, map[string]string{"count": "3"})
+		for i := range regions {
+			if want := base[i].CarbonGrams * 3; math.Abs(regions[i].CarbonGrams-want) > 1e-6 {
+				t.Errorf("region[%d] carbon = %v, want %v", i, regions[i].CarbonGrams, want)
+			}
+		}
+		if sum := regions[0].CarbonGrams + regions[1].CarbonGrams; math.Abs(total-sum) > 1e-6 {
+			t.Errorf("carbon = %v, want per-region sum %v", total, sum)
+		}
+	})
+
+	t.Run("metric round to", func(t *testing.T) {
+		_, regions := estimate(t, map[string]string{tagMetricRoundTo: "0"})
+		for i, rc := range regions {
+			if rc.CarbonGrams != math.Round(base[i].CarbonGrams) {
+				t.Errorf("region[%d] carbon = %v, want whole grams %v", i, rc.CarbonGrams, math.Round(base[i].CarbonGrams))
+			}
+		}
+	})
+}
@@ -0,0 +1,50 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// tagResourceCount prices that many identical resources in one call (e.g. the instances
+// of an Auto Scaling group). GetProjectedCostRequest has no quantity field, so it is read
+// from the resource tags. Unset means 1.
+const tagResourceCount = "count"
+
+// resourceCount returns the resource's count tag, defaulting to 1. Anything other than
+// a positive integer is an InvalidArgument error, since silently pricing a single
+// resource would understate a bulk estimate.
+func (p *AWSPublicPlugin) resourceCount(traceID string, resource *pbc.ResourceDescriptor) (int, error) {
+	val, ok := resource.GetTags()[tagResourceCount]
+	if !ok {
+		return 1, nil
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || count < 1 {
+		return 0, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for 'count': %q must be a positive integer", val),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	return count, nil
+}
+
+// applyResourceCount scales a single-resource estimate to count identical resources.
+// CostPerMonth and the impact metrics are multiplied so carbon stays consistent with
+// cost; UnitPrice remains the per-unit rate. Amounts already itemized in the billing
+// detail are per resource, which the appended note makes explicit.
+func applyResourceCount(resp *pbc.GetProjectedCostResponse, count int) {
+	if resp == nil || count == 1 {
+		return
+	}
+
+	perResource := resp.CostPerMonth
+	resp.CostPerMonth *= float64(count)
+	for _, m := range resp.ImpactMetrics {
+		m.Value *= float64(count)
+	}
+	resp.BillingDetail += fmt.Sprintf(" [x%d identical resources, $%.2f/month each]", count, perResource)
+}
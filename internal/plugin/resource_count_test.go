@@ -0,0 +1,75 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_ResourceCount verifies the count tag scales cost and carbon
+// together, keeps the unit price, and notes the count in the billing detail.
+func TestGetProjectedCost_ResourceCount(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(tags map[string]string) (*pbc.GetProjectedCostResponse, error) {
+		return plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+	}
+
+	single, err := estimate(nil)
+	if err != nil {
+		t.Fatalf("GetProjectedCost() error: %v", err)
+	}
+	bulk, err := estimate(map[string]string{"count": "10"})
+	if err != nil {
+		t.Fatalf("GetProjectedCost(count=10) error: %v", err)
+	}
+
+	if math.Abs(bulk.CostPerMonth-single.CostPerMonth*10) > 1e-9 {
+		t.Errorf("CostPerMonth = %v, want %v", bulk.CostPerMonth, single.CostPerMonth*10)
+	}
+	if bulk.UnitPrice != single.UnitPrice {
+		t.Errorf("UnitPrice = %v, want unchanged %v", bulk.UnitPrice, single.UnitPrice)
+	}
+	singleCarbon, _ := carbonFootprint(single)
+	bulkCarbon, ok := carbonFootprint(bulk)
+	if !ok || math.Abs(bulkCarbon-singleCarbon*10) > 1e-6 {
+		t.Errorf("carbon = %v (found %v), want %v", bulkCarbon, ok, singleCarbon*10)
+	}
+	if !strings.Contains(bulk.BillingDetail, "x10 identical resources, $7.59/month each") {
+		t.Errorf("BillingDetail = %q, want the count noted", bulk.BillingDetail)
+	}
+
+	one, err := estimate(map[string]string{"count": "1"})
+	if err != nil {
+		t.Fatalf("GetProjectedCost(count=1) error: %v", err)
+	}
+	if one.CostPerMonth != single.CostPerMonth || one.BillingDetail != single.BillingDetail {
+		t.Errorf("count=1 response = (%v, %q), want same as untagged (%v, %q)",
+			one.CostPerMonth, one.BillingDetail, single.CostPerMonth, single.BillingDetail)
+	}
+
+	for _, val := range []string{"0", "-3", "2.5", "ten", ""} {
+		t.Run("invalid "+val, func(t *testing.T) {
+			_, err := estimate(map[string]string{"count": val})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("GetProjectedCost(count=%q) code = %v, want InvalidArgument", val, status.Code(err))
+			}
+		})
+	}
+}
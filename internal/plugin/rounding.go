@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	// tagRoundTo asks GetProjectedCost to round CostPerMonth and UnitPrice to this many
+	// decimal places for display-oriented clients. GetProjectedCostRequest has no rounding
+	// field, so it is read from the resource tags. Unset means full precision.
+	tagRoundTo = "round_to"
+
+	// tagMetricRoundTo asks GetProjectedCost to round every impact metric value (e.g. the
+	// carbon footprint) to this many decimal places; 0 gives whole grams of CO2e. It is
+	// independent of round_to. Unset means full precision.
+	tagMetricRoundTo = "metric_round_to"
+
+	// maxRoundTo caps round_to; unit prices such as Lambda GB-second rates need ~10 places.
+	maxRoundTo = 10
+)
+
+// roundHalfUp rounds v to places decimal places, with ties toward positive infinity
+// (the same rule as toMinorUnits): 1.86667 -> 1.87, 0.125 -> 0.13 at 2 places.
+// Rounding applies to the binary float value, so an amount stored just below the
+// midpoint (e.g. 1.005) rounds down.
+func roundHalfUp(v float64, places int) float64 {
+	scale := math.Pow10(places)
+	return math.Floor(v*scale+0.5) / scale
+}
+
+// applyRoundTo rounds the response's CostPerMonth and UnitPrice when the resource carries a
+// valid round_to tag, and its impact metric values when it carries a valid metric_round_to
+// tag (each an integer from 0 to maxRoundTo), along with the per-region carbon of a
+// replicated estimate. Invalid values are logged and ignored.
+func (p *AWSPublicPlugin) applyRoundTo(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	resp *pbc.GetProjectedCostResponse,
+	regions *carbonRegions,
+) {
+	if resp == nil {
+		return
+	}
+
+	if places, ok := p.roundToPlaces(traceID, resource, tagRoundTo); ok {
+		resp.CostPerMonth = roundHalfUp(resp.CostPerMonth, places)
+		resp.UnitPrice = roundHalfUp(resp.UnitPrice, places)
+	}
+	if places, ok := p.roundToPlaces(traceID, resource, tagMetricRoundTo); ok {
+		for _, metric := range resp.GetImpactMetrics() {
+			metric.Value = roundHalfUp(metric.GetValue(), places)
+		}
+		regions.round(places)
+	}
+}
+
+// roundToPlaces returns the decimal places requested by the resource's tag. ok is false
+// when the tag is unset or not an integer from 0 to maxRoundTo; invalid values are logged.
+func (p *AWSPublicPlugin) roundToPlaces(traceID string, resource *pbc.ResourceDescriptor, tag string) (places int, ok bool) {
+	val, ok := resource.GetTags()[tag]
+	if !ok {
+		return 0, false
+	}
+
+	places, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || places < 0 || places > maxRoundTo {
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tag).
+			Str("value", val).
+			Msgf("invalid %s, must be an integer from 0 to 10, returning full precision", tag)
+		return 0, false
+	}
+	return places, true
+}
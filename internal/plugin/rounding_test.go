@@ -0,0 +1,138 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestRoundHalfUp verifies half-up rounding at several precisions.
+func TestRoundHalfUp(t *testing.T) {
+	tests := []struct {
+		v      float64
+		places int
+		want   float64
+	}{
+		{1.86667, 2, 1.87},
+		{0.125, 2, 0.13},
+		{7.592, 0, 8},
+		{7.5, 0, 8},
+		{0.0000166667, 6, 0.000017},
+		{0, 2, 0},
+	}
+
+	for _, tt := range tests {
+		if got := roundHalfUp(tt.v, tt.places); got != tt.want {
+			t.Errorf("roundHalfUp(%v, %d) = %v, want %v", tt.v, tt.places, got, tt.want)
+		}
+	}
+}
+
+// TestGetProjectedCost_RoundTo verifies round_to rounds CostPerMonth and UnitPrice and that
+// missing or invalid values keep full precision.
+func TestGetProjectedCost_RoundTo(t *testing.T) {
+	const hourly = 0.0104
+
+	tests := []struct {
+		name      string
+		roundTo   string
+		wantCost  float64
+		wantPrice float64
+	}{
+		{"unset keeps full precision", "", hourly * 730, hourly},
+		{"two places", "2", 7.59, 0.01},
+		{"zero places", "0", 8, 0},
+		{"invalid ignored", "two", hourly * 730, hourly},
+		{"out of range ignored", "11", hourly * 730, hourly},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = hourly
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			tags := map[string]string{}
+			if tt.roundTo != "" {
+				tags[tagRoundTo] = tt.roundTo
+			}
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+					Tags:         tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if resp.CostPerMonth != tt.wantCost {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if resp.UnitPrice != tt.wantPrice {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantPrice)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_MetricRoundTo verifies metric_round_to rounds the carbon metric
+// independently of round_to, and that missing or invalid values keep full precision.
+func TestGetProjectedCost_MetricRoundTo(t *testing.T) {
+	estimate := func(t *testing.T, tags map[string]string) *pbc.GetProjectedCostResponse {
+		t.Helper()
+		mock := newMockPricingClient("us-east-1", "USD")
+		mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+		plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		if len(resp.ImpactMetrics) == 0 {
+			t.Fatal("expected a carbon impact metric")
+		}
+		return resp
+	}
+	full := estimate(t, nil)
+	carbon := full.ImpactMetrics[0].Value
+	if carbon == roundHalfUp(carbon, 1) {
+		t.Fatalf("carbon %v already has at most one decimal; test does not exercise rounding", carbon)
+	}
+
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		wantCarbon float64
+		wantCost   float64
+	}{
+		{"whole grams", map[string]string{tagMetricRoundTo: "0"}, roundHalfUp(carbon, 0), full.CostPerMonth},
+		{"one decimal", map[string]string{tagMetricRoundTo: "1"}, roundHalfUp(carbon, 1), full.CostPerMonth},
+		{"independent of round_to", map[string]string{tagRoundTo: "0"}, carbon, 8},
+		{"invalid ignored", map[string]string{tagMetricRoundTo: "-1"}, carbon, full.CostPerMonth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := estimate(t, tt.tags)
+			if got := resp.ImpactMetrics[0].Value; got != tt.wantCarbon {
+				t.Errorf("carbon = %v, want %v", got, tt.wantCarbon)
+			}
+			if resp.CostPerMonth != tt.wantCost {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+		})
+	}
+}
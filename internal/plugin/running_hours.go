@@ -0,0 +1,116 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// Tags that replace the 730 hrs/month of the hourly estimators (EC2, RDS instance hours and
+// ElastiCache nodes) for resources that only run part of the time.
+const (
+	// tagRunningHoursPerMonth gives the running hours directly, 0 < hours <= 730.
+	tagRunningHoursPerMonth = "running_hours_per_month"
+	// tagSchedule names a running schedule: always-on, weekdays, weekdays-<start>to<end>
+	// or daily-<start>to<end> with whole hours on a 24-hour clock (e.g. weekdays-8to20).
+	// An end at or before the start is read as afternoon, so weekdays-9to5 runs 9:00-17:00.
+	// tagRunningHoursPerMonth takes precedence when both are set.
+	tagSchedule = "schedule"
+)
+
+// weekdayFraction is the share of a 730-hour month falling on Monday-Friday.
+const weekdayFraction = 5.0 / 7.0
+
+// runningHours is the number of billed hours per month for an hourly resource and where
+// the number came from.
+type runningHours struct {
+	hours float64
+	// source is "" for the full 730-hour month, otherwise the tag that reduced it
+	source string
+}
+
+// detail renders the hours for a billing detail, e.g. "730 hrs/month" or
+// "173.81 hrs/month (schedule weekdays-9to5)".
+func (r runningHours) detail() string {
+	if r.source == "" {
+		return "730 hrs/month"
+	}
+	return fmt.Sprintf("%s hrs/month (%s)", strconv.FormatFloat(r.hours, 'f', -1, 64), r.source)
+}
+
+// resourceRunningHours returns the running hours for an hourly resource from the
+// running_hours_per_month or schedule tag, or the full 730-hour month when neither is set.
+// Invalid values are rejected with InvalidArgument rather than silently priced at 730 hours.
+func (p *AWSPublicPlugin) resourceRunningHours(traceID string, resource *pbc.ResourceDescriptor) (runningHours, error) {
+	if val := strings.TrimSpace(p.tagValue(resource, tagRunningHoursPerMonth)); val != "" {
+		hours, err := strconv.ParseFloat(val, 64)
+		if err != nil || !(hours > 0) || hours > carbon.HoursPerMonth {
+			return runningHours{}, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("invalid value for '%s': %q must be a number greater than 0 and at most 730",
+					tagRunningHoursPerMonth, val),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		return runningHours{hours: hours, source: tagRunningHoursPerMonth}, nil
+	}
+
+	if val := strings.TrimSpace(p.tagValue(resource, tagSchedule)); val != "" {
+		hours, err := scheduleHoursPerMonth(val)
+		if err != nil {
+			return runningHours{}, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("invalid value for '%s': %v", tagSchedule, err),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		return runningHours{hours: hours, source: "schedule " + strings.ToLower(val)}, nil
+	}
+
+	return runningHours{hours: carbon.HoursPerMonth}, nil
+}
+
+// scheduleHoursPerMonth converts a schedule tag value to running hours per month, rounded
+// to two decimals.
+func scheduleHoursPerMonth(schedule string) (float64, error) {
+	schedule = strings.ToLower(schedule)
+	switch schedule {
+	case "always-on":
+		return carbon.HoursPerMonth, nil
+	case "weekdays":
+		return roundHours(carbon.HoursPerMonth * weekdayFraction), nil
+	}
+
+	days, window, ok := strings.Cut(schedule, "-")
+	if !ok || (days != "weekdays" && days != "daily") {
+		return 0, fmt.Errorf("%q is not a known schedule (want always-on, weekdays, weekdays-<start>to<end> or daily-<start>to<end>)", schedule)
+	}
+	startStr, endStr, ok := strings.Cut(window, "to")
+	if !ok {
+		return 0, fmt.Errorf("%q has no <start>to<end> hours", schedule)
+	}
+	start, startErr := strconv.Atoi(startStr)
+	end, endErr := strconv.Atoi(endStr)
+	if startErr != nil || endErr != nil || start < 0 || start > 23 || end < 0 || end > 24 {
+		return 0, fmt.Errorf("%q hours must be whole hours from 0 to 24", schedule)
+	}
+	if end <= start {
+		end += 12
+	}
+	if end <= start || end > 24 {
+		return 0, fmt.Errorf("%q does not describe a window within one day", schedule)
+	}
+
+	hours := float64(end-start) * carbon.HoursPerMonth / 24
+	if days == "weekdays" {
+		hours *= weekdayFraction
+	}
+	return roundHours(hours), nil
+}
+
+// roundHours rounds hours to two decimals so billing details stay readable.
+func roundHours(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
@@ -0,0 +1,162 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestScheduleHoursPerMonth verifies named and windowed schedules convert to running hours
+// and that malformed schedules are rejected.
+func TestScheduleHoursPerMonth(t *testing.T) {
+	tests := []struct {
+		schedule string
+		want     float64
+		wantErr  bool
+	}{
+		{schedule: "always-on", want: 730},
+		{schedule: "weekdays", want: 521.43},
+		{schedule: "weekdays-9to5", want: 173.81},
+		{schedule: "Weekdays-9to17", want: 173.81},
+		{schedule: "weekdays-8to20", want: 260.71},
+		{schedule: "daily-8to20", want: 365},
+		{schedule: "daily-0to24", want: 730},
+		{schedule: "nights", wantErr: true},
+		{schedule: "weekends-9to5", wantErr: true},
+		{schedule: "weekdays-9", wantErr: true},
+		{schedule: "weekdays-9to25", wantErr: true},
+		{schedule: "weekdays-22to6", wantErr: true},
+		{schedule: "daily-ninetofive", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			got, err := scheduleHoursPerMonth(tt.schedule)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("scheduleHoursPerMonth(%q) = %v, want error", tt.schedule, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("scheduleHoursPerMonth(%q) returned error: %v", tt.schedule, err)
+			}
+			if got != tt.want {
+				t.Errorf("scheduleHoursPerMonth(%q) = %v, want %v", tt.schedule, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_RunningHours verifies the schedule and running_hours_per_month tags
+// scale the hourly cost and carbon of EC2, RDS and ElastiCache and annotate the detail.
+func TestGetProjectedCost_RunningHours(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	mock.rdsInstancePrices["db.t3.medium/MySQL"] = 0.068
+	mock.rdsStoragePrices["gp2"] = 0.115
+	mock.elasticachePrices["cache.t3.micro:Redis"] = 0.017
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	estimate := func(t *testing.T, resourceType, sku string, tags map[string]string) *pbc.GetProjectedCostResponse {
+		t.Helper()
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: resourceType,
+				Sku:          sku,
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost() returned error: %v", err)
+		}
+		return resp
+	}
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+	}{
+		{
+			name: "EC2 weekdays 9 to 5", resourceType: "ec2", sku: "t3.micro",
+			tags:       map[string]string{tagSchedule: "weekdays-9to5"},
+			wantCost:   0.0104 * 173.81,
+			wantDetail: "173.81 hrs/month (schedule weekdays-9to5)",
+		},
+		{
+			name: "EC2 running hours take precedence", resourceType: "ec2", sku: "t3.micro",
+			tags:       map[string]string{tagRunningHoursPerMonth: "200", tagSchedule: "weekdays"},
+			wantCost:   0.0104 * 200,
+			wantDetail: "200 hrs/month (running_hours_per_month)",
+		},
+		{
+			name: "EC2 capacity reservation bills the full month", resourceType: "ec2", sku: "t3.micro",
+			tags:       map[string]string{tagSchedule: "weekdays-9to5", tagEC2CapacityReservation: "true"},
+			wantCost:   0.0104 * 730,
+			wantDetail: "730 hrs/month reserved-capacity charge",
+		},
+		{
+			name: "RDS storage billed for the full month", resourceType: "rds", sku: "db.t3.medium",
+			tags:       map[string]string{tagRunningHoursPerMonth: "365", "storage_size": "20"},
+			wantCost:   0.068*365 + 0.115*20,
+			wantDetail: "365 hrs/month (running_hours_per_month) + 20GB gp2 storage",
+		},
+		{
+			name: "ElastiCache nodes", resourceType: "elasticache", sku: "cache.t3.micro",
+			tags:       map[string]string{tagSchedule: "daily-8to20", "num_nodes": "2"},
+			wantCost:   0.017 * 2 * 365,
+			wantDetail: "2 nodes, 365 hrs/month (schedule daily-8to20)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := estimate(t, tt.resourceType, tt.sku, tt.tags)
+			if math.Abs(resp.CostPerMonth-tt.wantCost) > 1e-9 {
+				t.Errorf("CostPerMonth = %v, want %v", resp.CostPerMonth, tt.wantCost)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+
+	t.Run("carbon scales with running hours", func(t *testing.T) {
+		full := estimate(t, "ec2", "t3.micro", nil)
+		half := estimate(t, "ec2", "t3.micro", map[string]string{tagRunningHoursPerMonth: "365"})
+		if len(full.ImpactMetrics) == 0 || len(half.ImpactMetrics) == 0 {
+			t.Fatal("expected carbon impact metrics")
+		}
+		if got, want := half.ImpactMetrics[0].Value, full.ImpactMetrics[0].Value/2; math.Abs(got-want) > 1e-6 {
+			t.Errorf("carbon at 365 hrs = %v, want %v", got, want)
+		}
+	})
+
+	for _, tags := range []map[string]string{
+		{tagRunningHoursPerMonth: "0"},
+		{tagRunningHoursPerMonth: "800"},
+		{tagRunningHoursPerMonth: "lots"},
+		{tagSchedule: "weekends"},
+	} {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "ec2", Sku: "t3.micro", Region: "us-east-1", Tags: tags,
+			},
+		})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("tags %v: error = %v, want InvalidArgument", tags, err)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package plugin
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TagType is the value type an estimator expects for a resource tag.
+type TagType string
+
+const (
+	TagTypeInt    TagType = "int"
+	TagTypeFloat  TagType = "float"
+	TagTypeString TagType = "string"
+	TagTypeBool   TagType = "bool"
+)
+
+// tagSchemaMetadataKey is the PricingSpec plugin_metadata key carrying the JSON tag schema.
+const tagSchemaMetadataKey = "tag_schema"
+
+// TagSpec describes one resource tag consumed by an estimator.
+type TagSpec struct {
+	Name        string   `json:"name"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Type        TagType  `json:"type"`
+	Default     string   `json:"default,omitempty"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+}
+
+// ResourceSchema lists the tags the estimator for a service consumes.
+// Services with no tag inputs (e.g. zero-cost networking resources) have an empty Tags slice.
+type ResourceSchema struct {
+	Service string    `json:"service"`
+	Tags    []TagSpec `json:"tags"`
+}
+
+// resourceTagSchemas is the single source of truth for the tags each estimator reads.
+// Keep it in sync with the tag parsing in projected.go and ec2_attrs.go.
+var resourceTagSchemas = map[string][]TagSpec{
+	"ec2": {
+		{Name: "platform", Type: TagTypeString, Default: "linux", Description: "Operating system: linux or windows"},
+		{Name: tagOSStrategy, Type: TagTypeString, Default: osStrategyLinux, Description: "OS used when platform is not set: linux, cheapest (lowest-priced indexed OS) or error (reject)"},
+		{Name: "tenancy", Type: TagTypeString, Default: "shared", Description: "Tenancy: shared, dedicated or host"},
+		{Name: tagEC2CapacityReservation, Type: TagTypeBool, Default: "false", Description: "Price as an On-Demand Capacity Reservation, billed for all 730 hours whether or not an instance runs"},
+		{Name: tagEC2DetailedMonitoring, Type: TagTypeBool, Default: "false", Description: "Add 1-minute CloudWatch monitoring (7 metrics billed at CloudWatch metric rates)"},
+		{Name: tagAnnotateMissingCarbon, Type: TagTypeBool, Default: "false", Description: "Return a zero-valued carbon metric labelled unavailable when the instance type has no carbon data"},
+		{Name: tagRunningHoursPerMonth, Type: TagTypeFloat, Default: "730", Description: "Running hours per month (0-730) for part-time workloads; replaces the 730-hour month"},
+		{Name: tagSchedule, Type: TagTypeString, Default: "always-on", Description: "Running schedule: always-on, weekdays, weekdays-<start>to<end> or daily-<start>to<end> (e.g. weekdays-9to5)"},
+	},
+	"ebs": {
+		{Name: "size", Aliases: []string{"volume_size"}, Type: TagTypeInt, Default: strconv.Itoa(defaultEBSGB), Description: "Volume size in GB"},
+		{Name: tagEBSUsedGB, Type: TagTypeFloat, Description: "Data actually stored in GB; carbon uses it while cost stays on the provisioned size (default: the provisioned size)"},
+		{Name: "iops", Type: TagTypeInt, Description: "Provisioned IOPS (gp3 above 3000, io1/io2; io2 tiered above 32000)"},
+		{Name: "provisioned_iops", Type: TagTypeInt, Description: "Alias for iops"},
+		{Name: "throughput", Type: TagTypeInt, Description: "Provisioned throughput in MiB/s (gp3 above 125)"},
+		{Name: tagEBSVolumes, Type: TagTypeString, Description: "JSON array of {\"type\",\"size\",\"iops\",\"throughput\"} volumes priced together; replaces the single-volume tags"},
+	},
+	"rds": {
+		{Name: "engine", Type: TagTypeString, Default: defaultRDSEngine, Description: "Database engine"},
+		{Name: "storage_type", Type: TagTypeString, Default: defaultRDSStorage, Description: "Storage type: gp2, gp3, io1, io2 or standard; aurora or aurora-io-optimized for Aurora engines (default aurora)"},
+		{Name: "storage_size", Type: TagTypeInt, Default: strconv.Itoa(defaultRDSSizeGB), Description: "Allocated storage in GB"},
+		{Name: tagRDSMaxAllocatedStorage, Type: TagTypeInt, Description: "Storage autoscaling ceiling in GB; adds an upper-bound cost to the billing detail (non-Aurora only)"},
+		{Name: "multi_az", Type: TagTypeBool, Default: "false", Description: "Multi-AZ deployment"},
+		{Name: tagRDSReadReplicas, Type: TagTypeInt, Default: "0", Description: "Read replicas (0-15) billed at the instance rate; non-Aurora replicas also bill their own allocated storage"},
+		{Name: "pricing_model", Type: TagTypeString, Default: rdsPricingOnDemand, Description: "Pricing model: on-demand or reserved-1yr (1yr No Upfront)"},
+		{Name: "io_requests_per_month", Type: TagTypeInt, Default: "0", Description: "Aurora Standard I/O requests per month"},
+		{Name: tagAuroraStorageGB, Type: TagTypeInt, Description: "Aurora cluster volume size in GB; takes precedence over storage_size for Aurora engines"},
+		{Name: tagAuroraIORequests, Type: TagTypeInt, Description: "Aurora Standard I/O requests per month; takes precedence over io_requests_per_month"},
+		{Name: tagRunningHoursPerMonth, Type: TagTypeFloat, Default: "730", Description: "Running hours per month (0-730) for part-time workloads; replaces the 730-hour month"},
+		{Name: tagSchedule, Type: TagTypeString, Default: "always-on", Description: "Running schedule: always-on, weekdays, weekdays-<start>to<end> or daily-<start>to<end> (e.g. weekdays-9to5)"},
+	},
+	"eks": {
+		{Name: "support_type", Type: TagTypeString, Default: "standard", Description: "Cluster support tier: standard or extended"},
+		{Name: tagEKSFargateVCPU, Type: TagTypeFloat, Default: "0", Description: "vCPU per Fargate pod; with fargate_memory_gb adds Fargate pod cost"},
+		{Name: tagEKSFargateMemoryGB, Type: TagTypeFloat, Default: "0", Description: "Memory in GB per Fargate pod"},
+		{Name: tagEKSNumPods, Type: TagTypeInt, Default: "1", Description: "Number of Fargate pods"},
+	},
+	"s3": {
+		{Name: "size", Type: TagTypeFloat, Default: "1", Description: "Stored data in GB"},
+		{Name: tagS3RetrievalGB, Type: TagTypeFloat, Default: "0", Description: "Data retrieved per month in GB; priced for IA, Glacier and Deep Archive classes"},
+		{Name: tagReplicaRegions, Type: TagTypeString, Description: "Comma-separated Cross-Region Replication destination regions; adds their storage carbon (cost unchanged)"},
+	},
+	"lambda": {
+		{Name: "requests_per_month", Type: TagTypeInt, Default: "0", Description: "Invocations per month"},
+		{Name: "avg_duration_ms", Type: TagTypeInt, Default: "100", Description: "Average invocation duration in milliseconds"},
+		{Name: "arch", Aliases: []string{"architecture"}, Type: TagTypeString, Default: "x86_64", Description: "Architecture: x86_64 or arm64"},
+	},
+	"dynamodb": {
+		{Name: "storage_gb", Type: TagTypeFloat, Default: "0", Description: "Table storage in GB"},
+		{Name: "read_capacity_units", Type: TagTypeInt, Default: "0", Description: "Provisioned read capacity units (SKU provisioned)"},
+		{Name: "write_capacity_units", Type: TagTypeInt, Default: "0", Description: "Provisioned write capacity units (SKU provisioned)"},
+		{Name: "read_requests_per_month", Type: TagTypeInt, Default: "0", Description: "On-demand read request units per month"},
+		{Name: "write_requests_per_month", Type: TagTypeInt, Default: "0", Description: "On-demand write request units per month"},
+		{Name: tagReplicaRegions, Type: TagTypeString, Description: "Comma-separated global table replica regions; adds their storage carbon (cost unchanged)"},
+	},
+	"elb": {
+		{Name: "lcu_per_hour", Type: TagTypeFloat, Default: "0", Description: "ALB capacity units per hour"},
+		{Name: "nlcu_per_hour", Type: TagTypeFloat, Default: "0", Description: "NLB capacity units per hour"},
+		{Name: "capacity_units", Type: TagTypeFloat, Default: "0", Description: "Generic capacity units per hour (either load balancer type)"},
+		{Name: tagOutposts, Type: TagTypeBool, Default: "false", Description: "Price at AWS Outposts rates; components without an Outposts rate keep the regional one"},
+	},
+	"natgw": {
+		{Name: "data_processed_gb", Type: TagTypeFloat, Default: "0", Description: "Data processed per month in GB"},
+	},
+	"cloudwatch": {
+		{Name: "log_ingestion_gb", Type: TagTypeFloat, Default: "0", Description: "Log data ingested per month in GB"},
+		{Name: "log_storage_gb", Type: TagTypeFloat, Default: "0", Description: "Archived log data in GB"},
+		{Name: tagCloudWatchRetentionDays, Type: TagTypeFloat, Description: "Log retention in days; derives stored GB from log_ingestion_gb when log_storage_gb is unset"},
+		{Name: "custom_metrics", Type: TagTypeFloat, Default: "0", Description: "Number of custom metrics"},
+	},
+	"elasticache": {
+		{Name: "engine", Type: TagTypeString, Default: "redis", Description: "Cache engine: redis, memcached or valkey"},
+		{Name: "num_nodes", Aliases: []string{"num_cache_nodes"}, Type: TagTypeInt, Default: "1", Description: "Number of cache nodes"},
+		{Name: tagRunningHoursPerMonth, Type: TagTypeFloat, Default: "730", Description: "Running hours per month (0-730) for part-time workloads; replaces the 730-hour month"},
+		{Name: tagSchedule, Type: TagTypeString, Default: "always-on", Description: "Running schedule: always-on, weekdays, weekdays-<start>to<end> or daily-<start>to<end> (e.g. weekdays-9to5)"},
+	},
+	"ecr": {
+		{Name: "storage_gb", Type: TagTypeFloat, Default: "0", Description: "Stored image data in GB"},
+		{Name: "data_transfer_out_gb", Type: TagTypeFloat, Default: "0", Description: "Image data pulled to the internet per month in GB"},
+	},
+	"secretsmanager": {
+		{Name: "api_calls_per_month", Type: TagTypeInt, Default: "0", Description: "Secrets Manager API calls per month"},
+	},
+	"kms": {
+		{Name: "api_calls_per_month", Type: TagTypeInt, Default: "0", Description: "Symmetric key requests per month"},
+		{Name: tagFreeTier, Type: TagTypeBool, Default: "false", Description: "Subtract the 20,000 free requests per month shared by every key in the account"},
+	},
+	"waf": {
+		{Name: "rules", Type: TagTypeInt, Default: strconv.Itoa(wafDefaultRules), Description: "Rules and rule groups in the web ACL"},
+		{Name: "requests_per_month", Type: TagTypeInt, Default: "0", Description: "Web requests inspected per month"},
+	},
+	"athena": {
+		{Name: tagAthenaDataScannedTB, Type: TagTypeFloat, Default: "0", Description: "Data scanned by SQL queries per month in TB"},
+	},
+	"glue": {
+		{Name: tagGlueDPUHours, Type: TagTypeFloat, Default: "0", Description: "DPU-hours consumed per month; the SKU selects the execution class (standard or flex)"},
+	},
+	"ses": {
+		{Name: tagSESEmailsPerMonth, Type: TagTypeInt, Default: "0", Description: "Outbound emails (recipients) sent per month"},
+		{Name: tagSESDataGB, Type: TagTypeFloat, Default: "0", Description: "Attachment data sent per month in GB"},
+		{Name: tagFreeTier, Type: TagTypeBool, Default: "false", Description: "Subtract the 3,000 free emails per month of the first 12 months"},
+	},
+	"stepfunctions": {
+		{Name: tagSFNType, Type: TagTypeString, Default: sfnTypeStandard, Description: "Workflow type: standard or express"},
+		{Name: tagSFNStateTransitions, Type: TagTypeInt, Default: "0", Description: "State transitions per month (Standard workflows)"},
+		{Name: "requests_per_month", Type: TagTypeInt, Default: "0", Description: "Workflow executions per month (Express workflows)"},
+		{Name: "avg_duration_ms", Type: TagTypeInt, Default: strconv.Itoa(sfnDurationIncrementMs), Description: "Average execution duration in milliseconds (Express workflows)"},
+		{Name: tagSFNMemoryMB, Type: TagTypeInt, Default: strconv.Itoa(sfnMemoryIncrementMB), Description: "Memory used per execution in MB (Express workflows)"},
+	},
+}
+
+// GetResourceSchema returns the tags consumed by the estimator for resourceType, with
+// their types, defaults and whether they are required. Clients can use it to build
+// forms and validate tags before calling GetProjectedCost.
+//
+// The CostSourceService proto has no schema RPC, so gRPC clients receive the same data
+// as JSON in the "tag_schema" plugin_metadata entry of GetPricingSpec.
+func (p *AWSPublicPlugin) GetResourceSchema(resourceType string) (*ResourceSchema, error) {
+	service := newServiceResolver(resourceType).ServiceType()
+
+	if IsZeroCostService(service) {
+		return &ResourceSchema{Service: service, Tags: []TagSpec{}}, nil
+	}
+
+	tags, ok := resourceTagSchemas[service]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "resource type %q not supported", resourceType)
+	}
+
+	return &ResourceSchema{Service: service, Tags: append([]TagSpec(nil), tags...)}, nil
+}
+
+// tagSchemaJSON returns the JSON-encoded tag schema for resourceType, or "" if none exists.
+func (p *AWSPublicPlugin) tagSchemaJSON(resourceType string) string {
+	schema, err := p.GetResourceSchema(resourceType)
+	if err != nil {
+		return ""
+	}
+	data, err := json.Marshal(schema)
+	if err != nil {
+		p.logger.Warn().Err(err).Str("resource_type", resourceType).Msg("failed to encode tag schema")
+		return ""
+	}
+	return string(data)
+}
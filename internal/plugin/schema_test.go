@@ -0,0 +1,97 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetResourceSchema verifies tag schemas are returned per service.
+func TestGetResourceSchema(t *testing.T) {
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.Nop())
+
+	tests := []struct {
+		name         string
+		resourceType string
+		wantService  string
+		wantTags     []string
+	}{
+		{name: "EBS", resourceType: "aws:ebs/volume:Volume", wantService: "ebs", wantTags: []string{"size", "used_gb", "iops", "provisioned_iops", "throughput", "volumes"}},
+		{name: "Lambda", resourceType: "lambda", wantService: "lambda", wantTags: []string{"requests_per_month", "avg_duration_ms", "arch"}},
+		{name: "CloudWatch", resourceType: "cloudwatch", wantService: "cloudwatch", wantTags: []string{"log_ingestion_gb", "log_storage_gb", "retention_days", "custom_metrics"}},
+		{name: "zero-cost VPC", resourceType: "aws:ec2/vpc:Vpc", wantService: "vpc", wantTags: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := plugin.GetResourceSchema(tt.resourceType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantService, schema.Service)
+
+			names := make([]string, 0, len(schema.Tags))
+			for _, tag := range schema.Tags {
+				names = append(names, tag.Name)
+			}
+			assert.Equal(t, tt.wantTags, names)
+		})
+	}
+
+	t.Run("EBS size default and alias", func(t *testing.T) {
+		schema, err := plugin.GetResourceSchema("ebs")
+		require.NoError(t, err)
+		size := schema.Tags[0]
+		assert.Equal(t, TagTypeInt, size.Type)
+		assert.Equal(t, "8", size.Default)
+		assert.Equal(t, []string{"volume_size"}, size.Aliases)
+		assert.False(t, size.Required)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := plugin.GetResourceSchema("aws:kinesis/stream:Stream")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestResourceTagSchemas_CoverSupportedServices ensures every estimated service has a schema.
+func TestResourceTagSchemas_CoverSupportedServices(t *testing.T) {
+	for service := range ServiceSupportLevels {
+		if IsZeroCostService(service) {
+			continue
+		}
+		_, ok := resourceTagSchemas[service]
+		assert.True(t, ok, "missing tag schema for service %q", service)
+	}
+}
+
+// TestGetPricingSpec_TagSchemaMetadata verifies the schema is exposed over gRPC via plugin_metadata.
+func TestGetPricingSpec_TagSchemaMetadata(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ebs",
+			Sku:          "gp3",
+			Region:       "us-east-1",
+		},
+	})
+	require.NoError(t, err)
+
+	raw, ok := resp.Spec.PluginMetadata[tagSchemaMetadataKey]
+	require.True(t, ok, "tag_schema metadata missing")
+
+	var schema ResourceSchema
+	require.NoError(t, json.Unmarshal([]byte(raw), &schema))
+	assert.Equal(t, "ebs", schema.Service)
+	require.NotEmpty(t, schema.Tags)
+	assert.Equal(t, "size", schema.Tags[0].Name)
+}
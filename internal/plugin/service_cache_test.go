@@ -368,6 +368,14 @@ func TestServiceResolver_AllSupportedServices(t *testing.T) {
 		{"natgw", "natgw"},
 		{"cloudwatch", "cloudwatch"},
 		{"elasticache", "elasticache"},
+		{"ecr", "ecr"},
+		{"secretsmanager", "secretsmanager"},
+		{"kms", "kms"},
+		{"waf", "waf"},
+		{"athena", "athena"},
+		{"glue", "glue"},
+		{"ses", "ses"},
+		{"stepfunctions", "stepfunctions"},
 
 		// ALB/NLB are normalized to ELB by detectService
 		{"alb", "elb"},
@@ -383,6 +391,19 @@ func TestServiceResolver_AllSupportedServices(t *testing.T) {
 		{"aws:dynamodb/table:Table", "dynamodb"},
 		{"aws:cloudwatch/logGroup:LogGroup", "cloudwatch"},
 		{"aws:elasticache/cluster:Cluster", "elasticache"},
+		{"aws:ecr/repository:Repository", "ecr"},
+		{"aws:secretsmanager/secret:Secret", "secretsmanager"},
+		{"aws:kms/key:Key", "kms"},
+		{"aws:wafv2/webAcl:WebAcl", "waf"},
+		{"aws:athena/workgroup:Workgroup", "athena"},
+		{"aws:glue/job:Job", "glue"},
+		{"aws:glue/crawler:Crawler", "glue"},
+		{"aws:ses/configurationSet:ConfigurationSet", "ses"},
+		{"aws:sesv2/configurationSet:ConfigurationSet", "ses"},
+		// SES identities are not sending resources and are not priced as SES
+		{"aws:ses/domainIdentity:DomainIdentity", "aws:ses/domainIdentity:DomainIdentity"},
+		{"aws:sesv2/emailIdentity:EmailIdentity", "aws:sesv2/emailIdentity:EmailIdentity"},
+		{"aws:sfn/stateMachine:StateMachine", "stepfunctions"},
 		// Note: aws:ec2/natGateway:NatGateway currently resolves to "ec2" because
 		// normalizeResourceType() extracts just the service prefix ("ec2"), not the
 		// subresource. This is consistent with the two-step normalization pattern.
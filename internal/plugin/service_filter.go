@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	// EnvEnabledServices restricts the binary to the listed comma-separated canonical
+	// services (e.g. "ec2,ebs,rds"). Requests for other recognized services return
+	// codes.Unimplemented and Supports reports them unsupported. Unset enables every
+	// service.
+	EnvEnabledServices = "FINFOCUS_ENABLED_SERVICES"
+
+	// enabledServicesMetadataKey is the GetPluginInfo metadata key listing the enabled
+	// services, comma-separated, when EnvEnabledServices restricts them.
+	enabledServicesMetadataKey = "enabled_services"
+)
+
+// parseEnabledServices returns the service allowlist from a comma-separated list, or
+// nil when every service is enabled. Names the plugin does not recognize are logged and
+// skipped; a list with no recognized names enables every service, so a typo cannot
+// silently disable the binary.
+func parseEnabledServices(list string, logger zerolog.Logger) map[string]bool {
+	names := splitTagKeys(list)
+	if len(names) == 0 {
+		return nil
+	}
+	enabled := make(map[string]bool, len(names))
+	for _, name := range names {
+		if GetSupportLevel(name) == SupportLevelUnsupported {
+			logger.Warn().
+				Str("variable", EnvEnabledServices).
+				Str("service", name).
+				Msg("unknown service in enabled services list, ignoring")
+			continue
+		}
+		enabled[name] = true
+	}
+	if len(enabled) == 0 {
+		logger.Warn().
+			Str("variable", EnvEnabledServices).
+			Str("value", list).
+			Msg("no known services in enabled services list, enabling all services")
+		return nil
+	}
+	logger.Info().
+		Str("enabled_services", joinServices(enabled)).
+		Msg("service allowlist configured")
+	return enabled
+}
+
+// serviceDisabled reports whether a recognized service is excluded by the allowlist.
+// Unrecognized services are never disabled; they keep their unsupported handling.
+func (p *AWSPublicPlugin) serviceDisabled(service string) bool {
+	return p.enabledServices != nil &&
+		p.supportLevel(service) != SupportLevelUnsupported &&
+		!p.enabledServices[service]
+}
+
+// joinServices returns the services as a sorted comma-separated list.
+func joinServices(services map[string]bool) string {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
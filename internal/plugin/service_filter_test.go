@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestParseEnabledServices verifies the allowlist is normalized, skips unknown names, and
+// enables every service when nothing usable is listed.
+func TestParseEnabledServices(t *testing.T) {
+	tests := []struct {
+		name string
+		list string
+		want string // joined allowlist; "" means every service is enabled
+	}{
+		{name: "unset", list: "", want: ""},
+		{name: "normalized", list: " EC2, ebs ,,rds", want: "ebs,ec2,rds"},
+		{name: "unknown names skipped", list: "ec2,bogus", want: "ec2"},
+		{name: "zero-cost services allowed", list: "vpc,iam", want: "iam,vpc"},
+		{name: "no known names", list: "bogus,nope", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseEnabledServices(tt.list, zerolog.Nop())
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("parseEnabledServices(%q) = %v, want nil", tt.list, got)
+				}
+				return
+			}
+			if joined := joinServices(got); joined != tt.want {
+				t.Errorf("parseEnabledServices(%q) = %q, want %q", tt.list, joined, tt.want)
+			}
+		})
+	}
+}
+
+// TestServiceAllowlist verifies disabled services return Unimplemented from the cost RPCs
+// and are reported unsupported, while enabled and unrecognized types behave as before.
+func TestServiceAllowlist(t *testing.T) {
+	newPlugin := func(t *testing.T, enabled string) *AWSPublicPlugin {
+		t.Setenv(EnvEnabledServices, enabled)
+		mock := newMockPricingClient("us-east-1", "USD")
+		mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+		mock.ebsPrices["gp3"] = 0.08
+		return NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	}
+	ec2 := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "t3.micro", Region: "us-east-1"}
+	ebs := &pbc.ResourceDescriptor{
+		Provider: "aws", ResourceType: "ebs", Sku: "gp3", Region: "us-east-1",
+		Tags: map[string]string{"size": "100"},
+	}
+
+	t.Run("all services enabled by default", func(t *testing.T) {
+		p := newPlugin(t, "")
+		for _, resource := range []*pbc.ResourceDescriptor{ec2, ebs} {
+			resp, err := p.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: resource})
+			if err != nil {
+				t.Fatalf("GetProjectedCost(%s) error: %v", resource.ResourceType, err)
+			}
+			if resp.CostPerMonth <= 0 {
+				t.Errorf("GetProjectedCost(%s) CostPerMonth = %v, want > 0", resource.ResourceType, resp.CostPerMonth)
+			}
+		}
+	})
+
+	t.Run("disabled service returns Unimplemented", func(t *testing.T) {
+		p := newPlugin(t, "ec2")
+
+		if _, err := p.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: ec2}); err != nil {
+			t.Fatalf("GetProjectedCost(ec2) error: %v", err)
+		}
+
+		_, err := p.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: ebs})
+		if status.Code(err) != codes.Unimplemented {
+			t.Fatalf("GetProjectedCost(ebs) error = %v, want Unimplemented", err)
+		}
+
+		_, err = p.getProjectedForResource(context.Background(), "trace", ebs, nil)
+		if status.Code(err) != codes.Unimplemented {
+			t.Errorf("getProjectedForResource(ebs) error = %v, want Unimplemented", err)
+		}
+	})
+
+	t.Run("Supports reports disabled services", func(t *testing.T) {
+		p := newPlugin(t, "ec2")
+
+		resp, err := p.Supports(context.Background(), &pbc.SupportsRequest{Resource: ebs})
+		if err != nil {
+			t.Fatalf("Supports(ebs) error: %v", err)
+		}
+		if resp.Supported {
+			t.Error("Supports(ebs) = true, want false for a disabled service")
+		}
+		if want := `Service "ebs" is disabled in this deployment (FINFOCUS_ENABLED_SERVICES)`; resp.Reason != want {
+			t.Errorf("Supports(ebs) reason = %q, want %q", resp.Reason, want)
+		}
+
+		resp, err = p.Supports(context.Background(), &pbc.SupportsRequest{Resource: ec2})
+		if err != nil {
+			t.Fatalf("Supports(ec2) error: %v", err)
+		}
+		if !resp.Supported {
+			t.Errorf("Supports(ec2) = false (%s), want true", resp.Reason)
+		}
+	})
+
+	t.Run("unrecognized types keep the unsupported response", func(t *testing.T) {
+		p := newPlugin(t, "ec2")
+		resp, err := p.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "aws:foo/bar:Baz", Sku: "x", Region: "us-east-1"},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost(unknown) error: %v", err)
+		}
+		if resp.CostPerMonth != 0 {
+			t.Errorf("GetProjectedCost(unknown) CostPerMonth = %v, want 0", resp.CostPerMonth)
+		}
+	})
+
+	t.Run("plugin info lists the allowlist", func(t *testing.T) {
+		p := newPlugin(t, "rds,ec2")
+		info, err := p.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+		if err != nil {
+			t.Fatalf("GetPluginInfo() error: %v", err)
+		}
+		if got := info.Metadata[enabledServicesMetadataKey]; got != "ec2,rds" {
+			t.Errorf("metadata %s = %q, want %q", enabledServicesMetadataKey, got, "ec2,rds")
+		}
+	})
+}
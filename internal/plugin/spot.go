@@ -0,0 +1,109 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// modTypeSpot is the modification type for running the same instance type on Spot capacity.
+	modTypeSpot = "spot"
+
+	// spotOpportunityTrailerKey is the gRPC trailer key carrying the batch's total monthly
+	// Spot savings across interruptible EC2 resources. RecommendationSummary has no
+	// field for it.
+	spotOpportunityTrailerKey = "finfocus-spot-opportunity-savings"
+)
+
+// getSpotRecommendation returns a recommendation to run an interruptible EC2 instance on
+// Spot capacity with the same instance type, assuming spotDiscountFactor off On-Demand.
+// Returns nil if the instance type has no On-Demand pricing.
+func (p *AWSPublicPlugin) getSpotRecommendation(instanceType, region string) *pbc.Recommendation {
+	price, found := p.pricing.EC2OnDemandPricePerHour(instanceType, "Linux", "Shared")
+	if !found || price <= 0 {
+		return nil
+	}
+
+	currentMonthly := price * carbon.HoursPerMonth
+	spotMonthly := currentMonthly * (1 - spotDiscountFactor)
+	savings := currentMonthly - spotMonthly
+
+	confidence := confidenceLow
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: "ec2",
+			Region:       region,
+			Sku:          instanceType,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modTypeSpot,
+				CurrentConfig:     map[string]string{"instance_type": instanceType, "purchase_option": "on-demand"},
+				RecommendedConfig: map[string]string{"instance_type": instanceType, "purchase_option": "spot"},
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  savings,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     spotMonthly,
+			SavingsPercentage: spotDiscountFactor * 100,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW,
+		ConfidenceScore: &confidence,
+		Description: fmt.Sprintf("Run interruptible workload on %s Spot for ~%.0f%% cost savings",
+			instanceType, spotDiscountFactor*100),
+		Reasoning: []string{
+			fmt.Sprintf("Assumes a %.0f%% Spot discount off the On-Demand rate; actual Spot prices vary",
+				spotDiscountFactor*100),
+			"Spot instances can be interrupted with a two-minute notice when AWS reclaims capacity",
+			"Only suitable for fault-tolerant, stateless or checkpointed workloads",
+		},
+		Source: sourceAWSPublic,
+	}
+}
+
+// spotOpportunity returns the largest Spot savings among one resource's recommendations.
+// Same-type and Graviton Spot are alternatives, so only the best one counts; ok is false
+// when recs has no Spot recommendation.
+func spotOpportunity(recs []*pbc.Recommendation) (savings float64, ok bool) {
+	for _, rec := range recs {
+		modType := rec.GetModify().GetModificationType()
+		if modType != modTypeSpot && modType != modTypeGravitonSpot {
+			continue
+		}
+		if s := rec.GetImpact().GetEstimatedSavings(); !ok || s > savings {
+			savings = s
+			ok = true
+		}
+	}
+	return savings, ok
+}
+
+// setSpotOpportunityTrailer attaches the batch's total Spot savings to the gRPC response
+// trailer. It is a no-op outside a gRPC server stream.
+func (p *AWSPublicPlugin) setSpotOpportunityTrailer(ctx context.Context, traceID string, total float64) {
+	if grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	md := metadata.Pairs(spotOpportunityTrailerKey, strconv.FormatFloat(total, 'f', 2, 64))
+	if err := grpc.SetTrailer(ctx, md); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set spot opportunity trailer")
+	}
+}
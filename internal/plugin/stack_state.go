@@ -0,0 +1,252 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// awsProviderType is the Pulumi type of AWS provider resources; their "region" input is
+// the region of every resource that references them.
+const awsProviderType = "pulumi:providers:aws"
+
+// StackResource is one AWS resource from a Pulumi stack export. Descriptor is nil when
+// the resource cannot be priced, with the reason in Skipped.
+type StackResource struct {
+	URN        string
+	Type       string
+	Descriptor *pbc.ResourceDescriptor
+	Skipped    string
+}
+
+// Name returns the resource name, the last segment of its URN.
+func (r StackResource) Name() string {
+	if i := strings.LastIndex(r.URN, "::"); i >= 0 {
+		return r.URN[i+2:]
+	}
+	return r.URN
+}
+
+// stackExport is the subset of `pulumi stack export` output read for cost estimation.
+type stackExport struct {
+	Deployment struct {
+		Resources []stackExportResource `json:"resources"`
+	} `json:"deployment"`
+}
+
+// stackExportResource is one resource of a stack export's deployment.
+type stackExportResource struct {
+	URN      string         `json:"urn"`
+	ID       string         `json:"id"`
+	Type     string         `json:"type"`
+	Custom   bool           `json:"custom"`
+	Provider string         `json:"provider"` // "<provider URN>::<provider ID>"
+	Inputs   map[string]any `json:"inputs"`
+}
+
+// stackInputMapping derives a ResourceDescriptor from a Pulumi resource's inputs.
+type stackInputMapping struct {
+	// resourceType replaces the Pulumi type sent as ResourceType when normalization
+	// resolves it to the wrong service: aws:ec2/natGateway:NatGateway normalizes to "ec2"
+	resourceType string
+	// sku returns the SKU for the inputs, or "" when they do not name one
+	sku func(inputs map[string]any) string
+	// tags maps Pulumi input names to the estimator tags they populate
+	tags map[string]string
+}
+
+// fixedSKU returns a stackInputMapping.sku that ignores the inputs.
+func fixedSKU(sku string) func(map[string]any) string {
+	return func(map[string]any) string { return sku }
+}
+
+// inputSKU returns a stackInputMapping.sku that reads one input, falling back to def.
+func inputSKU(key, def string) func(map[string]any) string {
+	return func(inputs map[string]any) string {
+		if v := scalarInput(inputs[key]); v != "" {
+			return v
+		}
+		return def
+	}
+}
+
+// stackInputMappings gives, per exact Pulumi type token, how the Pulumi AWS provider's
+// input properties map to the SKU and tags each estimator reads (see resourceTagSchemas).
+// Keys are exact so that sub-resources of a priced service, such as a load balancer
+// listener or a KMS alias, are skipped instead of priced as their parent. Zero-cost
+// services need no mapping.
+var stackInputMappings = map[string]stackInputMapping{
+	"aws:ec2/instance:Instance": {
+		sku:  inputSKU("instanceType", ""),
+		tags: map[string]string{"tenancy": "tenancy"},
+	},
+	"aws:ebs/volume:Volume": {
+		sku:  inputSKU("type", "gp2"),
+		tags: map[string]string{"size": "size", "iops": "iops", "throughput": "throughput"},
+	},
+	"aws:rds/instance:Instance": {
+		sku: inputSKU("instanceClass", ""),
+		tags: map[string]string{
+			"engine":              "engine",
+			"storageType":         "storage_type",
+			"allocatedStorage":    "storage_size",
+			"maxAllocatedStorage": tagRDSMaxAllocatedStorage,
+			"multiAz":             "multi_az",
+		},
+	},
+	"aws:eks/cluster:Cluster":  {sku: fixedSKU("cluster")},
+	"aws:s3/bucket:Bucket":     {sku: fixedSKU("STANDARD")},
+	"aws:s3/bucketV2:BucketV2": {sku: fixedSKU("STANDARD")},
+	"aws:lambda/function:Function": {
+		sku:  inputSKU("memorySize", "128"),
+		tags: map[string]string{"architectures": "arch"},
+	},
+	"aws:dynamodb/table:Table": {
+		sku: func(inputs map[string]any) string {
+			if scalarInput(inputs["billingMode"]) == "PAY_PER_REQUEST" {
+				return "on-demand"
+			}
+			return "provisioned"
+		},
+		tags: map[string]string{"readCapacity": "read_capacity_units", "writeCapacity": "write_capacity_units"},
+	},
+	"aws:lb/loadBalancer:LoadBalancer":  {sku: loadBalancerSKU},
+	"aws:alb/loadBalancer:LoadBalancer": {sku: loadBalancerSKU},
+	"aws:ec2/natGateway:NatGateway":     {resourceType: "natgw", sku: fixedSKU("nat_gateway")},
+	"aws:cloudwatch/logGroup:LogGroup":  {sku: fixedSKU("logs")},
+	"aws:elasticache/cluster:Cluster": {
+		sku:  inputSKU("nodeType", ""),
+		tags: map[string]string{"engine": "engine", "numCacheNodes": "num_nodes"},
+	},
+	"aws:ecr/repository:Repository":               {sku: fixedSKU("repository")},
+	"aws:secretsmanager/secret:Secret":            {sku: fixedSKU("secret")},
+	"aws:kms/key:Key":                             {sku: fixedSKU("key")},
+	"aws:wafv2/webAcl:WebAcl":                     {sku: fixedSKU("webacl"), tags: map[string]string{"rules": "rules"}},
+	"aws:athena/workgroup:Workgroup":              {sku: fixedSKU("workgroup")},
+	"aws:glue/job:Job":                            {sku: inputSKU("executionClass", "standard")},
+	"aws:ses/configurationSet:ConfigurationSet":   {sku: fixedSKU("email")},
+	"aws:sesv2/configurationSet:ConfigurationSet": {sku: fixedSKU("email")},
+	"aws:sfn/stateMachine:StateMachine": {
+		sku:  fixedSKU("stateMachine"),
+		tags: map[string]string{"type": tagSFNType},
+	},
+}
+
+// loadBalancerSKU maps an ELBv2 load balancer's loadBalancerType input to the ELB SKU.
+func loadBalancerSKU(inputs map[string]any) string {
+	if scalarInput(inputs["loadBalancerType"]) == "network" {
+		return "nlb"
+	}
+	return "alb"
+}
+
+// ParseStackExport reads `pulumi stack export` JSON and maps each custom AWS resource to
+// a ResourceDescriptor for GetProjectedCost. The SKU and tags come from the resource's
+// inputs, and string values of its AWS "tags" input are passed through so usage tags
+// such as requests_per_month can be set on the resources themselves. The region comes
+// from the resource's provider, then an availabilityZone input, then defaultRegion.
+// Providers and component resources are omitted; AWS types without a mapping, including
+// sub-resources of supported services, are returned with Skipped set.
+func ParseStackExport(data []byte, defaultRegion string) ([]StackResource, error) {
+	var export stackExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("parse stack export: %w", err)
+	}
+
+	providerRegions := make(map[string]string)
+	for _, res := range export.Deployment.Resources {
+		if res.Type == awsProviderType {
+			providerRegions[res.URN+"::"+res.ID] = scalarInput(res.Inputs["region"])
+		}
+	}
+
+	var resources []StackResource
+	for _, res := range export.Deployment.Resources {
+		if !res.Custom || !strings.HasPrefix(res.Type, "aws:") {
+			continue
+		}
+		entry := StackResource{URN: res.URN, Type: res.Type}
+
+		mapping, mapped := stackInputMappings[res.Type]
+		resourceType := res.Type
+		if mapping.resourceType != "" {
+			resourceType = mapping.resourceType
+		}
+		service := newServiceResolver(resourceType).ServiceType()
+		if !mapped && !IsZeroCostService(service) {
+			entry.Skipped = "resource type not supported for cost estimation"
+			resources = append(resources, entry)
+			continue
+		}
+
+		tags := make(map[string]string)
+		if awsTags, ok := res.Inputs["tags"].(map[string]any); ok {
+			for k, v := range awsTags {
+				if s, ok := v.(string); ok {
+					tags[k] = s
+				}
+			}
+		}
+		for input, tag := range mapping.tags {
+			if v := scalarInput(res.Inputs[input]); v != "" {
+				tags[tag] = v
+			}
+		}
+
+		var sku string
+		if mapping.sku != nil {
+			sku = mapping.sku(res.Inputs)
+		}
+		if sku == "" && !IsZeroCostService(service) {
+			entry.Skipped = "no SKU in resource inputs"
+			resources = append(resources, entry)
+			continue
+		}
+
+		region := providerRegions[res.Provider]
+		if region == "" {
+			region = extractAWSRegion(map[string]string{"availabilityZone": scalarInput(res.Inputs["availabilityZone"])})
+		}
+		if region == "" {
+			region = defaultRegion
+		}
+
+		entry.Descriptor = &pbc.ResourceDescriptor{
+			Id:           res.URN,
+			Provider:     providerAWS,
+			ResourceType: resourceType,
+			Sku:          sku,
+			Region:       region,
+			Tags:         tags,
+		}
+		resources = append(resources, entry)
+	}
+	return resources, nil
+}
+
+// scalarInput formats a JSON input value as a tag value. A list yields its first
+// element (e.g. Lambda architectures) or, for a list of objects, its length (e.g. WAF
+// rules). Objects and null yield "".
+func scalarInput(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(val)
+	case []any:
+		if len(val) == 0 {
+			return ""
+		}
+		if _, isObject := val[0].(map[string]any); isObject {
+			return strconv.Itoa(len(val))
+		}
+		return scalarInput(val[0])
+	default:
+		return ""
+	}
+}
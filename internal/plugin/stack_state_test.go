@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testStackExport is a trimmed `pulumi stack export` with a regional provider, a
+// component, priced resources, a zero-cost VPC, an unsupported type and sub-resources of
+// priced services.
+const testStackExport = `{
+	"version": 3,
+	"deployment": {
+		"resources": [
+			{"urn": "urn:pulumi:dev::app::pulumi:pulumi:Stack::app-dev", "custom": false, "type": "pulumi:pulumi:Stack"},
+			{"urn": "urn:pulumi:dev::app::pulumi:providers:aws::west", "id": "p-1", "custom": true,
+				"type": "pulumi:providers:aws", "inputs": {"region": "us-west-2"}},
+			{"urn": "urn:pulumi:dev::app::aws:ec2/instance:Instance::web", "custom": true,
+				"type": "aws:ec2/instance:Instance", "provider": "urn:pulumi:dev::app::pulumi:providers:aws::west::p-1",
+				"inputs": {"instanceType": "t3.micro", "tags": {"Name": "web", "utilization": "0.8"}}},
+			{"urn": "urn:pulumi:dev::app::aws:ebs/volume:Volume::data", "custom": true,
+				"type": "aws:ebs/volume:Volume", "inputs": {"availabilityZone": "eu-west-1a", "size": 100, "type": "gp3", "iops": 4000}},
+			{"urn": "urn:pulumi:dev::app::aws:lambda/function:Function::fn", "custom": true,
+				"type": "aws:lambda/function:Function", "inputs": {"memorySize": 512, "architectures": ["arm64"]}},
+			{"urn": "urn:pulumi:dev::app::aws:dynamodb/table:Table::tbl", "custom": true,
+				"type": "aws:dynamodb/table:Table", "inputs": {"billingMode": "PAY_PER_REQUEST"}},
+			{"urn": "urn:pulumi:dev::app::aws:wafv2/webAcl:WebAcl::acl", "custom": true,
+				"type": "aws:wafv2/webAcl:WebAcl", "inputs": {"rules": [{"name": "a"}, {"name": "b"}]}},
+			{"urn": "urn:pulumi:dev::app::aws:rds/instance:Instance::db", "custom": true,
+				"type": "aws:rds/instance:Instance", "inputs": {"engine": "postgres"}},
+			{"urn": "urn:pulumi:dev::app::aws:ec2/natGateway:NatGateway::nat", "custom": true,
+				"type": "aws:ec2/natGateway:NatGateway", "inputs": {"subnetId": "subnet-1"}},
+			{"urn": "urn:pulumi:dev::app::aws:ec2/vpc:Vpc::net", "custom": true,
+				"type": "aws:ec2/vpc:Vpc", "inputs": {"cidrBlock": "10.0.0.0/16"}},
+			{"urn": "urn:pulumi:dev::app::aws:sqs/queue:Queue::jobs", "custom": true,
+				"type": "aws:sqs/queue:Queue", "inputs": {}},
+			{"urn": "urn:pulumi:dev::app::aws:lb/listener:Listener::http", "custom": true,
+				"type": "aws:lb/listener:Listener", "inputs": {"port": 80}},
+			{"urn": "urn:pulumi:dev::app::aws:kms/alias:Alias::alias", "custom": true,
+				"type": "aws:kms/alias:Alias", "inputs": {"name": "alias/app"}},
+			{"urn": "urn:pulumi:dev::app::aws:s3/bucketPolicy:BucketPolicy::policy", "custom": true,
+				"type": "aws:s3/bucketPolicy:BucketPolicy", "inputs": {"bucket": "b"}},
+			{"urn": "urn:pulumi:dev::app::aws:ec2/launchTemplate:LaunchTemplate::lt", "custom": true,
+				"type": "aws:ec2/launchTemplate:LaunchTemplate", "inputs": {"instanceType": "m5.large"}}
+		]
+	}
+}`
+
+// TestParseStackExport verifies stack export resources map to descriptors with SKUs,
+// tags and regions derived from their inputs and providers, and that sub-resources of
+// priced services are skipped rather than priced as their parent.
+func TestParseStackExport(t *testing.T) {
+	resources, err := ParseStackExport([]byte(testStackExport), "us-east-1")
+	if err != nil {
+		t.Fatalf("ParseStackExport() error: %v", err)
+	}
+
+	type want struct {
+		sku, region, skipped string
+		resourceType         string // defaults to the Pulumi type
+		tags                 map[string]string
+	}
+	wants := map[string]want{
+		"web":    {sku: "t3.micro", region: "us-west-2", tags: map[string]string{"Name": "web", "utilization": "0.8"}},
+		"data":   {sku: "gp3", region: "eu-west-1", tags: map[string]string{"size": "100", "iops": "4000"}},
+		"fn":     {sku: "512", region: "us-east-1", tags: map[string]string{"arch": "arm64"}},
+		"tbl":    {sku: "on-demand", region: "us-east-1", tags: map[string]string{}},
+		"acl":    {sku: "webacl", region: "us-east-1", tags: map[string]string{"rules": "2"}},
+		"db":     {skipped: "no SKU in resource inputs"},
+		"nat":    {sku: "nat_gateway", region: "us-east-1", resourceType: "natgw", tags: map[string]string{}},
+		"net":    {region: "us-east-1", tags: map[string]string{}},
+		"jobs":   {skipped: "resource type not supported for cost estimation"},
+		"http":   {skipped: "resource type not supported for cost estimation"},
+		"alias":  {skipped: "resource type not supported for cost estimation"},
+		"policy": {skipped: "resource type not supported for cost estimation"},
+		"lt":     {skipped: "resource type not supported for cost estimation"},
+	}
+
+	if len(resources) != len(wants) {
+		t.Fatalf("got %d resources, want %d: %+v", len(resources), len(wants), resources)
+	}
+	for _, res := range resources {
+		w, ok := wants[res.Name()]
+		if !ok {
+			t.Errorf("unexpected resource %s", res.URN)
+			continue
+		}
+		if res.Skipped != w.skipped {
+			t.Errorf("%s: Skipped = %q, want %q", res.Name(), res.Skipped, w.skipped)
+		}
+		if w.skipped != "" {
+			if res.Descriptor != nil {
+				t.Errorf("%s: skipped resource has descriptor %v", res.Name(), res.Descriptor)
+			}
+			continue
+		}
+		d := res.Descriptor
+		if d == nil {
+			t.Fatalf("%s: descriptor is nil", res.Name())
+		}
+		wantType := w.resourceType
+		if wantType == "" {
+			wantType = res.Type
+		}
+		if d.Sku != w.sku || d.Region != w.region || d.ResourceType != wantType || d.Id != res.URN {
+			t.Errorf("%s: descriptor = %v, want sku %q region %q", res.Name(), d, w.sku, w.region)
+		}
+		if !reflect.DeepEqual(d.Tags, w.tags) {
+			t.Errorf("%s: tags = %v, want %v", res.Name(), d.Tags, w.tags)
+		}
+	}
+}
+
+// TestParseStackExport_InvalidJSON verifies malformed exports are reported.
+func TestParseStackExport_InvalidJSON(t *testing.T) {
+	if _, err := ParseStackExport([]byte("{"), "us-east-1"); err == nil {
+		t.Error("ParseStackExport() error = nil, want a parse error")
+	}
+}
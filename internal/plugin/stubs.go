@@ -0,0 +1,23 @@
+package plugin
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// stubUnimplementedMetadataKey is the gRPC request metadata key that asks for
+// codes.Unimplemented instead of a $0 placeholder when a service's estimator is a stub.
+// Neither cost request has a field for it, and the default stays $0 with an explanation.
+const stubUnimplementedMetadataKey = "finfocus-stub-unimplemented"
+
+// stubUnimplementedRequested reports whether the incoming request metadata opts into
+// Unimplemented errors for stub estimators.
+func stubUnimplementedRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(stubUnimplementedMetadataKey)
+	return len(values) > 0 && parseBoolVal(values[0])
+}
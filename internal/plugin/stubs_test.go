@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestGetActualCost_StubUnimplemented verifies services registered at SupportLevelStub
+// return $0 by default and codes.Unimplemented when the request opts in via metadata.
+func TestGetActualCost_StubUnimplemented(t *testing.T) {
+	t.Parallel()
+
+	plugin := newTestPluginForActual()
+	plugin.supportLevels = map[string]SupportLevel{"sqs": SupportLevelStub}
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON("aws", "sqs", "standard", "us-east-1", nil),
+		Start:      timestamppb.New(from),
+		End:        timestamppb.New(from.Add(24 * time.Hour)),
+	}
+
+	resp, err := plugin.GetActualCost(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetActualCost() default mode error: %v", err)
+	}
+	if len(resp.Results) == 0 || resp.Results[0].Cost != 0 {
+		t.Errorf("default mode results = %v, want a $0 placeholder", resp.Results)
+	}
+
+	for _, val := range []string{"true", "1"} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stubUnimplementedMetadataKey, val))
+		_, err = plugin.GetActualCost(ctx, req)
+		if status.Code(err) != codes.Unimplemented {
+			t.Errorf("GetActualCost() with %s=%s error = %v, want Unimplemented", stubUnimplementedMetadataKey, val, err)
+		}
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stubUnimplementedMetadataKey, "false"))
+	if _, err = plugin.GetActualCost(ctx, req); err != nil {
+		t.Errorf("GetActualCost() with %s=false error = %v, want $0 placeholder", stubUnimplementedMetadataKey, err)
+	}
+}
+
+// TestGetProjectedCost_StubServiceLevel verifies services registered at SupportLevelStub
+// are routed to the placeholder estimator, and that implemented services ignore the
+// Unimplemented opt-in.
+func TestGetProjectedCost_StubServiceLevel(t *testing.T) {
+	t.Parallel()
+
+
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+	plugin.supportLevels = map[string]SupportLevel{"sqs": SupportLevelStub}
+	stub := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "sqs", Sku: "standard", Region: "us-east-1"}
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: stub})
+	if err != nil {
+		t.Fatalf("GetProjectedCost() default mode error: %v", err)
+	}
+	if resp.CostPerMonth != 0 || !strings.Contains(resp.BillingDetail, "not fully implemented") {
+		t.Errorf("default mode = $%v %q, want $0 stub explanation", resp.CostPerMonth, resp.BillingDetail)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(stubUnimplementedMetadataKey, "true"))
+	if _, err = plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: stub}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("GetProjectedCost() strict mode error = %v, want Unimplemented", err)
+	}
+
+	ec2 := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2", Sku: "t3.micro", Region: "us-east-1"}
+	if _, err = plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: ec2}); err != nil {
+		t.Errorf("GetProjectedCost() strict mode for implemented service error = %v", err)
+	}
+
+	supports, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{Resource: stub})
+	if err != nil {
+		t.Fatalf("Supports() error: %v", err)
+	}
+	if !supports.Supported || !supports.Capabilities[supportLevelCapabilityPrefix+string(SupportLevelStub)] {
+		t.Errorf("Supports() = %v, want supported with support_level_stub", supports)
+	}
+}
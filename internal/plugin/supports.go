@@ -3,21 +3,35 @@ package plugin
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/metadata"
 )
 
+// validateSKUMetadataKey is the gRPC request metadata key that opts a Supports call into
+// checking the resource's SKU against the embedded pricing data. SupportsRequest has no
+// field for it.
+const validateSKUMetadataKey = "finfocus-validate-sku"
+
 // Supports checks if this plugin can estimate costs for the given resource.
-func (p *AWSPublicPlugin) Supports(ctx context.Context, req *pbc.SupportsRequest) (*pbc.SupportsResponse, error) {
-	start := time.Now()
+//
+// By default only the provider, region and resource type are checked. When the request
+// carries the finfocus-validate-sku metadata, the SKU must also resolve a price, letting
+// clients pre-flight a resource before calling GetProjectedCost.
+func (p *AWSPublicPlugin) Supports(ctx context.Context, req *pbc.SupportsRequest) (_ *pbc.SupportsResponse, err error) {
+	start := p.clock.Now()
+	defer func() {
+		p.metrics.observe("Supports", metricsResourceType(req.GetResource().GetResourceType()), p.since(start), err)
+	}()
 	traceID := p.getTraceID(ctx)
 
 	if req == nil || req.Resource == nil {
 		p.traceLogger(traceID, "Supports").Info().
 			Str(pluginsdk.FieldErrorCode, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE.String()).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("resource support check")
 
 		return &pbc.SupportsResponse{
@@ -39,7 +53,7 @@ func (p *AWSPublicPlugin) Supports(ctx context.Context, req *pbc.SupportsRequest
 			Str(pluginsdk.FieldResourceType, resource.ResourceType).
 			Str("aws_region", resource.Region).
 			Bool("supported", false).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("resource support check")
 
 		return &pbc.SupportsResponse{
@@ -56,12 +70,13 @@ func (p *AWSPublicPlugin) Supports(ctx context.Context, req *pbc.SupportsRequest
 		effectiveRegion = p.region
 	}
 
-	if effectiveRegion != p.region {
+	regionFallback := p.usesRegionFallback(effectiveRegion)
+	if effectiveRegion != p.region && !regionFallback {
 		p.traceLogger(traceID, "Supports").Info().
 			Str(pluginsdk.FieldResourceType, resource.ResourceType).
 			Str("aws_region", resource.Region).
 			Bool("supported", false).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("resource support check")
 
 		return &pbc.SupportsResponse{
@@ -70,72 +85,139 @@ func (p *AWSPublicPlugin) Supports(ctx context.Context, req *pbc.SupportsRequest
 		}, nil
 	}
 
-	// Check resource type
-	switch serviceType {
-	case "ec2", "rds", "lambda", "s3", "ebs", "eks", "dynamodb", "elasticache":
-		// These services support cost estimation
-		// EC2 also supports carbon footprint estimation
-		supportedMetrics := getSupportedMetrics(serviceType)
+	// Check resource type against the central support-level table.
+	// Supported stays a boolean for backward compatibility; the maturity level is
+	// advertised via Capabilities (e.g. "support_level_partial": true).
+	level := p.supportLevel(serviceType)
+	if regionFallback && level == SupportLevelFull {
+		// Estimates derived from reference pricing are approximate
+		level = SupportLevelPartial
+	}
+	if level == SupportLevelUnsupported {
 		p.traceLogger(traceID, "Supports").Info().
 			Str(pluginsdk.FieldResourceType, resource.ResourceType).
 			Str("aws_region", resource.Region).
-			Bool("supported", true).
-			Int("supported_metrics_count", len(supportedMetrics)).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Bool("supported", false).
+			Str("support_level", string(level)).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("resource support check")
 
 		return &pbc.SupportsResponse{
-			Supported:        true,
-			Reason:           "",
-			SupportedMetrics: supportedMetrics,
+			Supported:        false,
+			Reason:           fmt.Sprintf("Resource type %q not supported", resource.ResourceType),
+			SupportedMetrics: nil,
+			Capabilities:     supportLevelCapabilities(level),
 		}, nil
+	}
 
-	case "elb", "natgw", "cloudwatch":
-		// Supported but no carbon estimation yet
+	if p.serviceDisabled(serviceType) {
 		p.traceLogger(traceID, "Supports").Info().
 			Str(pluginsdk.FieldResourceType, resource.ResourceType).
 			Str("aws_region", resource.Region).
-			Bool("supported", true).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+			Bool("supported", false).
+			Str("support_level", string(level)).
+			Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 			Msg("resource support check")
 
 		return &pbc.SupportsResponse{
-			Supported:        true,
-			Reason:           "",
-			SupportedMetrics: nil, // No additional metrics for these types yet
+			Supported: false,
+			Reason:    fmt.Sprintf("Service %q is disabled in this deployment (%s)", serviceType, EnvEnabledServices),
 		}, nil
+	}
 
-	default:
-		// Check for zero-cost resources using centralized ZeroCostServices map
-		if IsZeroCostService(serviceType) {
+	if resource.Sku != "" && skuValidationRequested(ctx) {
+		if reason := p.checkSKUPricing(serviceType, resource, effectiveRegion); reason != "" {
 			p.traceLogger(traceID, "Supports").Info().
 				Str(pluginsdk.FieldResourceType, resource.ResourceType).
-				Str("aws_region", effectiveRegion).
-				Bool("supported", true).
-				Str("cost_type", "zero-cost").
-				Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
+				Str("aws_region", resource.Region).
+				Str("sku", resource.Sku).
+				Bool("supported", false).
+				Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
 				Msg("resource support check")
 
 			return &pbc.SupportsResponse{
-				Supported:        true,
-				Reason:           "",
-				SupportedMetrics: nil, // No metrics for zero-cost resources
+				Supported:    false,
+				Reason:       reason,
+				Capabilities: supportLevelCapabilities(level),
 			}, nil
 		}
+	}
 
-		// Unknown resource type
-		p.traceLogger(traceID, "Supports").Info().
-			Str(pluginsdk.FieldResourceType, resource.ResourceType).
-			Str("aws_region", resource.Region).
-			Bool("supported", false).
-			Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
-			Msg("resource support check")
+	// Zero-cost resources have no metrics; ELB, NAT Gateway and CloudWatch have
+	// no carbon estimation yet (getSupportedMetrics returns nil for them).
+	var supportedMetrics []pbc.MetricKind
+	logEvent := p.traceLogger(traceID, "Supports").Info().
+		Str(pluginsdk.FieldResourceType, resource.ResourceType).
+		Bool("supported", true).
+		Str("support_level", string(level))
+	if IsZeroCostService(serviceType) {
+		logEvent = logEvent.Str("aws_region", effectiveRegion).Str("cost_type", "zero-cost")
+	} else {
+		supportedMetrics = getSupportedMetrics(serviceType)
+		logEvent = logEvent.Str("aws_region", resource.Region).
+			Int("supported_metrics_count", len(supportedMetrics))
+	}
+	logEvent.
+		Int64(pluginsdk.FieldDurationMs, p.since(start).Milliseconds()).
+		Msg("resource support check")
 
-		return &pbc.SupportsResponse{
-			Supported:        false,
-			Reason:           fmt.Sprintf("Resource type %q not supported", resource.ResourceType),
-			SupportedMetrics: nil,
-		}, nil
+	return &pbc.SupportsResponse{
+		Supported:        true,
+		Reason:           "",
+		SupportedMetrics: supportedMetrics,
+		Capabilities:     supportLevelCapabilities(level),
+	}, nil
+}
+
+// skuValidationRequested reports whether the incoming request metadata opts into the
+// deep SKU check.
+func skuValidationRequested(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(validateSKUMetadataKey)
+	return len(values) > 0 && parseBoolVal(values[0])
+}
+
+// checkSKUPricing returns why the resource's SKU has no price in the embedded data, or ""
+// if it resolves. A SKU that is a known AWS type but has no price is reported as not
+// offered in the region; anything else is reported as unknown. Only services whose SKU
+// alone selects a price (EC2, RDS, EBS) are checked.
+func (p *AWSPublicPlugin) checkSKUPricing(serviceType string, resource *pbc.ResourceDescriptor, region string) string {
+	sku := resource.Sku
+
+	switch serviceType {
+	case "ec2":
+		if _, found := p.pricing.EC2OnDemandPricePerHour(sku, "Linux", "Shared"); found {
+			return ""
+		}
+		if _, known := carbon.GetInstanceSpec(sku); known {
+			return fmt.Sprintf("EC2 instance type %q is not offered in region %s", sku, region)
+		}
+		return fmt.Sprintf(PricingNotFoundTemplate, "EC2 instance type", sku)
+	case "rds":
+		engine := "MySQL"
+		if normalized, ok := engineNormalization[strings.ToLower(resource.Tags["engine"])]; ok {
+			engine = normalized
+		}
+		if _, found := p.pricing.RDSOnDemandPricePerHour(sku, engine); found {
+			return ""
+		}
+		if _, known := carbon.GetInstanceSpec(strings.TrimPrefix(sku, "db.")); known {
+			return fmt.Sprintf("RDS %s instance class %q is not offered in region %s", engine, sku, region)
+		}
+		return fmt.Sprintf(PricingNotFoundTemplate, "RDS instance class", sku)
+	case "ebs":
+		if _, found := p.pricing.EBSPricePerGBMonth(sku); found {
+			return ""
+		}
+		if _, known := carbon.GetEBSStorageSpec(sku); known {
+			return fmt.Sprintf("EBS volume type %q is not offered in region %s", sku, region)
+		}
+		return fmt.Sprintf(PricingNotFoundTemplate, "EBS volume type", sku)
+	default:
+		return ""
 	}
 }
 
@@ -169,7 +251,7 @@ func getSupportedMetrics(resourceType string) []pbc.MetricKind {
 		// ElastiCache clusters: EC2-equivalent node carbon × cluster size
 		return []pbc.MetricKind{pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT}
 	default:
-		// ELB, NAT Gateway, CloudWatch: No carbon estimation yet
+		// ELB, NAT Gateway, CloudWatch, ECR: No carbon estimation yet
 		return nil
 	}
 }
\ No newline at end of file
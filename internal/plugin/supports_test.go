@@ -9,6 +9,7 @@ import (
 
 	"github.com/rs/zerolog"
 	pb "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestSupports(t *testing.T) {
@@ -1169,3 +1170,113 @@ func TestSupports_IAM(t *testing.T) {
 		})
 	}
 }
+
+// TestSupports_SupportLevel verifies the maturity level is advertised via Capabilities
+// alongside the backward-compatible Supported boolean.
+func TestSupports_SupportLevel(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
+
+	tests := []struct {
+		name          string
+		resourceType  string
+		wantSupported bool
+		wantLevel     SupportLevel
+	}{
+		{name: "EC2 full", resourceType: "aws:ec2/instance:Instance", wantSupported: true, wantLevel: SupportLevelFull},
+		{name: "EBS full", resourceType: "ebs", wantSupported: true, wantLevel: SupportLevelFull},
+		{name: "RDS full", resourceType: "rds", wantSupported: true, wantLevel: SupportLevelFull},
+		{name: "VPC zero-cost full", resourceType: "vpc", wantSupported: true, wantLevel: SupportLevelFull},
+		{name: "S3 partial", resourceType: "s3", wantSupported: true, wantLevel: SupportLevelPartial},
+		{name: "Lambda partial", resourceType: "lambda", wantSupported: true, wantLevel: SupportLevelPartial},
+		{name: "NAT Gateway partial", resourceType: "natgw", wantSupported: true, wantLevel: SupportLevelPartial},
+		{name: "CloudWatch partial", resourceType: "cloudwatch", wantSupported: true, wantLevel: SupportLevelPartial},
+		{name: "Unknown unsupported", resourceType: "aws:sqs/queue:Queue", wantSupported: false, wantLevel: SupportLevelUnsupported},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.Supports(context.Background(), &pb.SupportsRequest{
+				Resource: &pb.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Region:       "us-east-1",
+				},
+			})
+			if err != nil {
+				t.Fatalf("Supports() returned error: %v", err)
+			}
+
+			if resp.Supported != tt.wantSupported {
+				t.Errorf("Supported = %v, want %v", resp.Supported, tt.wantSupported)
+			}
+
+			if len(resp.Capabilities) != 1 {
+				t.Fatalf("Capabilities = %v, want exactly one support level entry", resp.Capabilities)
+			}
+			key := supportLevelCapabilityPrefix + string(tt.wantLevel)
+			if !resp.Capabilities[key] {
+				t.Errorf("Capabilities = %v, want %q", resp.Capabilities, key)
+			}
+		})
+	}
+}
+
+// TestSupports_ValidateSKU verifies the opt-in SKU check rejects SKUs without pricing,
+// distinguishing types not offered in the region from unknown ones, and that the default
+// shallow check ignores the SKU.
+func TestSupports_ValidateSKU(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	mock.rdsInstancePrices["db.t3.micro/PostgreSQL"] = 0.018
+	mock.ebsPrices["gp3"] = 0.08
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	deepCtx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(validateSKUMetadataKey, "true"))
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		resourceType  string
+		sku           string
+		tags          map[string]string
+		wantSupported bool
+		wantReason    string
+	}{
+		{name: "shallow ignores unknown SKU", ctx: context.Background(), resourceType: "ec2", sku: "bogus.large", wantSupported: true},
+		{name: "EC2 priced", ctx: deepCtx, resourceType: "ec2", sku: "t3.micro", wantSupported: true},
+		{name: "EC2 not in region", ctx: deepCtx, resourceType: "ec2", sku: "m5.large", wantReason: "not offered in region us-east-1"},
+		{name: "EC2 unknown", ctx: deepCtx, resourceType: "ec2", sku: "bogus.large", wantReason: "not found in pricing data"},
+		{name: "RDS priced with engine", ctx: deepCtx, resourceType: "rds", sku: "db.t3.micro", tags: map[string]string{"engine": "postgres"}, wantSupported: true},
+		{name: "RDS wrong engine", ctx: deepCtx, resourceType: "rds", sku: "db.t3.micro", wantReason: "RDS MySQL instance class \"db.t3.micro\" is not offered"},
+		{name: "EBS priced", ctx: deepCtx, resourceType: "ebs", sku: "gp3", wantSupported: true},
+		{name: "EBS unknown", ctx: deepCtx, resourceType: "ebs", sku: "gp9", wantReason: "not found in pricing data"},
+		{name: "unchecked service", ctx: deepCtx, resourceType: "lambda", sku: "anything", wantSupported: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.Supports(tt.ctx, &pb.SupportsRequest{
+				Resource: &pb.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("Supports() returned error: %v", err)
+			}
+
+			if resp.Supported != tt.wantSupported {
+				t.Errorf("Supported = %v, want %v (reason: %q)", resp.Supported, tt.wantSupported, resp.Reason)
+			}
+			if !strings.Contains(resp.Reason, tt.wantReason) {
+				t.Errorf("Reason = %q, want it to contain %q", resp.Reason, tt.wantReason)
+			}
+		})
+	}
+}
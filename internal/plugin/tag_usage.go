@@ -0,0 +1,143 @@
+package plugin
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk/mapping"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// requestTagKeys are read by GetProjectedCost for every resource type, around the
+// estimator rather than inside it.
+var requestTagKeys = []string{
+	tagResourceCount, tagRoundTo, tagMetricRoundTo, tagUnitPeriod,
+	tagVerboseBilling, tagPricingVintage, tagGridFactor,
+}
+
+// skuTagKeys are the tags extractAWSSKU reads when the descriptor has no SKU, and the
+// region tags validation falls back to.
+var skuTagKeys = []string{
+	mapping.AWSKeyInstanceType, "instance_type", "instance_class", mapping.AWSKeyInstanceClass,
+	"node_type", mapping.AWSKeyType, mapping.AWSKeyVolumeType, "volume_type",
+	mapping.AWSKeyRegion, mapping.AWSKeyAvailabilityZone,
+}
+
+// tagReadTracker records the tag keys estimators look up on each resource, so the tag
+// usage log reports what the estimate actually read rather than what the schema
+// publishes. Only descriptors registered with track are recorded. It is shared by
+// pointer because GetProjectedCost works on a copy of the plugin for pricing_vintage.
+type tagReadTracker struct {
+	reads sync.Map // *pbc.ResourceDescriptor -> *tagReadSet
+}
+
+// tagReadSet is the set of keys read from one descriptor.
+type tagReadSet struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+// track starts recording reads from resource. The returned function stops recording
+// and returns the keys read in between. It is a no-op on a nil tracker.
+func (t *tagReadTracker) track(resource *pbc.ResourceDescriptor) func() map[string]bool {
+	if t == nil || resource == nil {
+		return func() map[string]bool { return nil }
+	}
+	set := &tagReadSet{keys: make(map[string]bool)}
+	t.reads.Store(resource, set)
+	return func() map[string]bool {
+		t.reads.CompareAndDelete(resource, set)
+		set.mu.Lock()
+		defer set.mu.Unlock()
+		return set.keys
+	}
+}
+
+// record notes that key was read from resource, if resource is being tracked.
+func (t *tagReadTracker) record(resource *pbc.ResourceDescriptor, key string) {
+	if t == nil {
+		return
+	}
+	value, ok := t.reads.Load(resource)
+	if !ok {
+		return
+	}
+	set := value.(*tagReadSet)
+	set.mu.Lock()
+	set.keys[key] = true
+	set.mu.Unlock()
+}
+
+// tag returns the value of the resource's key tag and whether it is set, recording the
+// read for the tag usage log. Estimators read tags through it rather than indexing
+// resource.Tags directly.
+func (p *AWSPublicPlugin) tag(resource *pbc.ResourceDescriptor, key string) (string, bool) {
+	p.tagReads.record(resource, key)
+	val, ok := resource.GetTags()[key]
+	return val, ok
+}
+
+// tagValue returns the value of the resource's key tag, or "" when unset, recording the
+// read like tag.
+func (p *AWSPublicPlugin) tagValue(resource *pbc.ResourceDescriptor, key string) string {
+	val, _ := p.tag(resource, key)
+	return val
+}
+
+// tagSubset returns the set tags among keys, recording each read like tag. It serves
+// helpers that take a tag map rather than the descriptor.
+func (p *AWSPublicPlugin) tagSubset(resource *pbc.ResourceDescriptor, keys ...string) map[string]string {
+	subset := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if val, ok := p.tag(resource, key); ok {
+			subset[key] = val
+		}
+	}
+	return subset
+}
+
+// splitTagUsage splits the keys of tags into those the estimate read (the keys in read,
+// plus the request and SKU tags every estimate honours) and those it ignored
+// (unrecognized or misspelled keys, or tags for other services), both sorted.
+func splitTagUsage(read map[string]bool, tags map[string]string) (consumed, ignored []string) {
+	known := make(map[string]bool, len(read)+len(requestTagKeys)+len(skuTagKeys))
+	for key := range read {
+		known[key] = true
+	}
+	for _, keys := range [][]string{requestTagKeys, skuTagKeys} {
+		for _, key := range keys {
+			known[key] = true
+		}
+	}
+
+	for key := range tags {
+		if known[key] {
+			consumed = append(consumed, key)
+		} else {
+			ignored = append(ignored, key)
+		}
+	}
+	slices.Sort(consumed)
+	slices.Sort(ignored)
+	return consumed, ignored
+}
+
+// logTagUsage logs, at debug level, which of the resource's tag keys the estimate read
+// and which it ignored, so misspelled keys that silently fall back to defaults can be
+// found. Only keys are logged, never values, and they pass through the tag sanitizer
+// like logged tags do.
+func (p *AWSPublicPlugin) logTagUsage(traceID, serviceType string, resource *pbc.ResourceDescriptor, read map[string]bool) {
+	event := p.logger.Debug()
+	if !event.Enabled() || len(resource.GetTags()) == 0 {
+		event.Discard()
+		return
+	}
+	consumed, ignored := splitTagUsage(read, resource.GetTags())
+	event.
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("aws_service", serviceType).
+		Strs("consumed_tags", p.tagSanitizer.sanitizeKeys(consumed)).
+		Strs("ignored_tags", p.tagSanitizer.sanitizeKeys(ignored)).
+		Msg("resource tag usage")
+}
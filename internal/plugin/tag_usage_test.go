@@ -0,0 +1,191 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// TestSplitTagUsage verifies tags the estimator read and request-wide and SKU tags are
+// consumed, while misspelled keys and keys the estimator never read are ignored.
+func TestSplitTagUsage(t *testing.T) {
+	tests := []struct {
+		name         string
+		read         map[string]bool
+		tags         map[string]string
+		wantConsumed []string
+		wantIgnored  []string
+	}{
+		{
+			name:         "misspelled EBS size",
+			read:         map[string]bool{"size": true, "throughput": true},
+			tags:         map[string]string{"sise": "100", "throughput": "250", tagRoundTo: "2"},
+			wantConsumed: []string{tagRoundTo, "throughput"},
+			wantIgnored:  []string{"sise"},
+		},
+		{
+			name:         "SKU tag",
+			read:         map[string]bool{"volume_size": true},
+			tags:         map[string]string{"volume_size": "100", "volumeType": "gp3", "Name": "data"},
+			wantConsumed: []string{"volumeType", "volume_size"},
+			wantIgnored:  []string{"Name"},
+		},
+		{
+			name:         "tag the estimator did not read",
+			read:         map[string]bool{"requests_per_month": true},
+			tags:         map[string]string{"requests_per_month": "1000", "log_ingestion_gb": "5"},
+			wantConsumed: []string{"requests_per_month"},
+			wantIgnored:  []string{"log_ingestion_gb"},
+		},
+		{
+			name:        "nothing read",
+			tags:        map[string]string{"size": "100"},
+			wantIgnored: []string{"size"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			consumed, ignored := splitTagUsage(tt.read, tt.tags)
+			if !slices.Equal(consumed, tt.wantConsumed) {
+				t.Errorf("consumed = %v, want %v", consumed, tt.wantConsumed)
+			}
+			if !slices.Equal(ignored, tt.wantIgnored) {
+				t.Errorf("ignored = %v, want %v", ignored, tt.wantIgnored)
+			}
+		})
+	}
+}
+
+// TestTagReadTracker verifies reads are recorded only while a descriptor is tracked.
+func TestTagReadTracker(t *testing.T) {
+	tracker := &tagReadTracker{}
+	resource := &pbc.ResourceDescriptor{Tags: map[string]string{"size": "100"}}
+
+	tracker.record(resource, "before")
+	done := tracker.track(resource)
+	tracker.record(resource, "size")
+	tracker.record(&pbc.ResourceDescriptor{}, "other")
+	read := done()
+	tracker.record(resource, "after")
+
+	if len(read) != 1 || !read["size"] {
+		t.Errorf("read = %v, want only size", read)
+	}
+
+	var nilTracker *tagReadTracker
+	nilTracker.record(resource, "size")
+	if read := nilTracker.track(resource)(); read != nil {
+		t.Errorf("nil tracker read = %v, want nil", read)
+	}
+}
+
+// TestGetProjectedCost_LogsTagUsage verifies the debug log lists the tags the estimator
+// read as consumed and the rest as ignored, never logs tag values or denylisted keys, and
+// is silent above debug level.
+func TestGetProjectedCost_LogsTagUsage(t *testing.T) {
+	tests := []struct {
+		name         string
+		level        zerolog.Level
+		resource     *pbc.ResourceDescriptor
+		wantConsumed []string
+		wantIgnored  []string
+	}{
+		{
+			name:  "misspelled EBS size",
+			level: zerolog.DebugLevel,
+			resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "ebs", Sku: "gp3", Region: "us-east-1",
+				Tags: map[string]string{"size": "100", "sise": "secret-value-500"},
+			},
+			wantConsumed: []string{"size"},
+			wantIgnored:  []string{"sise"},
+		},
+		{
+			name:  "EC2 utilization read outside the schema",
+			level: zerolog.DebugLevel,
+			resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "ec2", Sku: "m5.large", Region: "us-east-1",
+				Tags: map[string]string{tagUtilization: "0.3", "platfrom": "windows"},
+			},
+			wantConsumed: []string{tagUtilization},
+			wantIgnored:  []string{"platfrom"},
+		},
+		{
+			name:  "denylisted key",
+			level: zerolog.DebugLevel,
+			resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "ebs", Sku: "gp3", Region: "us-east-1",
+				Tags: map[string]string{"size": "100", "db_password": "hunter2"},
+			},
+			wantConsumed: []string{"size"},
+		},
+		{
+			name:  "info level",
+			level: zerolog.InfoLevel,
+			resource: &pbc.ResourceDescriptor{
+				Provider: "aws", ResourceType: "ebs", Sku: "gp3", Region: "us-east-1",
+				Tags: map[string]string{"size": "100", "sise": "secret-value-500"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ebsPrices["gp3"] = 0.08
+			mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.New(&buf).Level(tt.level))
+
+			if _, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: tt.resource}); err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			var entry struct {
+				Consumed []string `json:"consumed_tags"`
+				Ignored  []string `json:"ignored_tags"`
+			}
+			found := false
+			for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+				if !strings.Contains(line, "resource tag usage") {
+					continue
+				}
+				found = true
+				if err := json.Unmarshal([]byte(line), &entry); err != nil {
+					t.Fatalf("log line %q is not JSON: %v", line, err)
+				}
+				for key, val := range tt.resource.Tags {
+					if strings.Contains(line, val) {
+						t.Errorf("log line %q contains the value of tag %s", line, key)
+					}
+				}
+				if strings.Contains(line, "db_password") {
+					t.Errorf("log line %q contains a denylisted key", line)
+				}
+			}
+
+			if tt.level != zerolog.DebugLevel {
+				if found {
+					t.Errorf("tag usage logged at %s level", tt.level)
+				}
+				return
+			}
+			if !found {
+				t.Fatalf("no tag usage log entry in %q", buf.String())
+			}
+			if !slices.Equal(entry.Consumed, tt.wantConsumed) {
+				t.Errorf("consumed_tags = %v, want %v", entry.Consumed, tt.wantConsumed)
+			}
+			if !slices.Equal(entry.Ignored, tt.wantIgnored) {
+				t.Errorf("ignored_tags = %v, want %v", entry.Ignored, tt.wantIgnored)
+			}
+		})
+	}
+}
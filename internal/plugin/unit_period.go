@@ -0,0 +1,108 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// tagUnitPeriod asks GetProjectedCost to express UnitPrice per hour, day, month or year
+// and to report the cost over that period. CostPerMonth is never changed; the period
+// cost is sent in the finfocus-cost-per-<period> response header. Unset means the
+// estimator's native unit.
+const tagUnitPeriod = "unit_period"
+
+// costPerPeriodHeaderPrefix prefixes the period cost header, e.g. finfocus-cost-per-year.
+const costPerPeriodHeaderPrefix = "finfocus-cost-per-"
+
+// Unit periods accepted by the unit_period tag.
+const (
+	unitPeriodHour  = "hour"
+	unitPeriodDay   = "day"
+	unitPeriodMonth = "month"
+	unitPeriodYear  = "year"
+)
+
+// unitPeriodHours is the length of each unit period in hours, on the same 730-hour
+// month as the estimators, so a year is 12 months and a day is 24 hours.
+var unitPeriodHours = map[string]float64{
+	unitPeriodHour:  1,
+	unitPeriodDay:   24,
+	unitPeriodMonth: HoursPerMonthProd,
+	unitPeriodYear:  12 * HoursPerMonthProd,
+}
+
+// serviceUnitPricePeriods is the period of each service's UnitPrice. Services missing
+// here (Lambda GB-seconds, DynamoDB RCU-hours or requests, CloudWatch, Athena TB scanned,
+// Glue DPU-hours, SES emails, Step Functions transitions or requests) have no single
+// time-based unit price, so unit_period leaves their UnitPrice unchanged.
+var serviceUnitPricePeriods = map[string]string{
+	"ec2":            unitPeriodHour,
+	"rds":            unitPeriodHour,
+	"eks":            unitPeriodHour,
+	"elb":            unitPeriodHour,
+	"natgw":          unitPeriodHour,
+	"elasticache":    unitPeriodHour,
+	"ebs":            unitPeriodMonth,
+	"s3":             unitPeriodMonth,
+	"ecr":            unitPeriodMonth,
+	"secretsmanager": unitPeriodMonth,
+	"kms":            unitPeriodMonth,
+	"waf":            unitPeriodMonth,
+}
+
+// periodCost is the estimated cost over a unit_period.
+type periodCost struct {
+	period string
+	cost   float64
+}
+
+// applyUnitPeriod converts the response's UnitPrice to the resource's unit_period tag and
+// returns CostPerMonth over that period. ok is false when the tag is unset or invalid
+// (logged and ignored). It runs before round_to, so the converted UnitPrice is rounded.
+func (p *AWSPublicPlugin) applyUnitPeriod(
+	traceID string, resource *pbc.ResourceDescriptor, serviceType string, resp *pbc.GetProjectedCostResponse,
+) (periodCost, bool) {
+	val, ok := resource.GetTags()[tagUnitPeriod]
+	if !ok || resp == nil {
+		return periodCost{}, false
+	}
+	period := strings.ToLower(strings.TrimSpace(val))
+	hours, ok := unitPeriodHours[period]
+	if !ok {
+		p.logger.Warn().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Str("tag", tagUnitPeriod).
+			Str("value", val).
+			Msg("invalid unit_period, must be hour, day, month or year, keeping native units")
+		return periodCost{}, false
+	}
+
+	if native, ok := serviceUnitPricePeriods[serviceType]; ok && native != period && resp.UnitPrice != 0 {
+		resp.UnitPrice *= hours / unitPeriodHours[native]
+		resp.BillingDetail += fmt.Sprintf(" (unit price per %s)", period)
+	}
+	return periodCost{period: period, cost: resp.CostPerMonth * hours / HoursPerMonthProd}, true
+}
+
+// setCostPerPeriodHeader sends the unit_period cost as a finfocus-cost-per-<period>
+// response header at full precision. It is a no-op outside a gRPC server stream or
+// without a valid unit_period.
+func (p *AWSPublicPlugin) setCostPerPeriodHeader(ctx context.Context, traceID string, cost periodCost, ok bool) {
+	if !ok || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	value := strconv.FormatFloat(cost.cost, 'f', -1, 64)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(costPerPeriodHeaderPrefix+cost.period, value)); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set cost per period header")
+	}
+}
@@ -0,0 +1,157 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_UnitPeriod verifies unit_period converts hourly and monthly unit
+// prices, reports the period cost in a header, and never changes CostPerMonth.
+func TestGetProjectedCost_UnitPeriod(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	mock.ebsPrices["gp2"] = 0.10
+	mock.lambdaPrices["request"] = 0.0000002
+	mock.lambdaPrices["gb-second"] = 0.0000166667
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	ec2Monthly := 0.0104 * 730
+	tests := []struct {
+		name          string
+		resourceType  string
+		sku           string
+		tags          map[string]string
+		wantUnitPrice float64
+		wantHeader    string // header key; empty for none
+		wantCost      float64
+		wantNote      bool
+	}{
+		{
+			name: "hourly to hour", resourceType: "ec2", sku: "t3.micro",
+			tags:          map[string]string{tagUnitPeriod: "hour"},
+			wantUnitPrice: 0.0104, wantHeader: "finfocus-cost-per-hour", wantCost: 0.0104,
+		},
+		{
+			name: "hourly to day", resourceType: "ec2", sku: "t3.micro",
+			tags:          map[string]string{tagUnitPeriod: "day"},
+			wantUnitPrice: 0.0104 * 24, wantHeader: "finfocus-cost-per-day", wantCost: 0.0104 * 24, wantNote: true,
+		},
+		{
+			name: "hourly to month", resourceType: "ec2", sku: "t3.micro",
+			tags:          map[string]string{tagUnitPeriod: "month"},
+			wantUnitPrice: ec2Monthly, wantHeader: "finfocus-cost-per-month", wantCost: ec2Monthly, wantNote: true,
+		},
+		{
+			name: "hourly to year", resourceType: "ec2", sku: "t3.micro",
+			tags:          map[string]string{tagUnitPeriod: " Year "},
+			wantUnitPrice: ec2Monthly * 12, wantHeader: "finfocus-cost-per-year", wantCost: ec2Monthly * 12, wantNote: true,
+		},
+		{
+			name: "monthly to hour", resourceType: "ebs", sku: "gp2",
+			tags:          map[string]string{"size": "100", tagUnitPeriod: "hour"},
+			wantUnitPrice: 0.10 / 730, wantHeader: "finfocus-cost-per-hour", wantCost: 10.0 / 730, wantNote: true,
+		},
+		{
+			name: "monthly to year", resourceType: "ebs", sku: "gp2",
+			tags:          map[string]string{"size": "100", tagUnitPeriod: "year"},
+			wantUnitPrice: 0.10 * 12, wantHeader: "finfocus-cost-per-year", wantCost: 120, wantNote: true,
+		},
+		{
+			name: "no time-based unit price", resourceType: "lambda", sku: "128",
+			tags:          map[string]string{"requests_per_month": "1000000", "avg_duration_ms": "100", tagUnitPeriod: "year"},
+			wantUnitPrice: 0.0000166667, wantHeader: "finfocus-cost-per-year",
+		},
+		{
+			name: "invalid period", resourceType: "ec2", sku: "t3.micro",
+			tags:          map[string]string{tagUnitPeriod: "fortnight"},
+			wantUnitPrice: 0.0104,
+		},
+		{
+			name: "unset", resourceType: "ec2", sku: "t3.micro",
+			wantUnitPrice: 0.0104,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if math.Abs(resp.UnitPrice-tt.wantUnitPrice) > 1e-9 {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantUnitPrice)
+			}
+			if got := strings.Contains(resp.BillingDetail, "(unit price per "); got != tt.wantNote {
+				t.Errorf("BillingDetail = %q, unit price note = %v, want %v", resp.BillingDetail, got, tt.wantNote)
+			}
+
+			for key := range stream.header {
+				if strings.HasPrefix(key, costPerPeriodHeaderPrefix) && key != tt.wantHeader {
+					t.Errorf("unexpected header %s", key)
+				}
+			}
+			if tt.wantHeader == "" {
+				return
+			}
+			values := stream.header.Get(tt.wantHeader)
+			if len(values) != 1 {
+				t.Fatalf("header %s = %v, want one value", tt.wantHeader, values)
+			}
+			got, err := strconv.ParseFloat(values[0], 64)
+			if err != nil {
+				t.Fatalf("header %s = %q is not a number: %v", tt.wantHeader, values[0], err)
+			}
+			wantCost := tt.wantCost
+			if tt.resourceType == "lambda" {
+				wantCost = resp.CostPerMonth * 12
+			}
+			if math.Abs(got-wantCost) > 1e-9 {
+				t.Errorf("header %s = %v, want %v", tt.wantHeader, got, wantCost)
+			}
+		})
+	}
+}
+
+// TestGetProjectedCost_UnitPeriodKeepsCostPerMonth verifies unit_period is presentation
+// only: CostPerMonth matches the estimate without the tag.
+func TestGetProjectedCost_UnitPeriodKeepsCostPerMonth(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	for period := range unitPeriodHours {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Tags:         map[string]string{tagUnitPeriod: period},
+			},
+		})
+		if err != nil {
+			t.Fatalf("GetProjectedCost(%s) returned error: %v", period, err)
+		}
+		if want := 0.0104 * 730; math.Abs(resp.CostPerMonth-want) > 1e-9 {
+			t.Errorf("%s: CostPerMonth = %v, want %v", period, resp.CostPerMonth, want)
+		}
+	}
+}
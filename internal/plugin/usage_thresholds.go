@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+)
+
+// usageWarnThresholds maps usage tags to the largest value considered plausible for a
+// single resource per month (or per hour for capacity units). Values above it are still
+// priced, but logged as a likely unit mistake such as bytes passed where GB is expected.
+// Tags not listed here are never checked.
+var usageWarnThresholds = map[string]float64{
+	// Load balancer capacity units per hour (#165)
+	"capacity_units": 1000,
+	"lcu_per_hour":   1000,
+	"nlcu_per_hour":  1000,
+
+	// Data volumes in GB per month (1 PB)
+	"data_processed_gb":    1e6,
+	"data_transfer_out_gb": 1e6,
+	tagCrossAZDataGB:       1e6,
+	"log_ingestion_gb":     1e6,
+	"log_storage_gb":       1e6,
+	"storage_gb":           1e6,
+	tagS3RetrievalGB:       1e6,
+	tagSESDataGB:           1e6,
+
+	// Request counts per month
+	"requests_per_month":       1e11,
+	"read_requests_per_month":  1e12,
+	"write_requests_per_month": 1e12,
+	"io_requests_per_month":    1e12,
+	tagAuroraIORequests:        1e12,
+	"api_calls_per_month":      1e11,
+	tagSESEmailsPerMonth:       1e10,
+	tagSFNStateTransitions:     1e12,
+
+	"custom_metrics": 1e5,
+
+	// Analytics usage per month: 1 PB scanned, 1M DPU-hours
+	tagAthenaDataScannedTB: 1e3,
+	tagGlueDPUHours:        1e6,
+}
+
+// warnImplausibleUsage logs a warning when a usage tag exceeds its threshold in
+// usageWarnThresholds. It never changes the value; the estimate proceeds as requested.
+func (p *AWSPublicPlugin) warnImplausibleUsage(traceID, tagName string, value float64) {
+	threshold, ok := usageWarnThresholds[tagName]
+	if !ok || value <= threshold {
+		return
+	}
+	p.logger.Warn().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("tag", tagName).
+		Float64("value", value).
+		Float64("threshold", threshold).
+		Msg("usage value unusually high - verify units (e.g. bytes vs GB)")
+}
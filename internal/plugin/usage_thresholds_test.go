@@ -0,0 +1,63 @@
+package plugin
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestWarnImplausibleUsage verifies a warning is logged only for configured tags whose
+// value exceeds the tag's threshold.
+func TestWarnImplausibleUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		value    float64
+		wantWarn bool
+	}{
+		{name: "below threshold", tag: "data_processed_gb", value: 5000, wantWarn: false},
+		{name: "at threshold", tag: "capacity_units", value: 1000, wantWarn: false},
+		{name: "above threshold", tag: "data_processed_gb", value: 5e9, wantWarn: true},
+		{name: "requests above threshold", tag: "requests_per_month", value: 1e13, wantWarn: true},
+		{name: "custom metrics above threshold", tag: "custom_metrics", value: 200000, wantWarn: true},
+		{name: "unconfigured tag", tag: "storage_size", value: 1e15, wantWarn: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.New(&buf))
+			buf.Reset()
+
+			plugin.warnImplausibleUsage("trace-1", tt.tag, tt.value)
+
+			gotWarn := strings.Contains(buf.String(), "usage value unusually high")
+			if gotWarn != tt.wantWarn {
+				t.Errorf("warning logged = %v, want %v, output: %s", gotWarn, tt.wantWarn, buf.String())
+			}
+			if tt.wantWarn && !strings.Contains(buf.String(), `"tag":"`+tt.tag+`"`) {
+				t.Errorf("warning does not name tag %s, output: %s", tt.tag, buf.String())
+			}
+		})
+	}
+}
+
+// TestWarnImplausibleUsage_FromValidators verifies tags parsed through the shared
+// non-negative validators are checked without per-estimator calls.
+func TestWarnImplausibleUsage_FromValidators(t *testing.T) {
+	var buf bytes.Buffer
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", newMockPricingClient("us-east-1", "USD"), zerolog.New(&buf))
+	buf.Reset()
+
+	// 2 TB expressed in bytes instead of GB
+	if got := plugin.validateNonNegativeFloat64("trace-1", "data_transfer_out_gb", "2000000000000"); got != 2e12 {
+		t.Errorf("validateNonNegativeFloat64() = %v, want the value unchanged", got)
+	}
+	plugin.validateNonNegativeInt64("trace-1", "api_calls_per_month", "5000")
+
+	if n := strings.Count(buf.String(), "usage value unusually high"); n != 1 {
+		t.Errorf("got %d warnings, want 1 (only data_transfer_out_gb), output: %s", n, buf.String())
+	}
+}
@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"strconv"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// EnvDefaultUtilization overrides the CPU utilization (0 < value <= 1) assumed for carbon
+// estimates when neither the request nor the resource supplies one. Unset uses the CCF
+// default of 50% (carbon.DefaultUtilization).
+const EnvDefaultUtilization = "FINFOCUS_DEFAULT_UTILIZATION"
+
+// Utilization tags read when neither proto utilization field is set, in precedence order.
+const (
+	tagUtilization       = "utilization"
+	tagAvgCPUUtilization = "avg_cpu_utilization"
+)
+
+// resourceUtilization returns the utilization for a resource's carbon estimate:
+// per-resource override, then request-level value, then a utilization tag, then the
+// configured default.
+func (p *AWSPublicPlugin) resourceUtilization(req *pbc.GetProjectedCostRequest, resource *pbc.ResourceDescriptor) float64 {
+	defaultUtil := p.baselineUtilization()
+	if util, ok := tagUtilizationValue(p.tagSubset(resource, tagUtilization, tagAvgCPUUtilization)); ok {
+		defaultUtil = util
+	}
+	return carbon.GetUtilizationWithDefault(req.GetUtilizationPercentage(), resource.UtilizationPercentage, defaultUtil)
+}
+
+// tagUtilizationValue reads utilization from the utilization tag, falling back to
+// avg_cpu_utilization. Values in (0, 1] are fractions and values in (1, 100] are
+// percentages, so "0.35" and "35" both mean 35%; "1" means 100%. Zero, negative,
+// out-of-range, and non-numeric values are ignored.
+func tagUtilizationValue(tags map[string]string) (float64, bool) {
+	for _, key := range []string{tagUtilization, tagAvgCPUUtilization} {
+		v, err := strconv.ParseFloat(tags[key], 64)
+		if err != nil || !(v > 0) || v > 100 {
+			continue
+		}
+		if v > 1 {
+			v /= 100
+		}
+		return v, true
+	}
+	return 0, false
+}
+
+// baselineUtilization returns the configured default utilization, or the CCF default
+// when none was configured.
+func (p *AWSPublicPlugin) baselineUtilization() float64 {
+	if p.defaultUtilization > 0 {
+		return p.defaultUtilization
+	}
+	return carbon.DefaultUtilization
+}
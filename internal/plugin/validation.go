@@ -78,7 +78,7 @@ func (p *AWSPublicPlugin) ValidateProjectedCostRequest(ctx context.Context, req
 			// services like S3 and IAM.
 			effectiveRegion = p.region
 		}
-		if effectiveRegion != p.region {
+		if effectiveRegion != p.region && !p.usesRegionFallback(effectiveRegion) {
 			return nil, p.RegionMismatchError(traceID, effectiveRegion)
 		}
 
@@ -111,7 +111,7 @@ func (p *AWSPublicPlugin) ValidateProjectedCostRequest(ctx context.Context, req
 		// only for validation, not returned to the caller.
 	}
 
-	if effectiveRegion != p.region {
+	if effectiveRegion != p.region && !p.usesRegionFallback(effectiveRegion) {
 		return nil, p.RegionMismatchError(traceID, effectiveRegion)
 	}
 
@@ -149,7 +149,7 @@ func (p *AWSPublicPlugin) validateProjectedCostRequestWithResolver(ctx context.C
 		if effectiveRegion == "" {
 			effectiveRegion = p.region
 		}
-		if effectiveRegion != p.region {
+		if effectiveRegion != p.region && !p.usesRegionFallback(effectiveRegion) {
 			return nil, p.RegionMismatchError(traceID, effectiveRegion)
 		}
 
@@ -178,7 +178,7 @@ func (p *AWSPublicPlugin) validateProjectedCostRequestWithResolver(ctx context.C
 		effectiveRegion = p.region
 	}
 
-	if effectiveRegion != p.region {
+	if effectiveRegion != p.region && !p.usesRegionFallback(effectiveRegion) {
 		return nil, p.RegionMismatchError(traceID, effectiveRegion)
 	}
 
@@ -0,0 +1,73 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// tagVerboseBilling opts a resource into appending the full cost formula, with the
+// rates and quantities substituted, to billing_detail. The default stays the concise
+// detail so existing consumers matching on it are unaffected.
+const tagVerboseBilling = "verbose_billing"
+
+// billingFormula collects the arithmetic behind an estimate, one term per charged
+// component. A nil collector discards terms, so callers that never render the formula
+// (e.g. GetActualCost) can pass nil.
+type billingFormula struct {
+	terms []string
+}
+
+// add records one component as "<expr> = $<cost>/mo", where expr is built from format
+// and args. Numeric args should be passed through formulaNum. No-op on a nil collector.
+func (f *billingFormula) add(cost float64, format string, args ...any) {
+	if f == nil {
+		return
+	}
+	f.terms = append(f.terms, fmt.Sprintf(format, args...)+" = $"+formulaNum(cost)+"/mo")
+}
+
+// apply appends the collected formula to resp's billing detail. With several components
+// the monthly total is appended too, so the reader can check the sum.
+func (f *billingFormula) apply(resp *pbc.GetProjectedCostResponse) {
+	if f == nil || len(f.terms) == 0 || resp == nil {
+		return
+	}
+	formula := strings.Join(f.terms, "; ")
+	if len(f.terms) > 1 {
+		formula += "; total $" + formulaNum(resp.CostPerMonth) + "/mo"
+	}
+	resp.BillingDetail += " [formula: " + formula + "]"
+}
+
+// formulaNum formats a rate, quantity, or cost for a formula without float noise
+// (e.g. 7.592 rather than 7.591999999999999) or exponent notation for tiny rates.
+func formulaNum(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e10)/1e10, 'f', -1, 64)
+}
+
+// tieredFormula renders the per-tier arithmetic of calculateTieredCost, e.g.
+// "10000 × $0.3 + 40000 × $0.1".
+func tieredFormula(quantity float64, tiers []pricing.TierRate) string {
+	var parts []string
+	previousUpperBound := 0.0
+	for _, tier := range tiers {
+		if quantity <= previousUpperBound {
+			break
+		}
+		tierQuantity := math.Min(tier.UpTo, quantity) - previousUpperBound
+		if tierQuantity > 0 {
+			parts = append(parts, formulaNum(tierQuantity)+" × $"+formulaNum(tier.Rate))
+		}
+		previousUpperBound = tier.UpTo
+	}
+	if len(parts) == 0 {
+		return "0"
+	}
+	return strings.Join(parts, " + ")
+}
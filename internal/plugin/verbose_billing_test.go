@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// TestGetProjectedCost_VerboseBilling verifies verbose_billing appends each estimator's
+// formula with substituted numbers, and that the default detail is unchanged.
+func TestGetProjectedCost_VerboseBilling(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantFormula  string
+	}{
+		{
+			name:         "ec2 hourly",
+			resourceType: "ec2",
+			sku:          "t3.micro",
+			wantFormula:  " [formula: $0.0104/hr × 730 hrs = $7.592/mo]",
+		},
+		{
+			name:         "ebs size",
+			resourceType: "ebs",
+			sku:          "gp2",
+			tags:         map[string]string{"size": "100"},
+			wantFormula:  " [formula: $0.1/GB-mo × 100 GB = $10/mo]",
+		},
+		{
+			name:         "elb fixed and capacity units",
+			resourceType: "elb",
+			sku:          "alb",
+			tags:         map[string]string{"lcu_per_hour": "2"},
+			wantFormula: " [formula: $0.0225/hr × 730 hrs = $16.425/mo; " +
+				"$0.008/LCU-hr × 2 LCU × 730 hrs = $11.68/mo; total $28.105/mo]",
+		},
+		{
+			name:         "lambda requests and compute",
+			resourceType: "lambda",
+			sku:          "512",
+			tags:         map[string]string{"requests_per_month": "1000000", "avg_duration_ms": "200"},
+			wantFormula: " [formula: $0.0000002/request × 1000000 requests = $0.2/mo; " +
+				"$0.0000166667/GB-s × (512 MB / 1024 × 200 ms / 1000 × 1000000 requests) GB-s = $1.66667/mo; " +
+				"total $1.86667/mo]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			mock.ebsPrices["gp2"] = 0.10
+			mock.albHourlyPrice = 0.0225
+			mock.albLCUPrice = 0.008
+			mock.lambdaPrices["request"] = 0.0000002
+			mock.lambdaPrices["gb-second"] = 0.0000166667
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+			get := func(tags map[string]string) *pbc.GetProjectedCostResponse {
+				resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+					Resource: &pbc.ResourceDescriptor{
+						Provider:     "aws",
+						ResourceType: tt.resourceType,
+						Sku:          tt.sku,
+						Region:       "us-east-1",
+						Tags:         tags,
+					},
+				})
+				if err != nil {
+					t.Fatalf("GetProjectedCost() returned error: %v", err)
+				}
+				return resp
+			}
+
+			concise := get(tt.tags)
+			if strings.Contains(concise.GetBillingDetail(), "[formula:") {
+				t.Errorf("default billing detail %q should not include the formula", concise.GetBillingDetail())
+			}
+
+			verboseTags := map[string]string{tagVerboseBilling: "true"}
+			for k, v := range tt.tags {
+				verboseTags[k] = v
+			}
+			verbose := get(verboseTags)
+			if want := concise.GetBillingDetail() + tt.wantFormula; verbose.GetBillingDetail() != want {
+				t.Errorf("verbose billing detail = %q, want %q", verbose.GetBillingDetail(), want)
+			}
+			if verbose.GetCostPerMonth() != concise.GetCostPerMonth() {
+				t.Errorf("verbose cost = %v, want %v", verbose.GetCostPerMonth(), concise.GetCostPerMonth())
+			}
+		})
+	}
+}
+
+// TestTieredFormula verifies tiered charges render one term per tier used.
+func TestTieredFormula(t *testing.T) {
+	tiers := []pricing.TierRate{
+		{UpTo: 10000, Rate: 0.30},
+		{UpTo: 250000, Rate: 0.10},
+	}
+	tests := []struct {
+		quantity float64
+		want     string
+	}{
+		{0, "0"},
+		{7, "7 × $0.3"},
+		{50000, "10000 × $0.3 + 40000 × $0.1"},
+	}
+	for _, tt := range tests {
+		if got := tieredFormula(tt.quantity, tiers); got != tt.want {
+			t.Errorf("tieredFormula(%v) = %q, want %q", tt.quantity, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// tagPricingVintage selects a dated pricing snapshot (e.g., "2024-01") for before/after
+// comparisons. GetProjectedCostRequest has no vintage field, so it is read from the
+// resource tags. Unset or "latest" uses the current embedded pricing.
+const tagPricingVintage = "pricing_vintage"
+
+// forPricingVintage returns a plugin that prices from the vintage named by the resource's
+// pricing_vintage tag, and the vintage when a snapshot was selected. The copy shares all
+// read-only configuration; only the pricing client differs. Unknown vintages are an
+// InvalidArgument error listing the available ones.
+func (p *AWSPublicPlugin) forPricingVintage(traceID string, resource *pbc.ResourceDescriptor) (*AWSPublicPlugin, string, error) {
+	vintage := strings.TrimSpace(resource.GetTags()[tagPricingVintage])
+	if vintage == "" || vintage == pricing.LatestVintage {
+		return p, "", nil
+	}
+
+	client, ok := p.pricing.ForVintage(vintage)
+	if !ok {
+		available := append([]string{pricing.LatestVintage}, p.pricing.Vintages()...)
+		return nil, "", p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("unknown pricing vintage %q (available: %s)", vintage, strings.Join(available, ", ")),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	vp := *p
+	vp.pricing = client
+	return &vp, vintage, nil
+}
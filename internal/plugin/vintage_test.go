@@ -0,0 +1,76 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_PricingVintage verifies the pricing_vintage tag routes lookups to a
+// dated snapshot, defaults to the latest pricing and rejects unknown vintages.
+func TestGetProjectedCost_PricingVintage(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	snapshot := newMockPricingClient("us-east-1", "USD")
+	snapshot.ec2Prices["t3.micro/Linux/Shared"] = 0.0094
+	mock.vintages = map[string]*mockPricingClient{"2024-01": snapshot}
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	tests := []struct {
+		name         string
+		vintage      string
+		wantRate     float64
+		wantSnapshot bool
+		wantErr      string
+	}{
+		{name: "default uses latest", wantRate: 0.0104},
+		{name: "explicit latest", vintage: "latest", wantRate: 0.0104},
+		{name: "dated snapshot", vintage: "2024-01", wantRate: 0.0094, wantSnapshot: true},
+		{name: "unknown vintage", vintage: "2019-01", wantErr: "available: latest, 2024-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := map[string]string{}
+			if tt.vintage != "" {
+				tags[tagPricingVintage] = tt.vintage
+			}
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "ec2",
+					Sku:          "t3.micro",
+					Region:       "us-east-1",
+					Tags:         tags,
+				},
+			})
+
+			if tt.wantErr != "" {
+				if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("GetProjectedCost() error = %v, want InvalidArgument containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			if resp.UnitPrice != tt.wantRate {
+				t.Errorf("UnitPrice = %v, want %v", resp.UnitPrice, tt.wantRate)
+			}
+			if hasNote := strings.Contains(resp.BillingDetail, "pricing snapshot"); hasNote != tt.wantSnapshot {
+				t.Errorf("BillingDetail = %q, snapshot note present = %v, want %v", resp.BillingDetail, hasNote, tt.wantSnapshot)
+			}
+		})
+	}
+
+	// The plugin's own pricing client is unchanged by a vintage request
+	if plugin.pricing != mock {
+		t.Error("GetProjectedCost() must not replace the shared pricing client")
+	}
+}
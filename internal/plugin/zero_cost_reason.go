@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// zeroCostReasonHeaderKey says why GetProjectedCost returned a $0 estimate.
+// GetProjectedCostResponse has no field for it, so it is sent as a response header next
+// to the prose billing detail. It is only set when CostPerMonth is 0.
+const zeroCostReasonHeaderKey = "finfocus-zero-cost-reason"
+
+// ZeroCostReason is the machine-readable cause of a $0 estimate, letting clients tell
+// estimates worth retrying with better tags from resources to skip.
+type ZeroCostReason string
+
+const (
+	// ZeroCostFreeResource is a resource AWS does not charge for (VPC, subnet, security
+	// group, IAM).
+	ZeroCostFreeResource ZeroCostReason = "free_resource"
+	// ZeroCostUnknownSKU is a SKU (instance type, volume type, storage class, ...) the
+	// region's pricing data does not list; retry with a valid SKU.
+	ZeroCostUnknownSKU ZeroCostReason = "unknown_sku"
+	// ZeroCostPricingUnavailable is a service without pricing data for the region.
+	ZeroCostPricingUnavailable ZeroCostReason = "pricing_unavailable"
+	// ZeroCostZeroUsage is a priced estimate whose usage tags (requests, GB, ...) are zero
+	// or unset; retry with usage tags.
+	ZeroCostZeroUsage ZeroCostReason = "zero_usage"
+	// ZeroCostNotImplemented is a recognized service whose estimator is a stub.
+	ZeroCostNotImplemented ZeroCostReason = "not_implemented"
+	// ZeroCostUnsupportedType is a resource type the plugin cannot estimate.
+	ZeroCostUnsupportedType ZeroCostReason = "unsupported_type"
+)
+
+// Billing-detail markers of the shared $0 message templates, without their arguments.
+var (
+	pricingNotFoundMarker    = strings.TrimPrefix(PricingNotFoundTemplate, "%s %q")
+	pricingUnavailableMarker = strings.TrimSuffix(strings.TrimPrefix(PricingUnavailableTemplate, "%s"), "%s")
+)
+
+// zeroCostReasonFor classifies a $0 estimate of serviceType. Free, stub and unsupported
+// services are known from their support level (level); other $0 estimates are classified by the
+// PricingNotFoundTemplate / PricingUnavailableTemplate detail their estimator returned,
+// and otherwise were priced with zero usage. It returns "" for a non-zero estimate.
+func zeroCostReasonFor(serviceType string, level SupportLevel, resp *pbc.GetProjectedCostResponse) ZeroCostReason {
+	if resp == nil || resp.CostPerMonth != 0 {
+		return ""
+	}
+	switch {
+	case IsZeroCostService(serviceType):
+		return ZeroCostFreeResource
+	case level == SupportLevelStub:
+		return ZeroCostNotImplemented
+	case level == SupportLevelUnsupported:
+		return ZeroCostUnsupportedType
+	case strings.Contains(resp.BillingDetail, pricingNotFoundMarker):
+		return ZeroCostUnknownSKU
+	case strings.Contains(resp.BillingDetail, pricingUnavailableMarker):
+		return ZeroCostPricingUnavailable
+	default:
+		return ZeroCostZeroUsage
+	}
+}
+
+// setZeroCostReasonHeader sends the reason for a $0 estimate as a response header. It is
+// a no-op outside a gRPC server stream or when reason is empty.
+func (p *AWSPublicPlugin) setZeroCostReasonHeader(ctx context.Context, traceID string, reason ZeroCostReason) {
+	if reason == "" || grpc.ServerTransportStreamFromContext(ctx) == nil {
+		return
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(zeroCostReasonHeaderKey, string(reason))); err != nil {
+		p.logger.Debug().
+			Str(pluginsdk.FieldTraceID, traceID).
+			Err(err).
+			Msg("failed to set zero cost reason header")
+	}
+}
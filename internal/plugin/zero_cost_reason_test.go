@@ -0,0 +1,82 @@
+package plugin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
+)
+
+// TestGetProjectedCost_ZeroCostReason verifies each $0 path sets its reason header, the
+// prose billing detail is kept, and priced estimates carry no reason.
+func TestGetProjectedCost_ZeroCostReason(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		lambdaPriced bool
+		want         ZeroCostReason
+		wantDetail   string
+	}{
+		{name: "free resource", resourceType: "vpc", want: ZeroCostFreeResource, wantDetail: "no direct hourly or monthly charge"},
+		{name: "unknown SKU", resourceType: "ec2", sku: "m9.huge", want: ZeroCostUnknownSKU, wantDetail: "not found in pricing data"},
+		{
+			name: "unknown SKU with verbose billing", resourceType: "ec2", sku: "m9.huge",
+			tags: map[string]string{tagVerboseBilling: "true"}, want: ZeroCostUnknownSKU,
+		},
+		{name: "pricing unavailable", resourceType: "lambda", sku: "128", want: ZeroCostPricingUnavailable, wantDetail: "pricing data not available"},
+		{name: "zero usage", resourceType: "lambda", sku: "128", lambdaPriced: true, want: ZeroCostZeroUsage},
+		{name: "stub service", resourceType: "sqs", sku: "standard", want: ZeroCostNotImplemented, wantDetail: "not fully implemented"},
+		{name: "unsupported type", resourceType: "aws:sagemaker/endpoint:Endpoint", sku: "ml.m5.large", want: ZeroCostUnsupportedType, wantDetail: "not supported"},
+		{name: "priced estimate", resourceType: "ec2", sku: "t3.micro"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := newMockPricingClient("us-east-1", "USD")
+			mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+			if tt.lambdaPriced {
+				mock.lambdaPrices["request"] = 0.0000002
+				mock.lambdaPrices["gb-second"] = 0.0000166667
+			}
+			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+			plugin.supportLevels = map[string]SupportLevel{"sqs": SupportLevelStub}
+
+			stream := &captureTransportStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			if err != nil {
+				t.Fatalf("GetProjectedCost() returned error: %v", err)
+			}
+
+			values := stream.header.Get(zeroCostReasonHeaderKey)
+			if tt.want == "" {
+				if len(values) != 0 {
+					t.Errorf("header %s = %v, want none for $%v", zeroCostReasonHeaderKey, values, resp.CostPerMonth)
+				}
+				return
+			}
+			if resp.CostPerMonth != 0 {
+				t.Fatalf("CostPerMonth = %v, want 0", resp.CostPerMonth)
+			}
+			if len(values) != 1 || values[0] != string(tt.want) {
+				t.Errorf("header %s = %v, want [%s]", zeroCostReasonHeaderKey, values, tt.want)
+			}
+			if !strings.Contains(resp.BillingDetail, tt.wantDetail) {
+				t.Errorf("BillingDetail = %q, want it to contain %q", resp.BillingDetail, tt.wantDetail)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package pricing
 
 import (
 	"fmt"
+	"io/fs"
 	"math"
 	"sort"
 	"strconv"
@@ -22,6 +23,20 @@ var elasticacheEngineNormalization = map[string]string{
 	"valkey":    "Valkey",
 }
 
+// s3StorageClassByVolumeType maps the S3 price list's volumeType attribute to the
+// S3 API storage class names (STANDARD, GLACIER_IR, ...) that resources use as SKU.
+// The storageClass attribute alone is ambiguous: "Infrequent Access" covers both
+// STANDARD_IA and ONEZONE_IA, and "Archive" both GLACIER and DEEP_ARCHIVE.
+var s3StorageClassByVolumeType = map[string]string{
+	"Standard":                            "STANDARD",
+	"Standard - Infrequent Access":        "STANDARD_IA",
+	"One Zone - Infrequent Access":        "ONEZONE_IA",
+	"Intelligent-Tiering Frequent Access": "INTELLIGENT_TIERING",
+	"Glacier Instant Retrieval":           "GLACIER_IR",
+	"Amazon Glacier":                      "GLACIER",
+	"Glacier Deep Archive":                "DEEP_ARCHIVE",
+}
+
 // PricingClient provides pricing data lookups
 type PricingClient interface {
 	// Region returns the AWS region for this pricing data
@@ -34,30 +49,122 @@ type PricingClient interface {
 	// Returns (price, true) if found, (0, false) if not found
 	EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool)
 
+	// EC2NetworkPerformance returns the published network performance of an EC2
+	// instance type (e.g., "Up to 12.5 Gigabit"), taken from the EC2 price list.
+	// Returns ("", false) if the instance type is not in the pricing data
+	EC2NetworkPerformance(instanceType string) (string, bool)
+
+	// EC2InstanceTypes returns the distinct EC2 instance types with On-Demand pricing,
+	// sorted (e.g., "m5.large", "t3.micro"). Callers must not modify the returned slice.
+	EC2InstanceTypes() []string
+
+	// EC2InterAZDataTransferPricePerGB returns the per-GB rate for data transferred
+	// between Availability Zones in the region, charged in each direction.
+	// Returns (price, true) if found, (0, false) if not found
+	EC2InterAZDataTransferPricePerGB() (float64, bool)
+
 	// EBSPricePerGBMonth returns monthly rate per GB for an EBS volume
 	// Returns (price, true) if found, (0, false) if not found
 	EBSPricePerGBMonth(volumeType string) (float64, bool)
 
+	// EBSVolumeTypes returns the EBS volume types with storage pricing, sorted
+	// (e.g., "gp2", "gp3", "io2").
+	EBSVolumeTypes() []string
+
+	// EBSSnapshotPricePerGBMonth returns the monthly rate per GB of standard-tier EBS
+	// snapshot storage.
+	// Returns (price, true) if found, (0, false) if not found
+	EBSSnapshotPricePerGBMonth() (float64, bool)
+
+	// EBSIOPSPricePerMonth returns the monthly rate per provisioned IOPS for an EBS volume
+	// type (e.g., gp3 above baseline, io1, io2 first tier).
+	// Returns (price, true) if found, (0, false) if the volume type has no IOPS charge
+	EBSIOPSPricePerMonth(volumeType string) (float64, bool)
+
+	// EBSIOPSTiers returns the tiered monthly rates per provisioned IOPS for an EBS
+	// volume type whose IOPS are priced in tiers (io2 Block Express above 32,000 and
+	// 64,000 IOPS). Each TierRate.UpTo is an IOPS count.
+	// Returns (tiers, true) if found, (nil, false) if the volume type has a flat IOPS rate
+	EBSIOPSTiers(volumeType string) ([]TierRate, bool)
+
+	// EBSThroughputPricePerMonth returns the monthly rate per provisioned MiB/s of
+	// throughput for an EBS volume type (gp3 above baseline).
+	// Returns (price, true) if found, (0, false) if the volume type has no throughput charge
+	EBSThroughputPricePerMonth(volumeType string) (float64, bool)
+
 	// S3PricePerGBMonth returns monthly rate per GB for S3 storage
 	// Returns (price, true) if found, (0, false) if not found
 	S3PricePerGBMonth(storageClass string) (float64, bool)
 
+	// S3StorageClasses returns the storage class names S3PricePerGBMonth accepts, sorted.
+	// Both the price list names (e.g., "General Purpose") and the API names (e.g.,
+	// "STANDARD") are included.
+	S3StorageClasses() []string
+
+	// S3IntelligentTieringMonitoringPricePerObject returns the monthly monitoring and
+	// automation charge per object stored in S3 Intelligent-Tiering.
+	// Returns (price, true) if found, (0, false) if not found
+	S3IntelligentTieringMonitoringPricePerObject() (float64, bool)
+
+	// S3RetrievalPricePerGB returns the per-GB data retrieval charge for an S3 storage
+	// class (standard retrieval tier for GLACIER and DEEP_ARCHIVE).
+	// Returns (price, true) if found, (0, false) if the class has no retrieval charge
+	S3RetrievalPricePerGB(storageClass string) (float64, bool)
+
 	// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
 	// instanceType: e.g., "db.t3.medium"
 	// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
 	// Returns (price, true) if found, (0, false) if not found
 	RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool)
 
+	// RDSInstanceTypes returns the distinct RDS instance types with On-Demand pricing for
+	// any engine, sorted (e.g., "db.m5.large", "db.t3.micro").
+	RDSInstanceTypes() []string
+
 	// RDSStoragePricePerGBMonth returns monthly rate per GB for RDS storage
 	// volumeType: e.g., "gp2", "gp3", "io1"
 	// Returns (price, true) if found, (0, false) if not found
 	RDSStoragePricePerGBMonth(volumeType string) (float64, bool)
 
+	// RDSReservedPricePerHour returns the hourly rate for a 1-year, No Upfront, standard
+	// Reserved Instance (Single-AZ).
+	// instanceType: e.g., "db.t3.medium"
+	// engine: normalized engine name, e.g., "MySQL", "Aurora PostgreSQL"
+	// Returns (price, true) if found, (0, false) if reserved terms are not in the embedded data
+	RDSReservedPricePerHour(instanceType, engine string) (float64, bool)
+
+	// RDSAuroraIOOptimizedPricePerHour returns the hourly rate for an Aurora instance in an
+	// I/O-Optimized cluster, which carries higher instance and storage rates but no per-I/O charges.
+	// engine: "Aurora MySQL" or "Aurora PostgreSQL"
+	// Returns (price, true) if found, (0, false) if not found
+	RDSAuroraIOOptimizedPricePerHour(instanceType, engine string) (float64, bool)
+
+	// RDSAuroraIORequestPrice returns the cost per I/O request for Aurora Standard storage.
+	// Returns (price, true) if found, (0, false) if not found
+	RDSAuroraIORequestPrice() (float64, bool)
+
 	// EKSClusterPricePerHour returns hourly rate for EKS cluster control plane.
 	// extendedSupport: true for extended support pricing, false for standard support.
 	// Returns (price, true) if found, (0, false) if not found.
 	EKSClusterPricePerHour(extendedSupport bool) (float64, bool)
 
+	// PricingMetadata returns the AWS Price List version and publication date of the
+	// embedded data for a service offer code (e.g., "AmazonEC2").
+	// Returns ("", "", false) if the service's data carried no metadata.
+	PricingMetadata(offerCode string) (version, publicationDate string, found bool)
+
+	// SchemaDrift returns expected product families ("offerCode/family") that indexed
+	// no entries, which usually means AWS renamed them.
+	SchemaDrift() []string
+
+	// PriceInconsistencies returns "offerCode: detail" entries for parsed rates that
+	// are implausible next to another service's rate for the same resource.
+	PriceInconsistencies() []string
+
+	// EKSFargatePricePerHour returns the hourly rates for EKS pods running on Fargate.
+	// Returns (vCPU-hour rate, GB-hour rate, true) if both are found, (0, 0, false) otherwise.
+	EKSFargatePricePerHour() (vcpuRate, gbRate float64, found bool)
+
 	// LambdaPricePerRequest returns the cost per request (same for all architectures)
 	// Returns (price, true) if found, (0, false) if not found
 	LambdaPricePerRequest() (float64, bool)
@@ -67,6 +174,10 @@ type PricingClient interface {
 	// Returns (price, true) if found, (0, false) if not found
 	LambdaPricePerGBSecond(arch string) (float64, bool)
 
+	// LambdaHasARMPricing reports whether the region has an explicit arm64 GB-second rate.
+	// When false, LambdaPricePerGBSecond("arm64") returns the x86_64 rate.
+	LambdaHasARMPricing() bool
+
 	// DynamoDBOnDemandReadPrice returns the cost per read request unit.
 	// Returns (price, true) if found, (0, false) if not found
 	DynamoDBOnDemandReadPrice() (float64, bool)
@@ -103,6 +214,14 @@ type PricingClient interface {
 	// Returns (price, true) if found, (0, false) if not found.
 	NLBPricePerNLCU() (float64, bool)
 
+	// ELBOutpostsPricePerHour returns the fixed hourly rate for a load balancer ("alb" or
+	// "nlb") running on AWS Outposts. Returns (price, true) if found, (0, false) if not found.
+	ELBOutpostsPricePerHour(lbType string) (float64, bool)
+
+	// ELBOutpostsPricePerCapacityUnit returns the cost per LCU-hour ("alb") or NLCU-hour
+	// ("nlb") on AWS Outposts. Returns (price, true) if found, (0, false) if not found.
+	ELBOutpostsPricePerCapacityUnit(lbType string) (float64, bool)
+
 	// NATGatewayPrice returns the pricing for a NAT Gateway (hourly and data processing).
 	// Returns (price, true) if found, (nil, false) if not found.
 	NATGatewayPrice() (*NATGatewayPrice, bool)
@@ -124,6 +243,90 @@ type PricingClient interface {
 	// engine: "redis", "memcached", or "valkey" (case-insensitive)
 	// Returns (price, true) if found, (0, false) if not found.
 	ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool)
+
+	// ElastiCacheNodeTypes returns the distinct ElastiCache node types with On-Demand
+	// pricing for any engine, sorted (e.g., "cache.m5.large", "cache.t3.micro").
+	ElastiCacheNodeTypes() []string
+
+	// ECRStoragePricePerGBMonth returns the per-GB-month rate for ECR image storage.
+	// Returns (price, true) if found, (0, false) if not found.
+	ECRStoragePricePerGBMonth() (float64, bool)
+
+	// ECRDataTransferOutPricePerGB returns the first paid tier rate per GB of ECR
+	// data transferred out to the internet.
+	// Returns (price, true) if found, (0, false) if not found.
+	ECRDataTransferOutPricePerGB() (float64, bool)
+
+	// SecretsManagerPricePerSecret returns the monthly rate per Secrets Manager secret.
+	// Returns (price, true) if found, (0, false) if not found.
+	SecretsManagerPricePerSecret() (float64, bool)
+
+	// SecretsManagerPricePerAPICall returns the cost per Secrets Manager API call.
+	// Returns (price, true) if found, (0, false) if not found.
+	SecretsManagerPricePerAPICall() (float64, bool)
+
+	// KMSPricePerKey returns the monthly rate per KMS customer managed key.
+	// Returns (price, true) if found, (0, false) if not found.
+	KMSPricePerKey() (float64, bool)
+
+	// KMSPricePerRequest returns the cost per KMS request beyond the free tier.
+	// Returns (price, true) if found, (0, false) if not found.
+	KMSPricePerRequest() (float64, bool)
+
+	// WAFPricePerWebACL returns the monthly rate per AWS WAF web ACL.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerWebACL() (float64, bool)
+
+	// WAFPricePerRule returns the monthly rate per rule in an AWS WAF web ACL.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerRule() (float64, bool)
+
+	// WAFPricePerRequest returns the cost per web request inspected by AWS WAF.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerRequest() (float64, bool)
+
+	// AthenaPricePerTBScanned returns the cost per TB of data scanned by Amazon Athena queries.
+	// Returns (price, true) if found, (0, false) if not found.
+	AthenaPricePerTBScanned() (float64, bool)
+
+	// GluePricePerDPUHour returns the AWS Glue rate per DPU-hour for jobType ("etl",
+	// "flex" or "crawler").
+	// Returns (price, true) if found, (0, false) if not found.
+	GluePricePerDPUHour(jobType string) (float64, bool)
+
+	// SESPricePerEmail returns the cost per outbound email recipient sent through Amazon SES.
+	// Returns (price, true) if found, (0, false) if not found.
+	SESPricePerEmail() (float64, bool)
+
+	// SESPricePerAttachmentGB returns the cost per GB of email attachments sent through Amazon SES.
+	// Returns (price, true) if found, (0, false) if not found.
+	SESPricePerAttachmentGB() (float64, bool)
+
+	// StepFunctionsPricePerTransition returns the cost per state transition of a Standard workflow.
+	// Returns (price, true) if found, (0, false) if not found.
+	StepFunctionsPricePerTransition() (float64, bool)
+
+	// StepFunctionsExpressPricing returns the rates for Express workflows.
+	// Returns (request rate, GB-second rate, true) if both are found, (0, 0, false) otherwise.
+	StepFunctionsExpressPricing() (perRequest, perGBSecond float64, found bool)
+
+	// Vintages returns the dated pricing snapshots embedded for this region, oldest first.
+	Vintages() []string
+
+	// ForVintage returns a PricingClient backed by the snapshot for vintage (e.g., "2024-01").
+	// An empty vintage or LatestVintage returns the current embedded data.
+	// Returns (client, true) if found, (nil, false) if not found.
+	ForVintage(vintage string) (PricingClient, bool)
+}
+
+// DefaultSlowLookupThreshold is the pricing lookup duration above which a warning is logged.
+const DefaultSlowLookupThreshold = 50 * time.Millisecond
+
+// ClientOptions configures optional Client behavior.
+type ClientOptions struct {
+	// SlowLookupThreshold is the lookup duration above which a "pricing lookup took
+	// too long" warning is logged. Zero or negative uses DefaultSlowLookupThreshold.
+	SlowLookupThreshold time.Duration
 }
 
 // Client implements PricingClient with embedded JSON data
@@ -132,19 +335,54 @@ type Client struct {
 	currency string
 	logger   zerolog.Logger // Add zerolog logger
 
+	// slowLookupThreshold triggers lookup latency warnings (read-only after construction)
+	slowLookupThreshold time.Duration
+
 	// Thread-safe initialization
 	once sync.Once
 	err  error
 
+	// initDuration is how long init spent parsing the pricing data
+	initDuration time.Duration
+
 	// In-memory pricing indexes (built on first access)
 	ec2Index map[string]ec2Price
 	ebsIndex map[string]ebsPrice
 	s3Index  map[string]s3Price
 
+	// S3 Intelligent-Tiering monitoring and automation charge per object-month
+	s3ITMonitoringRate float64
+
+	// S3 per-GB retrieval charges (key: API storage class, e.g., "GLACIER_IR")
+	s3RetrievalIndex map[string]float64
+
+	// EC2 network performance index (key: instanceType, e.g., "m5.large" -> "Up to 10 Gigabit")
+	ec2NetworkIndex map[string]string
+
+	// Distinct priced EC2 instance types, built from ec2Index on first use
+	ec2InstanceTypes     []string
+	ec2InstanceTypesOnce sync.Once
+
+	// EC2 inter-AZ data transfer rate per GB, charged in each direction
+	ec2InterAZTransferRate float64
+
+	// EBS standard-tier snapshot storage rate per GB-month
+	ebsSnapshotRate float64
+
+	// EBS performance add-on indexes (key: volumeApiName, e.g., "gp3")
+	ebsIOPSIndex       map[string]ebsProvisionedPrice
+	ebsThroughputIndex map[string]ebsProvisionedPrice
+	ebsIOPSTierIndex   map[string][]TierRate // volume types with tiered IOPS (io2)
+
 	// RDS pricing indexes (key: "instanceType/engine" for instances, "volumeType" for storage)
 	rdsInstanceIndex map[string]rdsInstancePrice
 	rdsStorageIndex  map[string]rdsStoragePrice
 
+	// RDS reserved (1yr No Upfront) and Aurora I/O-Optimized instance indexes (key: "instanceType/engine")
+	rdsReservedIndex    map[string]rdsInstancePrice
+	rdsIOOptimizedIndex map[string]rdsInstancePrice
+	rdsAuroraIOPricing  *rdsIOPrice
+
 	// EKS pricing (single cluster rate)
 	eksPricing *eksPrice
 
@@ -157,6 +395,10 @@ type Client struct {
 	// ELB pricing (single rate per region)
 	elbPricing *elbPrice
 
+	// ELB on Outposts pricing ("Outposts-" usage types), kept apart so it never
+	// replaces the regional rates; nil when the region publishes none
+	elbOutpostsPricing *elbPrice
+
 	// NAT Gateway pricing (single rate per region)
 	natGatewayPricing *NATGatewayPrice
 
@@ -165,6 +407,46 @@ type Client struct {
 
 	// ElastiCache pricing index (key: "instanceType:engine", e.g., "cache.m5.large:Redis")
 	elasticacheIndex map[string]elasticacheInstancePrice
+
+	// ECR pricing (single rate per region)
+	ecrPricing *ecrPrice
+
+	// Secrets Manager pricing (single rate per region)
+	secretsManagerPricing *secretsManagerPrice
+
+	// KMS pricing (single rate per region)
+	kmsPricing *kmsPrice
+
+	// WAF pricing (single rate per region)
+	wafPricing *wafPrice
+
+	// Athena pricing (single rate per region)
+	athenaPricing *athenaPrice
+
+	// Glue pricing (one rate per job type)
+	gluePricing *gluePrice
+
+	// SES pricing (single rate per region)
+	sesPricing *sesPrice
+
+	// Step Functions pricing (single rate per region and workflow type)
+	stepFunctionsPricing *stepFunctionsPrice
+
+	// Per-service embedded data provenance (key: offerCode). Parsers run in
+	// parallel, so writes are guarded by metadataMu.
+	metadataMu sync.Mutex
+	metadata   map[string]pricingMetadata
+
+	// Raw service files parsed by init; nil means the embedded current data
+	data *rawPricingData
+
+	// Dated pricing snapshots (key: vintage, e.g., "2024-01"), indexed on first use.
+	// Each vintage gets its own Client, built lazily under vintageMu.
+	snapshotFS     fs.FS
+	snapshotsOnce  sync.Once
+	snapshots      map[string]*rawPricingData
+	vintageMu      sync.Mutex
+	vintageClients map[string]*Client
 }
 
 // NewClient creates a Client from embedded rawPricingJSON.
@@ -173,8 +455,17 @@ type Client struct {
 // warnings during pricing lookups and other client-level diagnostics.
 // It returns an initialized *Client or a non-nil error if initialization fails.
 func NewClient(logger zerolog.Logger) (*Client, error) {
+	return NewClientWithOptions(logger, ClientOptions{})
+}
+
+// NewClientWithOptions creates a Client like NewClient, applying opts.
+// Use it to tune the slow lookup warning threshold: noisy environments can raise it,
+// performance-sensitive ones can lower it.
+func NewClientWithOptions(logger zerolog.Logger, opts ClientOptions) (*Client, error) {
 	c := &Client{
-		logger: logger, // Initialize the logger
+		logger:              logger, // Initialize the logger
+		slowLookupThreshold: opts.SlowLookupThreshold,
+		snapshotFS:          snapshotFS,
 	}
 	if err := c.init(); err != nil {
 		return nil, err
@@ -182,10 +473,220 @@ func NewClient(logger zerolog.Logger) (*Client, error) {
 	return c, nil
 }
 
-// init parses embedded pricing data exactly once.
+// WarmUp builds the indexes that are otherwise built on first use: the priced EC2
+// instance type list and the pricing snapshot index. The pricing data itself is parsed
+// by NewClientWithOptions, so WarmUp does not re-parse it; the init call only covers
+// clients not built by a constructor. Call it at startup so the first request after a
+// cold start does not pay for the lazy indexes; lookups still build them without it.
+// Returns the time already spent parsing the pricing data, for logging.
+func (c *Client) WarmUp() (time.Duration, error) {
+	if err := c.init(); err != nil {
+		return 0, err
+	}
+	c.EC2InstanceTypes()
+	c.loadSnapshots()
+	return c.initDuration, nil
+}
+
+// recordMetadata stores the version and publication date of a parsed service file.
+// Files without either field (e.g., empty stubs) are not recorded.
+func (c *Client) recordMetadata(offerCode string, pricing *awsPricing) {
+	if pricing.Version == "" && pricing.PublicationDate == "" {
+		return
+	}
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	if c.metadata == nil {
+		c.metadata = make(map[string]pricingMetadata)
+	}
+	c.metadata[offerCode] = pricingMetadata{
+		Version:         pricing.Version,
+		PublicationDate: pricing.PublicationDate,
+		OfferCode:       pricing.OfferCode,
+		MissingFamilies: c.metadata[offerCode].MissingFamilies,
+		Inconsistencies: c.metadata[offerCode].Inconsistencies,
+	}
+}
+
+// expectFamily flags schema drift when a parse pass over a file with products indexed no
+// entries for a product family its parser keys on, e.g. after AWS renames "Compute
+// Instance". Parsers that key on usage types rather than families pass the usage type
+// suffix instead (e.g. "KMS-Keys"). Without this the index silently empties and every
+// estimate becomes $0. Empty files (stubs, unsupported regions) are not drift.
+func (c *Client) expectFamily(offerCode string, pricing *awsPricing, family string, entries int) {
+	if len(pricing.Products) == 0 || entries > 0 {
+		return
+	}
+
+	c.logger.Warn().
+		Str("offer_code", offerCode).
+		Str("product_family", family).
+		Int("products", len(pricing.Products)).
+		Msg("PRICING SCHEMA DRIFT: expected product family yielded no entries, estimates will be $0")
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	if c.metadata == nil {
+		c.metadata = make(map[string]pricingMetadata)
+	}
+	meta := c.metadata[offerCode]
+	meta.MissingFamilies = append(meta.MissingFamilies, family)
+	c.metadata[offerCode] = meta
+}
+
+// countRates returns how many of rates were indexed (are positive), as the entries
+// count for expectFamily.
+func countRates(rates ...float64) int {
+	n := 0
+	for _, rate := range rates {
+		if rate > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// SchemaDrift returns the expected product families that indexed no entries, as sorted
+// "offerCode/family" strings (e.g. "AmazonEC2/Compute Instance"). Empty means every
+// parser found what it expected.
+func (c *Client) SchemaDrift() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	var drift []string
+	for offerCode, meta := range c.metadata {
+		for _, family := range meta.MissingFamilies {
+			drift = append(drift, offerCode+"/"+family)
+		}
+	}
+	sort.Strings(drift)
+	return drift
+}
+
+// RDS storage runs on EBS volumes, so its gp3 rate is at least the EBS gp3 rate. The
+// Single-AZ premium is ~1.4x and Multi-AZ doubles that, so anything outside this band
+// means a parser matched the wrong product.
+const (
+	rdsGP3MinEBSRatio = 1.0
+	rdsGP3MaxEBSRatio = 4.0
+)
+
+// checkStorageConsistency compares the RDS gp3 storage rate with the EBS gp3 rate.
+// They are parsed by separate heuristics (RDS infers gp3 from the usage type), so a
+// misparse in either shows up as an implausible ratio. Divergence is logged and
+// recorded against AmazonRDS; it does not fail initialization.
+func (c *Client) checkStorageConsistency() {
+	rds, ok := c.rdsStorageIndex["gp3"]
+	if !ok || rds.RatePerGBMonth == 0 {
+		return
+	}
+	ebs, ok := c.ebsIndex["gp3"]
+	if !ok || ebs.RatePerGBMonth == 0 {
+		return
+	}
+
+	ratio := rds.RatePerGBMonth / ebs.RatePerGBMonth
+	if ratio >= rdsGP3MinEBSRatio && ratio <= rdsGP3MaxEBSRatio {
+		return
+	}
+
+	c.logger.Warn().
+		Str("region", c.region).
+		Float64("rds_gp3_rate", rds.RatePerGBMonth).
+		Float64("ebs_gp3_rate", ebs.RatePerGBMonth).
+		Float64("ratio", ratio).
+		Msg("PRICING INCONSISTENCY: RDS gp3 storage rate is implausible next to EBS gp3, check the RDS storage parser")
+
+	detail := fmt.Sprintf("gp3 storage $%g/GB-month is %.2fx EBS gp3 $%g/GB-month (expected %gx-%gx)",
+		rds.RatePerGBMonth, ratio, ebs.RatePerGBMonth, rdsGP3MinEBSRatio, rdsGP3MaxEBSRatio)
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	if c.metadata == nil {
+		c.metadata = make(map[string]pricingMetadata)
+	}
+	meta := c.metadata["AmazonRDS"]
+	meta.Inconsistencies = append(meta.Inconsistencies, detail)
+	c.metadata["AmazonRDS"] = meta
+}
+
+// PriceInconsistencies returns the rates that failed a cross-service sanity check, as
+// sorted "offerCode: detail" strings. Empty means every check passed or was skipped.
+func (c *Client) PriceInconsistencies() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	var found []string
+	for offerCode, meta := range c.metadata {
+		for _, detail := range meta.Inconsistencies {
+			found = append(found, offerCode+": "+detail)
+		}
+	}
+	sort.Strings(found)
+	return found
+}
+
+// PricingMetadata returns the embedded pricing version and publication date for offerCode.
+func (c *Client) PricingMetadata(offerCode string) (string, string, bool) {
+	if err := c.init(); err != nil {
+		return "", "", false
+	}
+
+	c.metadataMu.Lock()
+	defer c.metadataMu.Unlock()
+	meta, ok := c.metadata[offerCode]
+	if !ok || (meta.Version == "" && meta.PublicationDate == "") {
+		// Entries holding only a schema drift record carry no provenance
+		return "", "", false
+	}
+	return meta.Version, meta.PublicationDate, true
+}
+
+// isSlowLookup reports whether a lookup exceeded the configured warning threshold.
+func (c *Client) isSlowLookup(elapsed time.Duration) bool {
+	threshold := c.slowLookupThreshold
+	if threshold <= 0 {
+		threshold = DefaultSlowLookupThreshold
+	}
+	return elapsed > threshold
+}
+
+// Sources for the client's region, reported in the region_source log field.
+const (
+	regionSourcePricingData   = "pricing_data"   // regionCode of the EC2 products
+	regionSourceBuildConstant = "build_constant" // embeddedRegion of the regional build
+	regionSourceNone          = "none"           // neither available; region is "unknown"
+)
+
+// resolveRegion returns the client's region and where it came from. The regionCode
+// detected from the EC2 products wins; if the data lacks it but still priced EC2
+// instances, buildRegion (the region the embedded data was generated for) is used so a
+// regional binary does not reject every request as a region mismatch. Data without EC2
+// prices (e.g., a snapshot that stubs EC2 with "{}") stays "unknown" so the empty
+// index is tolerated as in fallback builds.
+func resolveRegion(productRegion, buildRegion string, hasEC2Prices bool) (region, source string) {
+	if productRegion != "" {
+		return productRegion, regionSourcePricingData
+	}
+	if buildRegion != "" && hasEC2Prices {
+		return buildRegion, regionSourceBuildConstant
+	}
+	return "unknown", regionSourceNone
+}
+
+// init parses the client's pricing data (the embedded current data, or one dated
+// snapshot for vintage clients) exactly once.
 // Parsing is parallelized across services for faster initialization.
 func (c *Client) init() error {
 	c.once.Do(func() {
+		data := c.rawData()
+
 		// Initialize indexes
 		c.currency = "USD"
 		c.region = "unknown"
@@ -194,10 +695,16 @@ func (c *Client) init() error {
 		// Capacity estimates derived from us-east-1 (largest region) with ~20-30% buffer for growth.
 		// See GitHub issue #176 for sizing rationale.
 		c.ec2Index = make(map[string]ec2Price, 100000)                       // ~90k EC2 products
+		c.ec2NetworkIndex = make(map[string]string, 1000)                    // ~800 instance types
 		c.ebsIndex = make(map[string]ebsPrice, 50)                           // ~20-30 volume types
+		c.ebsIOPSIndex = make(map[string]ebsProvisionedPrice, 10)            // gp3, io1, io2
+		c.ebsThroughputIndex = make(map[string]ebsProvisionedPrice, 10)      // gp3
 		c.s3Index = make(map[string]s3Price, 100)                            // ~50-100 storage classes
+		c.s3RetrievalIndex = make(map[string]float64, 10)                    // IA and archive classes
 		c.rdsInstanceIndex = make(map[string]rdsInstancePrice, 5000)         // instance×engine combos
 		c.rdsStorageIndex = make(map[string]rdsStoragePrice, 100)            // storage types
+		c.rdsReservedIndex = make(map[string]rdsInstancePrice, 5000)         // instance×engine combos
+		c.rdsIOOptimizedIndex = make(map[string]rdsInstancePrice, 500)       // Aurora instance×engine combos
 		c.elasticacheIndex = make(map[string]elasticacheInstancePrice, 1000) // node×engine combos
 
 		// Parse each service file in parallel for faster initialization.
@@ -236,7 +743,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if region, meta, err := c.parseEC2Pricing(rawEC2JSON); err != nil {
+			if region, meta, err := c.parseEC2Pricing(data.EC2); err != nil {
 				parseErrMu.Lock()
 				parseErrs = append(parseErrs, fmt.Errorf("EC2: %w", err))
 				parseErrMu.Unlock()
@@ -254,7 +761,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseS3Pricing(rawS3JSON); err != nil {
+			if _, err := c.parseS3Pricing(data.S3); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse S3 pricing")
 			}
 		}()
@@ -263,7 +770,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseRDSPricing(rawRDSJSON); err != nil {
+			if _, err := c.parseRDSPricing(data.RDS); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse RDS pricing")
 			}
 		}()
@@ -272,7 +779,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseEKSPricing(rawEKSJSON); err != nil {
+			if _, err := c.parseEKSPricing(data.EKS); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse EKS pricing")
 			}
 		}()
@@ -281,7 +788,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseLambdaPricing(rawLambdaJSON); err != nil {
+			if _, err := c.parseLambdaPricing(data.Lambda); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse Lambda pricing")
 			}
 		}()
@@ -290,7 +797,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseDynamoDBPricing(rawDynamoDBJSON); err != nil {
+			if _, err := c.parseDynamoDBPricing(data.DynamoDB); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse DynamoDB pricing")
 			}
 		}()
@@ -299,7 +806,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseELBPricing(rawELBJSON); err != nil {
+			if _, err := c.parseELBPricing(data.ELB); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse ELB pricing")
 			}
 		}()
@@ -308,7 +815,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseNATGatewayPricing(rawVPCJSON); err != nil {
+			if _, err := c.parseNATGatewayPricing(data.VPC); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse NAT Gateway pricing")
 			}
 		}()
@@ -317,7 +824,7 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseCloudWatchPricing(rawCloudWatchJSON); err != nil {
+			if _, err := c.parseCloudWatchPricing(data.CloudWatch); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse CloudWatch pricing")
 			}
 		}()
@@ -326,17 +833,90 @@ func (c *Client) init() error {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if _, err := c.parseElastiCachePricing(rawElastiCacheJSON); err != nil {
+			if _, err := c.parseElastiCachePricing(data.ElastiCache); err != nil {
 				c.logger.Error().Err(err).Msg("failed to parse ElastiCache pricing")
 			}
 		}()
 
+		// 11. Parse ECR pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseECRPricing(data.ECR); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse ECR pricing")
+			}
+		}()
+
+		// 12. Parse Secrets Manager pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseSecretsManagerPricing(data.SecretsManager); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Secrets Manager pricing")
+			}
+		}()
+
+		// 13. Parse KMS pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseKMSPricing(data.KMS); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse KMS pricing")
+			}
+		}()
+
+		// 14. Parse WAF pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseWAFPricing(data.WAF); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse WAF pricing")
+			}
+		}()
+
+		// 15. Parse Athena pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseAthenaPricing(data.Athena); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Athena pricing")
+			}
+		}()
+
+		// 16. Parse Glue pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseGluePricing(data.Glue); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Glue pricing")
+			}
+		}()
+
+		// 17. Parse SES pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseSESPricing(data.SES); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse SES pricing")
+			}
+		}()
+
+		// 18. Parse Step Functions pricing
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.parseStepFunctionsPricing(data.StepFunctions); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Step Functions pricing")
+			}
+		}()
+
 		// Wait for all parsing to complete
 		wg.Wait()
 
 		// Log initialization duration for performance monitoring
+		c.initDuration = time.Since(start)
 		c.logger.Debug().
-			Dur("init_duration_ms", time.Since(start)).
+			Dur("init_duration_ms", c.initDuration).
 			Int("ec2_products", len(c.ec2Index)).
 			Int("ebs_products", len(c.ebsIndex)).
 			Bool("natgw_found", c.natGatewayPricing != nil).
@@ -348,9 +928,20 @@ func (c *Client) init() error {
 			return
 		}
 
-		// Set region from EC2 data (all services have the same region in a regional binary)
-		if ec2Region != "" {
-			c.region = ec2Region
+		// Set region from EC2 data (all services have the same region in a regional binary),
+		// falling back to the build-time region if no product carries a regionCode
+		region, regionSource := resolveRegion(ec2Region, embeddedRegion, len(c.ec2Index) > 0)
+		c.region = region
+		if regionSource == regionSourceBuildConstant {
+			c.logger.Warn().
+				Str("region", c.region).
+				Str("region_source", regionSource).
+				Msg("region not found in EC2 pricing products, using build-time region")
+		} else {
+			c.logger.Debug().
+				Str("region", c.region).
+				Str("region_source", regionSource).
+				Msg("pricing region detected")
 		}
 
 		// Validate critical EC2/EBS indexes are populated (prevents v0.0.10 regression)
@@ -405,6 +996,8 @@ func (c *Client) init() error {
 		if c.eksPricing != nil {
 			warnMissing("EKS", "StandardHourlyRate", c.eksPricing.StandardHourlyRate)
 			warnMissing("EKS", "ExtendedHourlyRate", c.eksPricing.ExtendedHourlyRate)
+			warnMissing("EKS", "FargateVCPURate", c.eksPricing.FargateVCPURate)
+			warnMissing("EKS", "FargateGBRate", c.eksPricing.FargateGBRate)
 		} else {
 			c.logger.Warn().Str("region", c.region).Msg("EKS pricing not loaded")
 		}
@@ -464,6 +1057,73 @@ func (c *Client) init() error {
 		if len(c.elasticacheIndex) == 0 {
 			c.logger.Warn().Str("region", c.region).Msg("ElastiCache pricing not loaded")
 		}
+
+		// ECR pricing validation
+		if c.ecrPricing != nil {
+			warnMissing("ECR", "StorageRate", c.ecrPricing.StorageRate)
+			warnMissing("ECR", "DataTransferOutRate", c.ecrPricing.DataTransferOutRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("ECR pricing not loaded")
+		}
+
+		// Secrets Manager pricing validation
+		if c.secretsManagerPricing != nil {
+			warnMissing("SecretsManager", "SecretMonthlyRate", c.secretsManagerPricing.SecretMonthlyRate)
+			warnMissing("SecretsManager", "APICallRate", c.secretsManagerPricing.APICallRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Secrets Manager pricing not loaded")
+		}
+
+		// KMS pricing validation
+		if c.kmsPricing != nil {
+			warnMissing("KMS", "KeyMonthlyRate", c.kmsPricing.KeyMonthlyRate)
+			warnMissing("KMS", "RequestRate", c.kmsPricing.RequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("KMS pricing not loaded")
+		}
+
+		// WAF pricing validation
+		if c.wafPricing != nil {
+			warnMissing("WAF", "WebACLMonthlyRate", c.wafPricing.WebACLMonthlyRate)
+			warnMissing("WAF", "RuleMonthlyRate", c.wafPricing.RuleMonthlyRate)
+			warnMissing("WAF", "RequestRate", c.wafPricing.RequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("WAF pricing not loaded")
+		}
+
+		// Athena pricing validation
+		if c.athenaPricing != nil {
+			warnMissing("Athena", "DataScannedTBRate", c.athenaPricing.DataScannedTBRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Athena pricing not loaded")
+		}
+
+		// Glue pricing validation
+		if c.gluePricing != nil {
+			warnMissing("Glue", "ETLDPUHourRate", c.gluePricing.ETLDPUHourRate)
+			warnMissing("Glue", "CrawlerDPUHourRate", c.gluePricing.CrawlerDPUHourRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Glue pricing not loaded")
+		}
+
+		// SES pricing validation
+		if c.sesPricing != nil {
+			warnMissing("SES", "EmailRate", c.sesPricing.EmailRate)
+			warnMissing("SES", "AttachmentGBRate", c.sesPricing.AttachmentGBRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("SES pricing not loaded")
+		}
+
+		// Step Functions pricing validation
+		if c.stepFunctionsPricing != nil {
+			warnMissing("StepFunctions", "StateTransitionRate", c.stepFunctionsPricing.StateTransitionRate)
+			warnMissing("StepFunctions", "ExpressRequestRate", c.stepFunctionsPricing.ExpressRequestRate)
+			warnMissing("StepFunctions", "ExpressGBSecondRate", c.stepFunctionsPricing.ExpressGBSecondRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Step Functions pricing not loaded")
+		}
+
+		c.checkStorageConsistency()
 	})
 	return c.err
 }
@@ -510,6 +1170,43 @@ func getOnDemandPrice(data *awsPricing, sku string) (float64, string, bool) {
 	return 0, "", false
 }
 
+// ec2Key builds the ec2Index key "instanceType/os/tenancy". It is on the hot path of
+// every EC2 lookup and of EC2 parsing, so it concatenates directly: the compiler sizes
+// the result once, avoiding the reflection and extra allocations of fmt.Sprintf.
+func ec2Key(instanceType, os, tenancy string) string {
+	return instanceType + "/" + os + "/" + tenancy
+}
+
+// getReservedHourlyPrice returns the hourly rate of the standard Reserved term for sku
+// matching leaseLength (e.g. "1yr") and purchaseOption (e.g. "No Upfront").
+// Only recurring hourly charges are considered; upfront fees are ignored.
+func getReservedHourlyPrice(data *awsPricing, sku, leaseLength, purchaseOption string) (float64, bool) {
+	termMap, ok := data.Terms["Reserved"][sku]
+	if !ok {
+		return 0, false
+	}
+	for _, term := range termMap {
+		attrs := term.TermAttributes
+		if attrs["LeaseContractLength"] != leaseLength || attrs["PurchaseOption"] != purchaseOption {
+			continue
+		}
+		if class := attrs["OfferingClass"]; class != "" && class != "standard" {
+			continue
+		}
+		for _, dim := range term.PriceDimensions {
+			if dim.Unit != "Hrs" {
+				continue
+			}
+			if amountStr, ok := dim.PricePerUnit["USD"]; ok {
+				if amount, err := strconv.ParseFloat(amountStr, 64); err == nil {
+					return amount, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
 // parseEC2Pricing parses EC2 pricing data including EBS volumes.
 // Returns the detected region, pricing metadata, and any parsing error.
 func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error) {
@@ -525,6 +1222,7 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 			Str("actual", pricing.OfferCode).
 			Msg("EC2 pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonEC2", &pricing)
 
 	// Capture metadata for debugging (T034)
 	meta := &pricingMetadata{
@@ -533,6 +1231,9 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 		OfferCode:       pricing.OfferCode,
 	}
 
+	// Per-volume-type IOPS rates of the higher tiers, keyed by tier number (2, 3)
+	iopsTierRates := make(map[string]map[int]float64)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -550,11 +1251,15 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 			capacityStatus := attrs["capacitystatus"]
 			preInstalledSw := attrs["preInstalledSw"]
 
+			if instType != "" && attrs["networkPerformance"] != "" {
+				c.ec2NetworkIndex[instType] = attrs["networkPerformance"]
+			}
+
 			if instType != "" && os != "" && tenancy != "" &&
 				capacityStatus == "Used" &&
 				(preInstalledSw == "NA" || preInstalledSw == "") {
 
-				key := fmt.Sprintf("%s/%s/%s", instType, os, tenancy)
+				key := ec2Key(instType, os, tenancy)
 				rate, unit, found := getOnDemandPrice(&pricing, sku)
 				if found {
 					c.ec2Index[key] = ec2Price{
@@ -566,6 +1271,15 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 			}
 		}
 
+		// Inter-AZ data transfer (usagetype e.g. "USE1-DataTransfer-Regional-Bytes")
+		if prod.ProductFamily == "Data Transfer" && attrs["transferType"] == "IntraRegion" &&
+			strings.HasSuffix(attrs["usagetype"], "DataTransfer-Regional-Bytes") {
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && unit == "GB" {
+				c.ec2InterAZTransferRate = rate
+			}
+		}
+
 		// EBS Volumes (included in EC2 pricing file)
 		if prod.ProductFamily == "Storage" {
 			volType := attrs["volumeApiName"]
@@ -581,15 +1295,116 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 				}
 			}
 		}
-	}
-	return region, meta, nil
-}
 
-// parseS3Pricing parses S3 pricing data.
-// Returns the detected region and any parsing error.
-func (c *Client) parseS3Pricing(data []byte) (string, error) {
-	var pricing awsPricing
-	if err := json.Unmarshal(data, &pricing); err != nil {
+		// EBS snapshot storage (usagetype e.g. "EBS:SnapshotUsage"); the archive tier
+		// ("EBS:SnapshotArchiveStorage") is billed separately and not indexed.
+		if prod.ProductFamily == "Storage Snapshot" && strings.HasSuffix(attrs["usagetype"], "EBS:SnapshotUsage") {
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && unit == "GB-Mo" {
+				c.ebsSnapshotRate = rate
+			}
+		}
+
+		// EBS provisioned IOPS (gp3 above baseline, io1, io2).
+		// io2 publishes the higher tiers as separate SKUs (usagetype suffix ".tier2",
+		// ".tier3"); they are collected here and assembled into ebsIOPSTierIndex below.
+		if prod.ProductFamily == "System Operation" && attrs["group"] == "EBS IOPS" {
+			volType := attrs["volumeApiName"]
+			if volType == "" {
+				continue
+			}
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if !found || unit != "IOPS-Mo" {
+				continue
+			}
+			if _, suffix, tiered := strings.Cut(attrs["usagetype"], ".tier"); tiered {
+				if tier, err := strconv.Atoi(suffix); err == nil && tier > 1 {
+					if iopsTierRates[volType] == nil {
+						iopsTierRates[volType] = make(map[int]float64)
+					}
+					iopsTierRates[volType][tier] = rate
+				}
+				continue
+			}
+			c.ebsIOPSIndex[volType] = ebsProvisionedPrice{
+				Unit:             unit,
+				RatePerUnitMonth: rate,
+				Currency:         "USD",
+			}
+		}
+
+		// EBS provisioned throughput (gp3 above baseline).
+		// AWS publishes this per GiBps-month; normalize to MiB/s-month.
+		if prod.ProductFamily == "Provisioned Throughput" {
+			volType := attrs["volumeApiName"]
+			if volType == "" {
+				continue
+			}
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if !found {
+				continue
+			}
+			switch unit {
+			case "GiBps-mo":
+				rate /= 1024
+			case "MiBps-Mo":
+			default:
+				continue
+			}
+			c.ebsThroughputIndex[volType] = ebsProvisionedPrice{
+				Unit:             "MiBps-Mo",
+				RatePerUnitMonth: rate,
+				Currency:         "USD",
+			}
+		}
+	}
+
+	c.ebsIOPSTierIndex = buildIOPSTiers(c.ebsIOPSIndex, iopsTierRates)
+
+	c.expectFamily("AmazonEC2", &pricing, "Compute Instance", len(c.ec2Index))
+	c.expectFamily("AmazonEC2", &pricing, "Storage", len(c.ebsIndex))
+	return region, meta, nil
+}
+
+// ebsIOPSTierBounds are the upper IOPS bounds of each io2 Block Express IOPS tier but
+// the last: the first 32,000 IOPS bill at the base rate, the next 32,000 at the tier 2
+// rate and everything above 64,000 at the tier 3 rate. The price list carries the
+// rates but not the boundaries.
+var ebsIOPSTierBounds = []float64{32000, 64000}
+
+// buildIOPSTiers assembles the tier schedule for each volume type with higher-tier IOPS
+// rates, starting from its base rate. Tiers must be contiguous from 2; the last one
+// found is unbounded. Volume types without a base rate are skipped.
+func buildIOPSTiers(base map[string]ebsProvisionedPrice, higher map[string]map[int]float64) map[string][]TierRate {
+	index := make(map[string][]TierRate, len(higher))
+	for volType, rates := range higher {
+		first, found := base[volType]
+		if !found {
+			continue
+		}
+		tiers := []TierRate{{Rate: first.RatePerUnitMonth}}
+		for tier := 2; tier <= len(ebsIOPSTierBounds)+1; tier++ {
+			rate, ok := rates[tier]
+			if !ok {
+				break
+			}
+			tiers[len(tiers)-1].UpTo = ebsIOPSTierBounds[tier-2]
+			tiers = append(tiers, TierRate{Rate: rate})
+		}
+		if len(tiers) < 2 {
+			continue
+		}
+		tiers[len(tiers)-1].UpTo = math.MaxFloat64
+		index[volType] = tiers
+	}
+	return index
+}
+
+// parseS3Pricing parses S3 pricing data.
+// Returns the detected region and any parsing error.
+func (c *Client) parseS3Pricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
 		return "", fmt.Errorf("failed to parse S3 JSON: %w", err)
 	}
 
@@ -600,6 +1415,7 @@ func (c *Client) parseS3Pricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("S3 pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonS3", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -609,6 +1425,8 @@ func (c *Client) parseS3Pricing(data []byte) (string, error) {
 			region = attrs["regionCode"]
 		}
 
+		apiClass := s3StorageClassByVolumeType[attrs["volumeType"]]
+
 		if prod.ProductFamily == "Storage" {
 			storageClass := attrs["storageClass"]
 			if storageClass == "" {
@@ -616,14 +1434,46 @@ func (c *Client) parseS3Pricing(data []byte) (string, error) {
 			}
 			rate, unit, found := getOnDemandPrice(&pricing, sku)
 			if found && unit == "GB-Mo" {
-				c.s3Index[storageClass] = s3Price{
+				price := s3Price{
 					Unit:           unit,
 					RatePerGBMonth: rate,
 					Currency:       "USD",
 				}
+				c.s3Index[storageClass] = price
+				if apiClass != "" {
+					c.s3Index[apiClass] = price
+				}
+			}
+		}
+
+		// Per-GB retrieval fees (usagetype e.g. "USE1-Retrieval-SIA"). Glacier classes
+		// also publish Expedited and Bulk tiers; only the Standard tier is indexed.
+		usageType := attrs["usagetype"]
+		if apiClass != "" && strings.Contains(usageType, "Retrieval") &&
+			!strings.Contains(usageType, "Expedited") && !strings.Contains(usageType, "Bulk") {
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && unit == "GB" {
+				c.s3RetrievalIndex[apiClass] = rate
+			}
+		}
+
+		// Intelligent-Tiering monitoring (usagetype e.g. "Monitoring-Automation-INT"),
+		// published per 1,000 objects and normalized to a per-object rate
+		if prod.ProductFamily == "Fee" && strings.Contains(attrs["usagetype"], "Monitoring-Automation-INT") {
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if !found {
+				continue
+			}
+			switch unit {
+			case "1,000 Objects":
+				c.s3ITMonitoringRate = rate / 1000
+			case "Objects":
+				c.s3ITMonitoringRate = rate
 			}
 		}
 	}
+
+	c.expectFamily("AmazonS3", &pricing, "Storage", len(c.s3Index))
 	return region, nil
 }
 
@@ -642,6 +1492,7 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("RDS pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonRDS", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -659,14 +1510,46 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) {
 
 			if instClass != "" && engine != "" && deployOption == "Single-AZ" {
 				key := fmt.Sprintf("%s/%s", instClass, engine)
+
+				// Aurora I/O-Optimized instances share instanceType/engine with
+				// Aurora Standard and are distinguished by usagetype
+				index := c.rdsInstanceIndex
+				ioOptimized := strings.Contains(attrs["usagetype"], "IOOptimized")
+				if ioOptimized {
+					index = c.rdsIOOptimizedIndex
+				}
+
 				rate, unit, found := getOnDemandPrice(&pricing, sku)
 				if found && unit == "Hrs" {
-					c.rdsInstanceIndex[key] = rdsInstancePrice{
+					index[key] = rdsInstancePrice{
 						Unit:       unit,
 						HourlyRate: rate,
 						Currency:   "USD",
 					}
 				}
+
+				if !ioOptimized {
+					if rate, found := getReservedHourlyPrice(&pricing, sku, "1yr", "No Upfront"); found {
+						c.rdsReservedIndex[key] = rdsInstancePrice{
+							Unit:       "Hrs",
+							HourlyRate: rate,
+							Currency:   "USD",
+						}
+					}
+				}
+			}
+		}
+
+		// Aurora Standard I/O requests (I/O-Optimized clusters have no per-I/O charge)
+		if prod.ProductFamily == "System Operation" && attrs["group"] == "Aurora I/O Operation" &&
+			c.rdsAuroraIOPricing == nil {
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && unit == "IOs" {
+				c.rdsAuroraIOPricing = &rdsIOPrice{
+					Unit:           unit,
+					RatePerRequest: rate,
+					Currency:       "USD",
+				}
 			}
 		}
 
@@ -693,6 +1576,10 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) {
 				}
 			case "Magnetic":
 				apiVolType = "standard"
+			case "General Purpose-Aurora":
+				apiVolType = "aurora"
+			case "IO Optimized-Aurora":
+				apiVolType = "aurora-io-optimized"
 			default:
 				continue
 			}
@@ -709,6 +1596,9 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	c.expectFamily("AmazonRDS", &pricing, "Database Instance", len(c.rdsInstanceIndex))
+	c.expectFamily("AmazonRDS", &pricing, "Database Storage", len(c.rdsStorageIndex))
 	return region, nil
 }
 
@@ -727,6 +1617,7 @@ func (c *Client) parseEKSPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("EKS pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonEKS", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -748,6 +1639,17 @@ func (c *Client) parseEKSPricing(data []byte) (string, error) {
 			}
 
 			rate, unit, found := getOnDemandPrice(&pricing, sku)
+
+			// Fargate pod compute: usageType "USE1-Fargate-vCPU-Hours:perCPU" / "USE1-Fargate-GB-Hours"
+			if found && rate > 0 && strings.Contains(usageType, "Fargate-") {
+				if strings.Contains(usageType, "Fargate-vCPU-Hours") {
+					c.eksPricing.FargateVCPURate = rate
+				} else if strings.Contains(usageType, "Fargate-GB-Hours") {
+					c.eksPricing.FargateGBRate = rate
+				}
+				continue
+			}
+
 			// AWS returns unit as "Hours", "Hrs", or "hours" depending on the product
 			unitLower := strings.ToLower(unit)
 			if found && (unitLower == "hrs" || unitLower == "hours") && rate > 0 {
@@ -760,6 +1662,12 @@ func (c *Client) parseEKSPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	eksEntries := 0
+	if c.eksPricing != nil && c.eksPricing.StandardHourlyRate > 0 {
+		eksEntries = 1
+	}
+	c.expectFamily("AmazonEKS", &pricing, "perCluster", eksEntries)
 	return region, nil
 }
 
@@ -778,6 +1686,7 @@ func (c *Client) parseLambdaPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("Lambda pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AWSLambda", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -808,6 +1717,12 @@ func (c *Client) parseLambdaPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	lambdaEntries := 0
+	if c.lambdaPricing != nil {
+		lambdaEntries = 1
+	}
+	c.expectFamily("AWSLambda", &pricing, "AWS Lambda", lambdaEntries)
 	return region, nil
 }
 
@@ -826,6 +1741,7 @@ func (c *Client) parseDynamoDBPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("DynamoDB pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonDynamoDB", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -868,6 +1784,16 @@ func (c *Client) parseDynamoDBPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	var onDemandEntries, provisionedEntries, storageEntries int
+	if d := c.dynamoDBPricing; d != nil {
+		onDemandEntries = countRates(d.OnDemandReadPrice, d.OnDemandWritePrice)
+		provisionedEntries = countRates(d.ProvisionedRCUPrice, d.ProvisionedWCUPrice)
+		storageEntries = countRates(d.StoragePrice)
+	}
+	c.expectFamily("AmazonDynamoDB", &pricing, "Amazon DynamoDB PayPerRequest Throughput", onDemandEntries)
+	c.expectFamily("AmazonDynamoDB", &pricing, "Provisioned IOPS", provisionedEntries)
+	c.expectFamily("AmazonDynamoDB", &pricing, "Database Storage", storageEntries)
 	return region, nil
 }
 
@@ -886,6 +1812,7 @@ func (c *Client) parseELBPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("ELB pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AWSELB", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -904,27 +1831,48 @@ func (c *Client) parseELBPricing(data []byte) (string, error) {
 				}
 			}
 
+			// Outposts usage types (e.g. "USE1-Outposts-LCUUsage") go to their own index
+			target := c.elbPricing
+			if strings.Contains(usageType, "Outposts-") {
+				if c.elbOutpostsPricing == nil {
+					c.elbOutpostsPricing = &elbPrice{
+						Currency: "USD",
+					}
+				}
+				target = c.elbOutpostsPricing
+			}
+
 			rate, unit, found := getOnDemandPrice(&pricing, sku)
 			if found {
 				switch prod.ProductFamily {
 				case "Load Balancer-Application":
 					if strings.HasSuffix(usageType, "LoadBalancerUsage") && !strings.Contains(usageType, "TS-") && unit == "Hrs" {
-						c.elbPricing.ALBHourlyRate = rate
-					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Outposts-") && !strings.Contains(usageType, "Reserved") && unit == "LCU-Hrs" {
-						c.elbPricing.ALBLCURate = rate
+						target.ALBHourlyRate = rate
+					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Reserved") && unit == "LCU-Hrs" {
+						target.ALBLCURate = rate
 					}
 				case "Load Balancer-Network":
 					if strings.HasSuffix(usageType, "LoadBalancerUsage") && !strings.Contains(usageType, "TS-") && unit == "Hrs" {
-						c.elbPricing.NLBHourlyRate = rate
-					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Outposts-") && !strings.Contains(usageType, "Reserved") && unit == "LCU-Hrs" {
+						target.NLBHourlyRate = rate
+					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Reserved") && unit == "LCU-Hrs" {
 						// AWS uses "LCUUsage" with "LCU-Hrs" for NLB capacity units too
 						// The description differentiates: "Network load balancer capacity unit-hour"
-						c.elbPricing.NLBNLCURate = rate
+						target.NLBNLCURate = rate
 					}
 				}
 			}
 		}
 	}
+
+	var albEntries, nlbEntries int
+	if c.elbPricing != nil && c.elbPricing.ALBHourlyRate > 0 {
+		albEntries = 1
+	}
+	if c.elbPricing != nil && c.elbPricing.NLBHourlyRate > 0 {
+		nlbEntries = 1
+	}
+	c.expectFamily("AWSELB", &pricing, "Load Balancer-Application", albEntries)
+	c.expectFamily("AWSELB", &pricing, "Load Balancer-Network", nlbEntries)
 	return region, nil
 }
 
@@ -943,6 +1891,7 @@ func (c *Client) parseNATGatewayPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("VPC pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonVPC", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -974,6 +1923,12 @@ func (c *Client) parseNATGatewayPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	natEntries := 0
+	if c.natGatewayPricing != nil {
+		natEntries = 1
+	}
+	c.expectFamily("AmazonVPC", &pricing, "NAT Gateway", natEntries)
 	return region, nil
 }
 
@@ -998,6 +1953,7 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("CloudWatch pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonCloudWatch", &pricing)
 
 	c.cloudWatchPricing = &cloudWatchPrice{
 		Currency: "USD",
@@ -1057,6 +2013,8 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	c.expectFamily("AmazonCloudWatch", &pricing, "Metric", len(c.cloudWatchPricing.MetricsTiers))
 	return region, nil
 }
 
@@ -1082,6 +2040,7 @@ func (c *Client) parseElastiCachePricing(data []byte) (string, error) {
 			Str("actual", pricing.OfferCode).
 			Msg("ElastiCache pricing data has unexpected offerCode")
 	}
+	c.recordMetadata("AmazonElastiCache", &pricing)
 
 	var region string
 	for sku, prod := range pricing.Products {
@@ -1111,158 +2070,556 @@ func (c *Client) parseElastiCachePricing(data []byte) (string, error) {
 			}
 		}
 	}
+
+	c.expectFamily("AmazonElastiCache", &pricing, "Cache Instance", len(c.elasticacheIndex))
 	return region, nil
 }
 
-// extractTieredPricing extracts tiered pricing from a SKU's price dimensions.
-// AWS CloudWatch uses beginRange/endRange to define pricing tiers.
-// Returns sorted tiers from lowest to highest upper bound.
-func (c *Client) extractTieredPricing(data *awsPricing, sku string) []TierRate {
-	termMap, ok := data.Terms["OnDemand"][sku]
-	if !ok {
-		return nil
+// parseECRPricing parses ECR pricing data for image storage and data transfer out.
+// Returns the detected region and any parsing error.
+func (c *Client) parseECRPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse ECR JSON: %w", err)
 	}
 
-	var tiers []TierRate
-	for _, term := range termMap {
-		for _, dim := range term.PriceDimensions {
-			amountStr, ok := dim.PricePerUnit["USD"]
-			if !ok {
-				continue
-			}
-			rate, err := strconv.ParseFloat(amountStr, 64)
-			if err != nil || rate == 0 {
-				continue
-			}
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AmazonECR" {
+		c.logger.Warn().
+			Str("expected", "AmazonECR").
+			Str("actual", pricing.OfferCode).
+			Msg("ECR pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AmazonECR", &pricing)
 
-			// Parse endRange - "Inf" means unlimited
-			var upperBound float64
-			if dim.EndRange == "Inf" || dim.EndRange == "" {
-				upperBound = math.MaxFloat64
-			} else {
-				upperBound, err = strconv.ParseFloat(dim.EndRange, 64)
-				if err != nil {
-					continue
-				}
-			}
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
 
-			tiers = append(tiers, TierRate{
-				UpTo: upperBound,
-				Rate: rate,
-			})
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
 		}
-	}
 
-	// Sort by upper bound (ascending)
-	sort.Slice(tiers, func(i, j int) bool {
-		return tiers[i].UpTo < tiers[j].UpTo
-	})
+		usageType := attrs["usagetype"]
+		switch {
+		case strings.Contains(usageType, "TimedStorage-ByteHrs"):
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && unit == "GB-Mo" {
+				c.ensureECRPricing().StorageRate = rate
+			}
+		case prod.ProductFamily == "Data Transfer" && strings.Contains(usageType, "DataTransfer-Out-Bytes"):
+			// Tiered: the first tier is free, so use the lowest paid tier
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.ensureECRPricing().DataTransferOutRate = tiers[0].Rate
+			}
+		}
+	}
 
-	return tiers
+	ecrEntries := 0
+	if c.ecrPricing != nil {
+		ecrEntries = countRates(c.ecrPricing.StorageRate)
+	}
+	c.expectFamily("AmazonECR", &pricing, "TimedStorage-ByteHrs", ecrEntries)
+	return region, nil
 }
 
-// Region returns the AWS region for this pricing data
-func (c *Client) Region() string {
-	_ = c.init() // Ensure initialization
-	return c.region
+// ensureECRPricing returns the ECR pricing record, creating it on first use.
+func (c *Client) ensureECRPricing() *ecrPrice {
+	if c.ecrPricing == nil {
+		c.ecrPricing = &ecrPrice{Currency: "USD"}
+	}
+	return c.ecrPricing
 }
 
-// Currency returns the currency code
-func (c *Client) Currency() string {
-	_ = c.init() // Ensure initialization
-	return c.currency
-}
+// parseSecretsManagerPricing parses Secrets Manager pricing data for secrets and API calls.
+// Returns the detected region and any parsing error.
+func (c *Client) parseSecretsManagerPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Secrets Manager JSON: %w", err)
+	}
 
-// EC2OnDemandPricePerHour returns hourly rate for an EC2 instance
-func (c *Client) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool) {
-	start := time.Now()
-	defer func() {
-		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
-			c.logger.Warn().
-				Str("resource_type", "EC2").
-				Str("instance_type", instanceType).
-				Str("os", os).
-				Str("tenancy", tenancy).
-				Dur("elapsed", elapsed).
-				Msg("pricing lookup took too long")
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AWSSecretsManager" {
+		c.logger.Warn().
+			Str("expected", "AWSSecretsManager").
+			Str("actual", pricing.OfferCode).
+			Msg("Secrets Manager pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AWSSecretsManager", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
 		}
-	}()
 
-	if err := c.init(); err != nil {
-		return 0, false
+		usageType := attrs["usagetype"]
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate <= 0 {
+			continue
+		}
+
+		if c.secretsManagerPricing == nil {
+			c.secretsManagerPricing = &secretsManagerPrice{Currency: "USD"}
+		}
+		if strings.HasSuffix(usageType, "AWSSecretsManager-Secrets") {
+			c.secretsManagerPricing.SecretMonthlyRate = rate
+		} else if strings.HasSuffix(usageType, "AWSSecretsManager-APIRequest") {
+			c.secretsManagerPricing.APICallRate = rate
+		}
 	}
 
-	key := fmt.Sprintf("%s/%s/%s", instanceType, os, tenancy)
-	price, found := c.ec2Index[key]
-	if !found {
-		return 0, false
+	secretEntries := 0
+	if c.secretsManagerPricing != nil {
+		secretEntries = countRates(c.secretsManagerPricing.SecretMonthlyRate)
 	}
-	return price.HourlyRate, true
+	c.expectFamily("AWSSecretsManager", &pricing, "AWSSecretsManager-Secrets", secretEntries)
+	return region, nil
 }
 
-// EBSPricePerGBMonth returns monthly rate per GB for an EBS volume
-func (c *Client) EBSPricePerGBMonth(volumeType string) (float64, bool) {
-	start := time.Now()
-	defer func() {
-		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
-			c.logger.Warn().
-				Str("resource_type", "EBS").
-				Str("volume_type", volumeType).
-				Dur("elapsed", elapsed).
-				Msg("pricing lookup took too long")
-		}
-	}()
-
-	if err := c.init(); err != nil {
-		return 0, false
+// parseKMSPricing parses KMS pricing data for customer managed keys and symmetric requests.
+// Returns the detected region and any parsing error.
+//
+// Asymmetric and HMAC request usage types (e.g., "KMS-Requests-Asymmetric") are ignored.
+func (c *Client) parseKMSPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse KMS JSON: %w", err)
 	}
 
-	price, found := c.ebsIndex[volumeType]
-	if !found {
-		return 0, false
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "awskms" {
+		c.logger.Warn().
+			Str("expected", "awskms").
+			Str("actual", pricing.OfferCode).
+			Msg("KMS pricing data has unexpected offerCode")
 	}
-	return price.RatePerGBMonth, true
-}
+	c.recordMetadata("awskms", &pricing)
 
-// S3PricePerGBMonth returns monthly rate per GB for S3 storage
-func (c *Client) S3PricePerGBMonth(storageClass string) (float64, bool) {
-	start := time.Now()
-	defer func() {
-		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
-			c.logger.Warn().
-				Str("resource_type", "S3").
-				Str("storage_class", storageClass).
-				Dur("elapsed", elapsed).
-				Msg("pricing lookup took too long")
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
 		}
-	}()
 
-	if err := c.init(); err != nil {
-		return 0, false
+		usageType := attrs["usagetype"]
+		switch {
+		case strings.HasSuffix(usageType, "KMS-Keys"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				c.ensureKMSPricing().KeyMonthlyRate = rate
+			}
+		case strings.HasSuffix(usageType, "KMS-Requests"):
+			// Tiered: the free tier has a zero rate, so use the lowest paid tier
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.ensureKMSPricing().RequestRate = tiers[0].Rate
+			}
+		}
 	}
 
-	price, found := c.s3Index[storageClass]
-	if !found {
-		return 0, false
+	var keyEntries, requestEntries int
+	if c.kmsPricing != nil {
+		keyEntries = countRates(c.kmsPricing.KeyMonthlyRate)
+		requestEntries = countRates(c.kmsPricing.RequestRate)
 	}
-	return price.RatePerGBMonth, true
+	c.expectFamily("awskms", &pricing, "KMS-Keys", keyEntries)
+	c.expectFamily("awskms", &pricing, "KMS-Requests", requestEntries)
+	return region, nil
 }
 
-// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
-// instanceType: e.g., "db.t3.medium"
-// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
-func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+// ensureKMSPricing returns the KMS pricing record, creating it on first use.
+func (c *Client) ensureKMSPricing() *kmsPrice {
+	if c.kmsPricing == nil {
+		c.kmsPricing = &kmsPrice{Currency: "USD"}
+	}
+	return c.kmsPricing
+}
+
+// parseWAFPricing parses AWS WAF pricing data for web ACLs, rules and requests.
+// Returns the detected region and any parsing error.
+//
+// Usage types carry a region prefix (e.g., "USE1-WebACL"). Add-ons such as Bot
+// Control and Fraud Control (e.g., "USE1-BotControl-Request") and the zero-rated
+// Shield Advanced protected usage types are ignored.
+func (c *Client) parseWAFPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse WAF JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AWSWAF" {
+		c.logger.Warn().
+			Str("expected", "AWSWAF").
+			Str("actual", pricing.OfferCode).
+			Msg("WAF pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AWSWAF", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate <= 0 {
+			continue
+		}
+
+		// Strip the region prefix so add-on usage types ending in the same word don't match
+		usageType := attrs["usagetype"]
+		if _, rest, ok := strings.Cut(usageType, "-"); ok {
+			usageType = rest
+		}
+		switch usageType {
+		case "WebACL":
+			c.ensureWAFPricing().WebACLMonthlyRate = rate
+		case "Rule":
+			c.ensureWAFPricing().RuleMonthlyRate = rate
+		case "Request":
+			c.ensureWAFPricing().RequestRate = rate
+		}
+	}
+
+	var webACLEntries, requestEntries int
+	if c.wafPricing != nil {
+		webACLEntries = countRates(c.wafPricing.WebACLMonthlyRate)
+		requestEntries = countRates(c.wafPricing.RequestRate)
+	}
+	c.expectFamily("AWSWAF", &pricing, "WebACL", webACLEntries)
+	c.expectFamily("AWSWAF", &pricing, "Request", requestEntries)
+	return region, nil
+}
+
+// ensureWAFPricing returns the WAF pricing record, creating it on first use.
+func (c *Client) ensureWAFPricing() *wafPrice {
+	if c.wafPricing == nil {
+		c.wafPricing = &wafPrice{Currency: "USD"}
+	}
+	return c.wafPricing
+}
+
+// parseAthenaPricing parses Amazon Athena pricing data for SQL queries.
+// Returns the detected region and any parsing error.
+//
+// Usage types carry a region prefix (e.g., "USE1-DataScannedInTB"). Provisioned
+// capacity, Spark (DPU-hour) and federated query usage types are ignored.
+func (c *Client) parseAthenaPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Athena JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AmazonAthena" {
+		c.logger.Warn().
+			Str("expected", "AmazonAthena").
+			Str("actual", pricing.OfferCode).
+			Msg("Athena pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AmazonAthena", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate <= 0 {
+			continue
+		}
+
+		usageType := attrs["usagetype"]
+		if _, rest, ok := strings.Cut(usageType, "-"); ok {
+			usageType = rest
+		}
+		if usageType == "DataScannedInTB" {
+			c.ensureAthenaPricing().DataScannedTBRate = rate
+		}
+	}
+
+	athenaEntries := 0
+	if c.athenaPricing != nil {
+		athenaEntries = countRates(c.athenaPricing.DataScannedTBRate)
+	}
+	c.expectFamily("AmazonAthena", &pricing, "DataScannedInTB", athenaEntries)
+	return region, nil
+}
+
+// ensureAthenaPricing returns the Athena pricing record, creating it on first use.
+func (c *Client) ensureAthenaPricing() *athenaPrice {
+	if c.athenaPricing == nil {
+		c.athenaPricing = &athenaPrice{Currency: "USD"}
+	}
+	return c.athenaPricing
+}
+
+// parseGluePricing parses AWS Glue pricing data for ETL jobs and crawlers.
+// Returns the detected region and any parsing error.
+//
+// Usage types carry a region prefix (e.g., "USE1-ETL-DPU-Hour"). Data Catalog
+// storage and requests, DataBrew and interactive session usage types are ignored.
+func (c *Client) parseGluePricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Glue JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AWSGlue" {
+		c.logger.Warn().
+			Str("expected", "AWSGlue").
+			Str("actual", pricing.OfferCode).
+			Msg("Glue pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AWSGlue", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate <= 0 {
+			continue
+		}
+
+		usageType := attrs["usagetype"]
+		if _, rest, ok := strings.Cut(usageType, "-"); ok {
+			usageType = rest
+		}
+		switch usageType {
+		case "ETL-DPU-Hour":
+			c.ensureGluePricing().ETLDPUHourRate = rate
+		case "ETL-Flex-DPU-Hour":
+			c.ensureGluePricing().FlexDPUHourRate = rate
+		case "Crawler-DPU-Hour":
+			c.ensureGluePricing().CrawlerDPUHourRate = rate
+		}
+	}
+
+	glueEntries := 0
+	if c.gluePricing != nil {
+		glueEntries = countRates(c.gluePricing.ETLDPUHourRate)
+	}
+	c.expectFamily("AWSGlue", &pricing, "ETL-DPU-Hour", glueEntries)
+	return region, nil
+}
+
+// ensureGluePricing returns the Glue pricing record, creating it on first use.
+func (c *Client) ensureGluePricing() *gluePrice {
+	if c.gluePricing == nil {
+		c.gluePricing = &gluePrice{Currency: "USD"}
+	}
+	return c.gluePricing
+}
+
+// parseSESPricing parses Amazon SES pricing data for outbound email.
+// Returns the detected region and any parsing error.
+//
+// Outbound email is billed per recipient (usageType ending in "Recipients") and
+// attachments per GB (usageType ending in "AttachmentsSize-Bytes"). Inbound mail,
+// dedicated IPs and Virtual Deliverability Manager usage types are ignored.
+func (c *Client) parseSESPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse SES JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AmazonSES" {
+		c.logger.Warn().
+			Str("expected", "AmazonSES").
+			Str("actual", pricing.OfferCode).
+			Msg("SES pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AmazonSES", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case strings.HasSuffix(usageType, "Recipients"):
+			// Tiered: a zero-rate free tier may precede the paid rate
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.ensureSESPricing().EmailRate = tiers[0].Rate
+			}
+		case strings.HasSuffix(usageType, "AttachmentsSize-Bytes"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				c.ensureSESPricing().AttachmentGBRate = rate
+			}
+		}
+	}
+
+	sesEntries := 0
+	if c.sesPricing != nil {
+		sesEntries = countRates(c.sesPricing.EmailRate)
+	}
+	c.expectFamily("AmazonSES", &pricing, "Recipients", sesEntries)
+	return region, nil
+}
+
+// ensureSESPricing returns the SES pricing record, creating it on first use.
+func (c *Client) ensureSESPricing() *sesPrice {
+	if c.sesPricing == nil {
+		c.sesPricing = &sesPrice{Currency: "USD"}
+	}
+	return c.sesPricing
+}
+
+// parseStepFunctionsPricing parses AWS Step Functions pricing data.
+// Returns the detected region and any parsing error.
+//
+// Standard workflows are billed per state transition (usageType ending in
+// "StateTransition"). Express workflows are billed per request and per GB-second of
+// duration (usageTypes containing "Express" and ending in "Requests" or "GB-Second");
+// the duration rate is tiered and only the first tier is kept.
+func (c *Client) parseStepFunctionsPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Step Functions JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AmazonStates" {
+		c.logger.Warn().
+			Str("expected", "AmazonStates").
+			Str("actual", pricing.OfferCode).
+			Msg("Step Functions pricing data has unexpected offerCode")
+	}
+	c.recordMetadata("AmazonStates", &pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case strings.HasSuffix(usageType, "StateTransition"):
+			// Tiered: a zero-rate free tier may precede the paid rate
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.ensureStepFunctionsPricing().StateTransitionRate = tiers[0].Rate
+			}
+		case strings.Contains(usageType, "Express") && strings.HasSuffix(usageType, "Requests"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				c.ensureStepFunctionsPricing().ExpressRequestRate = rate
+			}
+		case strings.Contains(usageType, "Express") && strings.HasSuffix(usageType, "GB-Second"):
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.ensureStepFunctionsPricing().ExpressGBSecondRate = tiers[0].Rate
+			}
+		}
+	}
+
+	sfnEntries := 0
+	if c.stepFunctionsPricing != nil {
+		sfnEntries = countRates(c.stepFunctionsPricing.StateTransitionRate)
+	}
+	c.expectFamily("AmazonStates", &pricing, "StateTransition", sfnEntries)
+	return region, nil
+}
+
+// ensureStepFunctionsPricing returns the Step Functions pricing record, creating it on first use.
+func (c *Client) ensureStepFunctionsPricing() *stepFunctionsPrice {
+	if c.stepFunctionsPricing == nil {
+		c.stepFunctionsPricing = &stepFunctionsPrice{Currency: "USD"}
+	}
+	return c.stepFunctionsPricing
+}
+
+// extractTieredPricing extracts tiered pricing from a SKU's price dimensions.
+// AWS CloudWatch uses beginRange/endRange to define pricing tiers.
+// Returns sorted tiers from lowest to highest upper bound.
+func (c *Client) extractTieredPricing(data *awsPricing, sku string) []TierRate {
+	termMap, ok := data.Terms["OnDemand"][sku]
+	if !ok {
+		return nil
+	}
+
+	var tiers []TierRate
+	for _, term := range termMap {
+		for _, dim := range term.PriceDimensions {
+			amountStr, ok := dim.PricePerUnit["USD"]
+			if !ok {
+				continue
+			}
+			rate, err := strconv.ParseFloat(amountStr, 64)
+			if err != nil || rate == 0 {
+				continue
+			}
+
+			// Parse endRange - "Inf" means unlimited
+			var upperBound float64
+			if dim.EndRange == "Inf" || dim.EndRange == "" {
+				upperBound = math.MaxFloat64
+			} else {
+				upperBound, err = strconv.ParseFloat(dim.EndRange, 64)
+				if err != nil {
+					continue
+				}
+			}
+
+			tiers = append(tiers, TierRate{
+				UpTo: upperBound,
+				Rate: rate,
+			})
+		}
+	}
+
+	// Sort by upper bound (ascending)
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].UpTo < tiers[j].UpTo
+	})
+
+	return tiers
+}
+
+// Region returns the AWS region for this pricing data
+func (c *Client) Region() string {
+	_ = c.init() // Ensure initialization
+	return c.region
+}
+
+// Currency returns the currency code
+func (c *Client) Currency() string {
+	_ = c.init() // Ensure initialization
+	return c.currency
+}
+
+// EC2OnDemandPricePerHour returns hourly rate for an EC2 instance
+func (c *Client) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "RDS").
+				Str("resource_type", "EC2").
 				Str("instance_type", instanceType).
-				Str("engine", engine).
+				Str("os", os).
+				Str("tenancy", tenancy).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1272,24 +2629,939 @@ func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64,
 		return 0, false
 	}
 
-	key := fmt.Sprintf("%s/%s", instanceType, engine)
-	price, found := c.rdsInstanceIndex[key]
+	key := ec2Key(instanceType, os, tenancy)
+	price, found := c.ec2Index[key]
 	if !found {
 		return 0, false
 	}
 	return price.HourlyRate, true
 }
 
-// RDSStoragePricePerGBMonth returns monthly rate per GB for RDS storage
-// volumeType: e.g., "gp2", "gp3", "io1", "standard"
-func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
+// EC2NetworkPerformance returns the published network performance of an EC2 instance type
+func (c *Client) EC2NetworkPerformance(instanceType string) (string, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "RDS_Storage").
-				Str("volume_type", volumeType).
+				Str("resource_type", "EC2_NetworkPerformance").
+				Str("instance_type", instanceType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return "", false
+	}
+
+	network, found := c.ec2NetworkIndex[instanceType]
+	return network, found
+}
+
+// EC2InstanceTypes returns the distinct EC2 instance types with On-Demand pricing, sorted.
+// The list is built on first use and shared; callers must not modify it.
+func (c *Client) EC2InstanceTypes() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+	c.ec2InstanceTypesOnce.Do(func() {
+		c.ec2InstanceTypes = indexKeys(c.ec2Index, "/")
+	})
+	return c.ec2InstanceTypes
+}
+
+// EBSVolumeTypes returns the EBS volume types with storage pricing, sorted.
+func (c *Client) EBSVolumeTypes() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+	return indexKeys(c.ebsIndex, "")
+}
+
+// S3StorageClasses returns the S3 storage class names with storage pricing, sorted.
+func (c *Client) S3StorageClasses() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+	return indexKeys(c.s3Index, "")
+}
+
+// RDSInstanceTypes returns the distinct RDS instance types with On-Demand pricing, sorted.
+func (c *Client) RDSInstanceTypes() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+	return indexKeys(c.rdsInstanceIndex, "/")
+}
+
+// ElastiCacheNodeTypes returns the distinct ElastiCache node types with On-Demand
+// pricing, sorted.
+func (c *Client) ElastiCacheNodeTypes() []string {
+	if err := c.init(); err != nil {
+		return nil
+	}
+	return indexKeys(c.elasticacheIndex, ":")
+}
+
+// indexKeys returns the distinct keys of a pricing index, sorted. When sep is non-empty
+// each key is cut at its first sep, so composite keys such as "db.t3.micro/MySQL" yield
+// "db.t3.micro". Indexes are read-only after init, so this is safe for concurrent use.
+func indexKeys[V any](index map[string]V, sep string) []string {
+	seen := make(map[string]struct{}, len(index))
+	for key := range index {
+		if sep != "" {
+			key, _, _ = strings.Cut(key, sep)
+		}
+		seen[key] = struct{}{}
+	}
+	keys := make([]string, 0, len(seen))
+	for key := range seen {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// EC2InterAZDataTransferPricePerGB returns the per-GB rate for data transferred between
+// Availability Zones in the region, charged in each direction.
+func (c *Client) EC2InterAZDataTransferPricePerGB() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EC2_InterAZ").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.ec2InterAZTransferRate == 0 {
+		return 0, false
+	}
+	return c.ec2InterAZTransferRate, true
+}
+
+// EBSSnapshotPricePerGBMonth returns the monthly rate per GB of standard-tier EBS
+// snapshot storage.
+func (c *Client) EBSSnapshotPricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.ebsSnapshotRate == 0 {
+		return 0, false
+	}
+	return c.ebsSnapshotRate, true
+}
+
+// EBSPricePerGBMonth returns monthly rate per GB for an EBS volume
+func (c *Client) EBSPricePerGBMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Str("volume_type", volumeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.ebsIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// EBSIOPSPricePerMonth returns the monthly rate per provisioned IOPS for an EBS volume type
+func (c *Client) EBSIOPSPricePerMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Str("volume_type", volumeType).
+				Str("dimension", "iops").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.ebsIOPSIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerUnitMonth, true
+}
+
+// EBSIOPSTiers returns the tiered monthly rates per provisioned IOPS for an EBS volume type
+func (c *Client) EBSIOPSTiers(volumeType string) ([]TierRate, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Str("volume_type", volumeType).
+				Str("dimension", "iops_tiers").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+
+	tiers, found := c.ebsIOPSTierIndex[volumeType]
+	return tiers, found
+}
+
+// EBSThroughputPricePerMonth returns the monthly rate per provisioned MiB/s for an EBS volume type
+func (c *Client) EBSThroughputPricePerMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Str("volume_type", volumeType).
+				Str("dimension", "throughput").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.ebsThroughputIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerUnitMonth, true
+}
+
+// S3PricePerGBMonth returns monthly rate per GB for S3 storage
+func (c *Client) S3PricePerGBMonth(storageClass string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "S3").
+				Str("storage_class", storageClass).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.s3Index[storageClass]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// S3RetrievalPricePerGB returns the per-GB data retrieval charge for an S3 storage class
+func (c *Client) S3RetrievalPricePerGB(storageClass string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "S3").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	rate, found := c.s3RetrievalIndex[storageClass]
+	return rate, found
+}
+
+// S3IntelligentTieringMonitoringPricePerObject returns the monthly monitoring and
+// automation charge per object stored in S3 Intelligent-Tiering.
+func (c *Client) S3IntelligentTieringMonitoringPricePerObject() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "S3").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.s3ITMonitoringRate == 0 {
+		return 0, false
+	}
+	return c.s3ITMonitoringRate, true
+}
+
+// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
+// instanceType: e.g., "db.t3.medium"
+// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
+func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "RDS").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := fmt.Sprintf("%s/%s", instanceType, engine)
+	price, found := c.rdsInstanceIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// RDSStoragePricePerGBMonth returns monthly rate per GB for RDS storage
+// volumeType: e.g., "gp2", "gp3", "io1", "standard"
+func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "RDS_Storage").
+				Str("volume_type", volumeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.rdsStorageIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// RDSReservedPricePerHour returns the hourly rate for a 1-year, No Upfront RDS Reserved Instance
+// instanceType: e.g., "db.t3.medium"
+// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
+func (c *Client) RDSReservedPricePerHour(instanceType, engine string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "RDS_Reserved").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := fmt.Sprintf("%s/%s", instanceType, engine)
+	price, found := c.rdsReservedIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// RDSAuroraIOOptimizedPricePerHour returns hourly rate for an Aurora I/O-Optimized instance
+// engine: "Aurora MySQL" or "Aurora PostgreSQL"
+func (c *Client) RDSAuroraIOOptimizedPricePerHour(instanceType, engine string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "RDS_Aurora_IOOptimized").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := fmt.Sprintf("%s/%s", instanceType, engine)
+	price, found := c.rdsIOOptimizedIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// RDSAuroraIORequestPrice returns the cost per I/O request for Aurora Standard storage
+func (c *Client) RDSAuroraIORequestPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "RDS_Aurora_IO").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.rdsAuroraIOPricing == nil {
+		return 0, false
+	}
+	return c.rdsAuroraIOPricing.RatePerRequest, true
+}
+
+// EKSClusterPricePerHour returns hourly rate for EKS cluster control plane.
+// extendedSupport: true for extended support pricing, false for standard support.
+func (c *Client) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EKS").
+				Bool("extended_support", extendedSupport).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.eksPricing == nil {
+		return 0, false
+	}
+
+	if extendedSupport {
+		if c.eksPricing.ExtendedHourlyRate > 0 {
+			return c.eksPricing.ExtendedHourlyRate, true
+		}
+		return 0, false
+	}
+
+	if c.eksPricing.StandardHourlyRate > 0 {
+		return c.eksPricing.StandardHourlyRate, true
+	}
+	return 0, false
+}
+
+// EKSFargatePricePerHour returns the per vCPU-hour and per GB-hour rates for
+// EKS pods scheduled on Fargate. Both rates must be present for a match.
+func (c *Client) EKSFargatePricePerHour() (float64, float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "EKS").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, 0, false
+	}
+
+	if c.eksPricing == nil || c.eksPricing.FargateVCPURate <= 0 || c.eksPricing.FargateGBRate <= 0 {
+		return 0, 0, false
+	}
+	return c.eksPricing.FargateVCPURate, c.eksPricing.FargateGBRate, true
+}
+
+// LambdaPricePerRequest returns the cost per request for AWS Lambda invocations.
+// The rate is sourced from AWS Price List API product family "AWS Lambda" with
+// group "AWS-Lambda-Requests". Standard pricing is $0.20 per 1 million requests
+// ($0.0000002 per request) as of December 2025.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) LambdaPricePerRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Str("metric", "Requests").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.lambdaPricing == nil || c.lambdaPricing.RequestPrice == 0 {
+		return 0, false
+	}
+	return c.lambdaPricing.RequestPrice, true
+}
+
+// LambdaPricePerGBSecond returns the cost per GB-second of compute duration.
+// The rate is sourced from AWS Price List API product family "Serverless" with
+// group "AWS-Lambda-Duration" (x86) or "AWS-Lambda-Duration-ARM" (arm64).
+// This represents the compute cost based on allocated memory and execution time.
+//
+// Architecture pricing (as of December 2025):
+//   - x86_64: ~$0.0000166667 per GB-second
+//   - arm64:  ~$0.0000133334 per GB-second (~20% cheaper)
+//
+// arch parameter accepts: "x86_64", "arm64", "x86", "arm" (defaults to x86_64)
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Str("metric", "GB-Second").
+				Str("architecture", arch).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.lambdaPricing == nil {
+		return 0, false
+	}
+
+	// Normalize architecture string and select appropriate price
+	switch strings.ToLower(arch) {
+	case "arm64", "arm":
+		if c.lambdaPricing.ARMGBSecondPrice > 0 {
+			return c.lambdaPricing.ARMGBSecondPrice, true
+		}
+		// Fall back to x86 if ARM pricing not available
+		if c.lambdaPricing.X86GBSecondPrice > 0 {
+			c.logger.Debug().
+				Str("architecture", arch).
+				Str("aws_region", c.region).
+				Msg("Lambda arm64 pricing not available, substituting x86_64 rate")
+			return c.lambdaPricing.X86GBSecondPrice, true
+		}
+		return 0, false
+	default:
+		// x86_64, x86, or any unrecognized value defaults to x86
+		if c.lambdaPricing.X86GBSecondPrice > 0 {
+			return c.lambdaPricing.X86GBSecondPrice, true
+		}
+		return 0, false
+	}
+}
+
+// LambdaHasARMPricing reports whether the embedded Lambda data includes an explicit
+// arm64 GB-second rate ("AWS-Lambda-Duration-ARM"). Some regions only publish x86 rates.
+func (c *Client) LambdaHasARMPricing() bool {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return false
+	}
+	return c.lambdaPricing != nil && c.lambdaPricing.ARMGBSecondPrice > 0
+}
+
+// DynamoDBOnDemandReadPrice returns the cost per read request unit.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "OnDemandRead").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandReadPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.OnDemandReadPrice, true
+}
+
+// DynamoDBOnDemandWritePrice returns the cost per write request unit.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "OnDemandWrite").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandWritePrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.OnDemandWritePrice, true
+}
+
+// DynamoDBStoragePricePerGBMonth returns the monthly rate per GB for table storage.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "Storage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.StoragePrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.StoragePrice, true
+}
+
+// DynamoDBProvisionedRCUPrice returns the cost per RCU-hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "ProvisionedRCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedRCUPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.ProvisionedRCUPrice, true
+}
+
+// DynamoDBProvisionedWCUPrice returns the cost per WCU-hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "ProvisionedWCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedWCUPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.ProvisionedWCUPrice, true
+}
+
+// ALBPricePerHour returns the fixed hourly rate for an Application Load Balancer.
+func (c *Client) ALBPricePerHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "ALB").
+				Str("metric", "FixedHourly").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.ALBHourlyRate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.ALBHourlyRate, true
+}
+
+// ALBPricePerLCU returns the cost per LCU-hour for an Application Load Balancer.
+func (c *Client) ALBPricePerLCU() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "ALB").
+				Str("metric", "LCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.ALBLCURate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.ALBLCURate, true
+}
+
+// NLBPricePerHour returns the fixed hourly rate for a Network Load Balancer.
+func (c *Client) NLBPricePerHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "NLB").
+				Str("metric", "FixedHourly").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.NLBHourlyRate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.NLBHourlyRate, true
+}
+
+// NLBPricePerNLCU returns the cost per NLCU-hour for a Network Load Balancer.
+func (c *Client) NLBPricePerNLCU() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "NLB").
+				Str("metric", "NLCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.NLBNLCURate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.NLBNLCURate, true
+}
+
+// ELBOutpostsPricePerHour returns the fixed hourly rate for a load balancer on Outposts.
+func (c *Client) ELBOutpostsPricePerHour(lbType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB_Outposts").
+				Str("lb_type", lbType).
+				Str("metric", "Hourly").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil || c.elbOutpostsPricing == nil {
+		return 0, false
+	}
+	rate := c.elbOutpostsPricing.ALBHourlyRate
+	if lbType == "nlb" {
+		rate = c.elbOutpostsPricing.NLBHourlyRate
+	}
+	return rate, rate > 0
+}
+
+// ELBOutpostsPricePerCapacityUnit returns the LCU-hour (ALB) or NLCU-hour (NLB) rate on Outposts.
+func (c *Client) ELBOutpostsPricePerCapacityUnit(lbType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "ELB_Outposts").
+				Str("lb_type", lbType).
+				Str("metric", "CapacityUnit").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil || c.elbOutpostsPricing == nil {
+		return 0, false
+	}
+	rate := c.elbOutpostsPricing.ALBLCURate
+	if lbType == "nlb" {
+		rate = c.elbOutpostsPricing.NLBNLCURate
+	}
+	return rate, rate > 0
+}
+
+// NATGatewayPrice returns the pricing for a NAT Gateway.
+func (c *Client) NATGatewayPrice() (*NATGatewayPrice, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "NATGateway").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.natGatewayPricing == nil || c.natGatewayPricing.HourlyRate == 0 {
+		return nil, false
+	}
+	return c.natGatewayPricing, true
+}
+
+// CloudWatchLogsIngestionTiers returns the tiered pricing for CloudWatch log ingestion.
+// Returns (tiers, true) if found, (nil, false) if not found.
+func (c *Client) CloudWatchLogsIngestionTiers() ([]TierRate, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "CloudWatch").
+				Str("metric", "LogsIngestionTiers").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.LogsIngestionTiers) == 0 {
+		return nil, false
+	}
+	// Return a copy to prevent callers from modifying shared pricing data
+	result := make([]TierRate, len(c.cloudWatchPricing.LogsIngestionTiers))
+	copy(result, c.cloudWatchPricing.LogsIngestionTiers)
+	return result, true
+}
+
+// CloudWatchLogsStoragePrice returns the per-GB-month rate for CloudWatch log storage.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if c.isSlowLookup(elapsed) {
+			c.logger.Warn().
+				Str("resource_type", "CloudWatch").
+				Str("metric", "LogsStorage").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1298,63 +3570,60 @@ func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	price, found := c.rdsStorageIndex[volumeType]
-	if !found {
+	if c.cloudWatchPricing == nil || c.cloudWatchPricing.LogsStorageRate == 0 {
 		return 0, false
 	}
-	return price.RatePerGBMonth, true
+	return c.cloudWatchPricing.LogsStorageRate, true
 }
 
-// EKSClusterPricePerHour returns hourly rate for EKS cluster control plane.
-// extendedSupport: true for extended support pricing, false for standard support.
-func (c *Client) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
+// CloudWatchMetricsTiers returns the tiered pricing for CloudWatch custom metrics.
+// Returns (tiers, true) if found, (nil, false) if not found.
+func (c *Client) CloudWatchMetricsTiers() ([]TierRate, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "EKS").
-				Bool("extended_support", extendedSupport).
+				Str("resource_type", "CloudWatch").
+				Str("metric", "MetricsTiers").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return 0, false
-	}
-
-	if c.eksPricing == nil {
-		return 0, false
-	}
-
-	if extendedSupport {
-		if c.eksPricing.ExtendedHourlyRate > 0 {
-			return c.eksPricing.ExtendedHourlyRate, true
-		}
-		return 0, false
+		return nil, false
 	}
-
-	if c.eksPricing.StandardHourlyRate > 0 {
-		return c.eksPricing.StandardHourlyRate, true
+	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.MetricsTiers) == 0 {
+		return nil, false
 	}
-	return 0, false
+	// Return a copy to prevent callers from modifying shared pricing data
+	result := make([]TierRate, len(c.cloudWatchPricing.MetricsTiers))
+	copy(result, c.cloudWatchPricing.MetricsTiers)
+	return result, true
 }
 
-// LambdaPricePerRequest returns the cost per request for AWS Lambda invocations.
-// The rate is sourced from AWS Price List API product family "AWS Lambda" with
-// group "AWS-Lambda-Requests". Standard pricing is $0.20 per 1 million requests
-// ($0.0000002 per request) as of December 2025.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) LambdaPricePerRequest() (float64, bool) {
+// ElastiCacheOnDemandPricePerHour returns the hourly rate for an ElastiCache cache node.
+//
+// Parameters:
+//   - instanceType: The cache node type (e.g., "cache.m5.large", "cache.t3.micro")
+//   - engine: The cache engine, case-insensitive ("redis", "memcached", "valkey")
+//
+// Engine names are normalized to AWS canonical format:
+//   - "redis", "Redis", "REDIS" -> "Redis"
+//   - "memcached", "Memcached" -> "Memcached"
+//   - "valkey", "Valkey" -> "Valkey"
+//
+// Returns (price, true) if found, (0, false) if not found or engine unknown.
+func (c *Client) ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "Lambda").
-				Str("metric", "Requests").
+				Str("resource_type", "ElastiCache").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1364,32 +3633,31 @@ func (c *Client) LambdaPricePerRequest() (float64, bool) {
 		return 0, false
 	}
 
-	if c.lambdaPricing == nil || c.lambdaPricing.RequestPrice == 0 {
+	// Normalize engine name to AWS canonical format
+	normalizedEngine, ok := elasticacheEngineNormalization[strings.ToLower(engine)]
+	if !ok {
+		// Unknown engine - return not found
 		return 0, false
 	}
-	return c.lambdaPricing.RequestPrice, true
+
+	// Build index key: "instanceType:engine"
+	key := fmt.Sprintf("%s:%s", instanceType, normalizedEngine)
+	price, found := c.elasticacheIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
 }
 
-// LambdaPricePerGBSecond returns the cost per GB-second of compute duration.
-// The rate is sourced from AWS Price List API product family "Serverless" with
-// group "AWS-Lambda-Duration" (x86) or "AWS-Lambda-Duration-ARM" (arm64).
-// This represents the compute cost based on allocated memory and execution time.
-//
-// Architecture pricing (as of December 2025):
-//   - x86_64: ~$0.0000166667 per GB-second
-//   - arm64:  ~$0.0000133334 per GB-second (~20% cheaper)
-//
-// arch parameter accepts: "x86_64", "arm64", "x86", "arm" (defaults to x86_64)
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
+// ECRStoragePricePerGBMonth returns the per-GB-month rate for ECR image storage.
+func (c *Client) ECRStoragePricePerGBMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "Lambda").
-				Str("metric", "GB-Second").
-				Str("architecture", arch).
+				Str("resource_type", "ECR").
+				Str("metric", "StorageRate").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1398,41 +3666,21 @@ func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	if c.lambdaPricing == nil {
-		return 0, false
-	}
-
-	// Normalize architecture string and select appropriate price
-	switch strings.ToLower(arch) {
-	case "arm64", "arm":
-		if c.lambdaPricing.ARMGBSecondPrice > 0 {
-			return c.lambdaPricing.ARMGBSecondPrice, true
-		}
-		// Fall back to x86 if ARM pricing not available
-		if c.lambdaPricing.X86GBSecondPrice > 0 {
-			return c.lambdaPricing.X86GBSecondPrice, true
-		}
-		return 0, false
-	default:
-		// x86_64, x86, or any unrecognized value defaults to x86
-		if c.lambdaPricing.X86GBSecondPrice > 0 {
-			return c.lambdaPricing.X86GBSecondPrice, true
-		}
+	if c.ecrPricing == nil || c.ecrPricing.StorageRate == 0 {
 		return 0, false
 	}
+	return c.ecrPricing.StorageRate, true
 }
 
-// DynamoDBOnDemandReadPrice returns the cost per read request unit.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
+// ECRDataTransferOutPricePerGB returns the first paid tier rate per GB of ECR data
+// transferred out to the internet.
+func (c *Client) ECRDataTransferOutPricePerGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "OnDemandRead").
+				Str("resource_type", "ECR").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1441,22 +3689,20 @@ func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandReadPrice == 0 {
+	if c.ecrPricing == nil || c.ecrPricing.DataTransferOutRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.OnDemandReadPrice, true
+	return c.ecrPricing.DataTransferOutRate, true
 }
 
-// DynamoDBOnDemandWritePrice returns the cost per write request unit.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
+// SecretsManagerPricePerSecret returns the monthly rate per Secrets Manager secret.
+func (c *Client) SecretsManagerPricePerSecret() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "OnDemandWrite").
+				Str("resource_type", "SecretsManager").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1465,22 +3711,20 @@ func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandWritePrice == 0 {
+	if c.secretsManagerPricing == nil || c.secretsManagerPricing.SecretMonthlyRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.OnDemandWritePrice, true
+	return c.secretsManagerPricing.SecretMonthlyRate, true
 }
 
-// DynamoDBStoragePricePerGBMonth returns the monthly rate per GB for table storage.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
+// SecretsManagerPricePerAPICall returns the cost per Secrets Manager API call.
+func (c *Client) SecretsManagerPricePerAPICall() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "Storage").
+				Str("resource_type", "SecretsManager").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1489,22 +3733,20 @@ func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.StoragePrice == 0 {
+	if c.secretsManagerPricing == nil || c.secretsManagerPricing.APICallRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.StoragePrice, true
+	return c.secretsManagerPricing.APICallRate, true
 }
 
-// DynamoDBProvisionedRCUPrice returns the cost per RCU-hour.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
+// KMSPricePerKey returns the monthly rate per KMS customer managed key.
+func (c *Client) KMSPricePerKey() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "ProvisionedRCU").
+				Str("resource_type", "KMS").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1513,22 +3755,20 @@ func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedRCUPrice == 0 {
+	if c.kmsPricing == nil || c.kmsPricing.KeyMonthlyRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.ProvisionedRCUPrice, true
+	return c.kmsPricing.KeyMonthlyRate, true
 }
 
-// DynamoDBProvisionedWCUPrice returns the cost per WCU-hour.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
+// KMSPricePerRequest returns the cost per KMS request beyond the free tier.
+func (c *Client) KMSPricePerRequest() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "ProvisionedWCU").
+				Str("resource_type", "KMS").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1537,22 +3777,20 @@ func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedWCUPrice == 0 {
+	if c.kmsPricing == nil || c.kmsPricing.RequestRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.ProvisionedWCUPrice, true
+	return c.kmsPricing.RequestRate, true
 }
 
-// ALBPricePerHour returns the fixed hourly rate for an Application Load Balancer.
-func (c *Client) ALBPricePerHour() (float64, bool) {
+// WAFPricePerWebACL returns the monthly rate per AWS WAF web ACL.
+func (c *Client) WAFPricePerWebACL() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "ALB").
-				Str("metric", "FixedHourly").
+				Str("resource_type", "WAF").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1561,22 +3799,20 @@ func (c *Client) ALBPricePerHour() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.ALBHourlyRate == 0 {
+	if c.wafPricing == nil || c.wafPricing.WebACLMonthlyRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.ALBHourlyRate, true
+	return c.wafPricing.WebACLMonthlyRate, true
 }
 
-// ALBPricePerLCU returns the cost per LCU-hour for an Application Load Balancer.
-func (c *Client) ALBPricePerLCU() (float64, bool) {
+// WAFPricePerRule returns the monthly rate per rule in an AWS WAF web ACL.
+func (c *Client) WAFPricePerRule() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "ALB").
-				Str("metric", "LCU").
+				Str("resource_type", "WAF").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1585,22 +3821,20 @@ func (c *Client) ALBPricePerLCU() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.ALBLCURate == 0 {
+	if c.wafPricing == nil || c.wafPricing.RuleMonthlyRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.ALBLCURate, true
+	return c.wafPricing.RuleMonthlyRate, true
 }
 
-// NLBPricePerHour returns the fixed hourly rate for a Network Load Balancer.
-func (c *Client) NLBPricePerHour() (float64, bool) {
+// WAFPricePerRequest returns the cost per web request inspected by AWS WAF.
+func (c *Client) WAFPricePerRequest() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "NLB").
-				Str("metric", "FixedHourly").
+				Str("resource_type", "WAF").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1609,22 +3843,20 @@ func (c *Client) NLBPricePerHour() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.NLBHourlyRate == 0 {
+	if c.wafPricing == nil || c.wafPricing.RequestRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.NLBHourlyRate, true
+	return c.wafPricing.RequestRate, true
 }
 
-// NLBPricePerNLCU returns the cost per NLCU-hour for a Network Load Balancer.
-func (c *Client) NLBPricePerNLCU() (float64, bool) {
+// AthenaPricePerTBScanned returns the cost per TB of data scanned by Amazon Athena queries.
+func (c *Client) AthenaPricePerTBScanned() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "NLB").
-				Str("metric", "NLCU").
+				Str("resource_type", "Athena").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1633,71 +3865,78 @@ func (c *Client) NLBPricePerNLCU() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.NLBNLCURate == 0 {
+	if c.athenaPricing == nil || c.athenaPricing.DataScannedTBRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.NLBNLCURate, true
+	return c.athenaPricing.DataScannedTBRate, true
 }
 
-// NATGatewayPrice returns the pricing for a NAT Gateway.
-func (c *Client) NATGatewayPrice() (*NATGatewayPrice, bool) {
+// GluePricePerDPUHour returns the AWS Glue rate per DPU-hour for jobType ("etl",
+// "flex" or "crawler").
+func (c *Client) GluePricePerDPUHour(jobType string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "NATGateway").
+				Str("resource_type", "Glue").
+				Str("job_type", jobType).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.natGatewayPricing == nil || c.natGatewayPricing.HourlyRate == 0 {
-		return nil, false
+	if c.gluePricing == nil {
+		return 0, false
 	}
-	return c.natGatewayPricing, true
+	var rate float64
+	switch jobType {
+	case "etl":
+		rate = c.gluePricing.ETLDPUHourRate
+	case "flex":
+		rate = c.gluePricing.FlexDPUHourRate
+	case "crawler":
+		rate = c.gluePricing.CrawlerDPUHourRate
+	}
+	if rate == 0 {
+		return 0, false
+	}
+	return rate, true
 }
 
-// CloudWatchLogsIngestionTiers returns the tiered pricing for CloudWatch log ingestion.
-// Returns (tiers, true) if found, (nil, false) if not found.
-func (c *Client) CloudWatchLogsIngestionTiers() ([]TierRate, bool) {
+// SESPricePerEmail returns the cost per outbound email recipient sent through Amazon SES.
+func (c *Client) SESPricePerEmail() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "LogsIngestionTiers").
+				Str("resource_type", "SES").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.LogsIngestionTiers) == 0 {
-		return nil, false
+	if c.sesPricing == nil || c.sesPricing.EmailRate == 0 {
+		return 0, false
 	}
-	// Return a copy to prevent callers from modifying shared pricing data
-	result := make([]TierRate, len(c.cloudWatchPricing.LogsIngestionTiers))
-	copy(result, c.cloudWatchPricing.LogsIngestionTiers)
-	return result, true
+	return c.sesPricing.EmailRate, true
 }
 
-// CloudWatchLogsStoragePrice returns the per-GB-month rate for CloudWatch log storage.
-// Returns (price, true) if found, (0, false) if not found.
-func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
+// SESPricePerAttachmentGB returns the cost per GB of email attachments sent through Amazon SES.
+func (c *Client) SESPricePerAttachmentGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "LogsStorage").
+				Str("resource_type", "SES").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1706,81 +3945,53 @@ func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.cloudWatchPricing == nil || c.cloudWatchPricing.LogsStorageRate == 0 {
+	if c.sesPricing == nil || c.sesPricing.AttachmentGBRate == 0 {
 		return 0, false
 	}
-	return c.cloudWatchPricing.LogsStorageRate, true
+	return c.sesPricing.AttachmentGBRate, true
 }
 
-// CloudWatchMetricsTiers returns the tiered pricing for CloudWatch custom metrics.
-// Returns (tiers, true) if found, (nil, false) if not found.
-func (c *Client) CloudWatchMetricsTiers() ([]TierRate, bool) {
+// StepFunctionsPricePerTransition returns the cost per state transition of a Standard workflow.
+func (c *Client) StepFunctionsPricePerTransition() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "MetricsTiers").
+				Str("resource_type", "StepFunctions").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.MetricsTiers) == 0 {
-		return nil, false
+	if c.stepFunctionsPricing == nil || c.stepFunctionsPricing.StateTransitionRate == 0 {
+		return 0, false
 	}
-	// Return a copy to prevent callers from modifying shared pricing data
-	result := make([]TierRate, len(c.cloudWatchPricing.MetricsTiers))
-	copy(result, c.cloudWatchPricing.MetricsTiers)
-	return result, true
+	return c.stepFunctionsPricing.StateTransitionRate, true
 }
 
-// ElastiCacheOnDemandPricePerHour returns the hourly rate for an ElastiCache cache node.
-//
-// Parameters:
-//   - instanceType: The cache node type (e.g., "cache.m5.large", "cache.t3.micro")
-//   - engine: The cache engine, case-insensitive ("redis", "memcached", "valkey")
-//
-// Engine names are normalized to AWS canonical format:
-//   - "redis", "Redis", "REDIS" -> "Redis"
-//   - "memcached", "Memcached" -> "Memcached"
-//   - "valkey", "Valkey" -> "Valkey"
-//
-// Returns (price, true) if found, (0, false) if not found or engine unknown.
-func (c *Client) ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+// StepFunctionsExpressPricing returns the per-request and per-GB-second rates for Express workflows.
+func (c *Client) StepFunctionsExpressPricing() (float64, float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
-		if elapsed > 50*time.Millisecond {
+		if c.isSlowLookup(elapsed) {
 			c.logger.Warn().
-				Str("resource_type", "ElastiCache").
-				Str("instance_type", instanceType).
-				Str("engine", engine).
+				Str("resource_type", "StepFunctions").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return 0, false
-	}
-
-	// Normalize engine name to AWS canonical format
-	normalizedEngine, ok := elasticacheEngineNormalization[strings.ToLower(engine)]
-	if !ok {
-		// Unknown engine - return not found
-		return 0, false
+		return 0, 0, false
 	}
-
-	// Build index key: "instanceType:engine"
-	key := fmt.Sprintf("%s:%s", instanceType, normalizedEngine)
-	price, found := c.elasticacheIndex[key]
-	if !found {
-		return 0, false
+	sfn := c.stepFunctionsPricing
+	if sfn == nil || sfn.ExpressRequestRate == 0 || sfn.ExpressGBSecondRate == 0 {
+		return 0, 0, false
 	}
-	return price.HourlyRate, true
+	return sfn.ExpressRequestRate, sfn.ExpressGBSecondRate, true
 }
@@ -3,7 +3,10 @@
 package pricing
 
 import (
+	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/rs/zerolog"
@@ -162,6 +165,26 @@ func TestClient_EBSPricePerGBMonth(t *testing.T) {
 	}
 }
 
+func TestClient_EBSPerformancePricing(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	if price, found := client.EBSIOPSPricePerMonth("gp3"); !found || price <= 0 {
+		t.Errorf("EBSIOPSPricePerMonth(gp3) = (%v, %v), want positive price", price, found)
+	}
+	if price, found := client.EBSIOPSPricePerMonth("io1"); !found || price <= 0 {
+		t.Errorf("EBSIOPSPricePerMonth(io1) = (%v, %v), want positive price", price, found)
+	}
+	if price, found := client.EBSThroughputPricePerMonth("gp3"); !found || price <= 0 {
+		t.Errorf("EBSThroughputPricePerMonth(gp3) = (%v, %v), want positive price", price, found)
+	}
+	if _, found := client.EBSIOPSPricePerMonth("gp2"); found {
+		t.Error("EBSIOPSPricePerMonth(gp2) should not be found (gp2 has no IOPS charge)")
+	}
+}
+
 func TestClient_ConcurrentAccess(t *testing.T) {
 	client, err := NewClient(zerolog.Nop())
 	if err != nil {
@@ -856,6 +879,108 @@ func TestClient_parseEC2Pricing_Logic(t *testing.T) {
 	}
 }
 
+// TestClient_parseEC2Pricing_Metal verifies bare-metal instance types are indexed like
+// any other size.
+func TestClient_parseEC2Pricing_Metal(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonEC2",
+		"version": "test-version",
+		"products": {
+			"SKU_METAL": {
+				"sku": "SKU_METAL",
+				"productFamily": "Compute Instance",
+				"attributes": {
+					"instanceType": "c5.metal",
+					"operatingSystem": "Linux",
+					"tenancy": "Shared",
+					"regionCode": "us-east-1",
+					"capacitystatus": "Used",
+					"preInstalledSw": "NA"
+				}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_METAL": {
+					"SKU_METAL.OFFER": {
+						"offerTermCode": "OFFER",
+						"sku": "SKU_METAL",
+						"priceDimensions": {
+							"SKU_METAL.OFFER.RATE": {
+								"rateCode": "SKU_METAL.OFFER.RATE",
+								"unit": "Hrs",
+								"pricePerUnit": { "USD": "4.0800000000" }
+							}
+						}
+					}
+				}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:   zerolog.Nop(),
+		ec2Index: make(map[string]ec2Price),
+	}
+	if _, _, err := client.parseEC2Pricing(jsonData); err != nil {
+		t.Fatalf("parseEC2Pricing failed: %v", err)
+	}
+
+	price, found := client.ec2Index["c5.metal/Linux/Shared"]
+	if !found {
+		t.Fatal("c5.metal not found in EC2 index")
+	}
+	if price.HourlyRate != 4.08 {
+		t.Errorf("HourlyRate = %v, want 4.08", price.HourlyRate)
+	}
+}
+
+// TestClient_parseEC2Pricing_IO2IOPSTiers verifies io2's tiered IOPS SKUs are assembled
+// into a tier schedule while io1 keeps a single flat rate.
+func TestClient_parseEC2Pricing_IO2IOPSTiers(t *testing.T) {
+	jsonData := []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_IO1": {"sku": "SKU_IO1", "productFamily": "System Operation", "attributes": {"group": "EBS IOPS", "volumeApiName": "io1", "usagetype": "EBS:VolumeP-IOPS.piops"}},
+			"SKU_IO2": {"sku": "SKU_IO2", "productFamily": "System Operation", "attributes": {"group": "EBS IOPS", "volumeApiName": "io2", "usagetype": "EBS:VolumeP-IOPS.io2"}},
+			"SKU_IO2_T2": {"sku": "SKU_IO2_T2", "productFamily": "System Operation", "attributes": {"group": "EBS IOPS", "volumeApiName": "io2", "usagetype": "EBS:VolumeP-IOPS.io2.tier2"}},
+			"SKU_IO2_T3": {"sku": "SKU_IO2_T3", "productFamily": "System Operation", "attributes": {"group": "EBS IOPS", "volumeApiName": "io2", "usagetype": "EBS:VolumeP-IOPS.io2.tier3"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_IO1": {"SKU_IO1.OD": {"priceDimensions": {"R": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.065"}}}}},
+				"SKU_IO2": {"SKU_IO2.OD": {"priceDimensions": {"R": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.065"}}}}},
+				"SKU_IO2_T2": {"SKU_IO2_T2.OD": {"priceDimensions": {"R": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.0455"}}}}},
+				"SKU_IO2_T3": {"SKU_IO2_T3.OD": {"priceDimensions": {"R": {"unit": "IOPS-Mo", "pricePerUnit": {"USD": "0.03185"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:       zerolog.Nop(),
+		ebsIOPSIndex: make(map[string]ebsProvisionedPrice),
+	}
+	if _, _, err := client.parseEC2Pricing(jsonData); err != nil {
+		t.Fatalf("parseEC2Pricing failed: %v", err)
+	}
+
+	if got := client.ebsIOPSIndex["io2"].RatePerUnitMonth; got != 0.065 {
+		t.Errorf("io2 base IOPS rate = %v, want 0.065", got)
+	}
+	want := []TierRate{
+		{UpTo: 32000, Rate: 0.065},
+		{UpTo: 64000, Rate: 0.0455},
+		{UpTo: math.MaxFloat64, Rate: 0.03185},
+	}
+	if got := client.ebsIOPSTierIndex["io2"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("io2 IOPS tiers = %+v, want %+v", got, want)
+	}
+	if tiers, found := client.ebsIOPSTierIndex["io1"]; found {
+		t.Errorf("io1 IOPS tiers = %+v, want none (flat rate)", tiers)
+	}
+}
+
 // TestClient_parseELBPricing_Logic tests the ELB pricing parsing logic with controlled input.
 //
 // Purpose: Validates that the parseELBPricing method correctly parses minimal ELB pricing
@@ -932,3 +1057,905 @@ func TestClient_parseELBPricing_Logic(t *testing.T) {
 		t.Errorf("expected NLB NLCU 0, got %v", client.elbPricing.NLBNLCURate)
 	}
 }
+
+// TestClient_SlowLookupThreshold verifies the configurable lookup warning threshold.
+func TestClient_SlowLookupThreshold(t *testing.T) {
+	defaultClient := &Client{}
+	if defaultClient.isSlowLookup(40 * time.Millisecond) {
+		t.Error("40ms should be below the default 50ms threshold")
+	}
+	if !defaultClient.isSlowLookup(60 * time.Millisecond) {
+		t.Error("60ms should exceed the default 50ms threshold")
+	}
+
+	client, err := NewClientWithOptions(zerolog.Nop(), ClientOptions{SlowLookupThreshold: 200 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewClientWithOptions() failed: %v", err)
+	}
+	if client.isSlowLookup(60 * time.Millisecond) {
+		t.Error("60ms should be below the configured 200ms threshold")
+	}
+	if !client.isSlowLookup(250 * time.Millisecond) {
+		t.Error("250ms should exceed the configured 200ms threshold")
+	}
+}
+
+// TestClient_parseRDSPricing_ReservedAndAurora verifies that RDS parsing indexes
+// 1yr No Upfront Reserved rates and keeps Aurora I/O-Optimized instances separate.
+func TestClient_parseRDSPricing_ReservedAndAurora(t *testing.T) {
+	jsonData := []byte(`{
+		"offerCode": "AmazonRDS",
+		"products": {
+			"SKU_STD": {
+				"sku": "SKU_STD",
+				"productFamily": "Database Instance",
+				"attributes": {
+					"instanceType": "db.r6g.large",
+					"databaseEngine": "Aurora PostgreSQL",
+					"deploymentOption": "Single-AZ",
+					"usagetype": "InstanceUsage:db.r6g.large",
+					"regionCode": "us-test-1"
+				}
+			},
+			"SKU_IOOPT": {
+				"sku": "SKU_IOOPT",
+				"productFamily": "Database Instance",
+				"attributes": {
+					"instanceType": "db.r6g.large",
+					"databaseEngine": "Aurora PostgreSQL",
+					"deploymentOption": "Single-AZ",
+					"usagetype": "InstanceUsageIOOptimized:db.r6g.large",
+					"regionCode": "us-test-1"
+				}
+			},
+			"SKU_IO": {
+				"sku": "SKU_IO",
+				"productFamily": "System Operation",
+				"attributes": {
+					"group": "Aurora I/O Operation",
+					"usagetype": "Aurora:StorageIOUsage",
+					"regionCode": "us-test-1"
+				}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_STD": {"SKU_STD.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.26"}}}}},
+				"SKU_IOOPT": {"SKU_IOOPT.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.338"}}}}},
+				"SKU_IO": {"SKU_IO.OD": {"priceDimensions": {"R": {"unit": "IOs", "pricePerUnit": {"USD": "0.0000002"}}}}}
+			},
+			"Reserved": {
+				"SKU_STD": {
+					"SKU_STD.RI3": {
+						"termAttributes": {"LeaseContractLength": "3yr", "PurchaseOption": "No Upfront", "OfferingClass": "standard"},
+						"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.12"}}}
+					},
+					"SKU_STD.RI1": {
+						"termAttributes": {"LeaseContractLength": "1yr", "PurchaseOption": "No Upfront", "OfferingClass": "standard"},
+						"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.18"}}}
+					}
+				}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:              zerolog.Nop(),
+		rdsInstanceIndex:    make(map[string]rdsInstancePrice),
+		rdsStorageIndex:     make(map[string]rdsStoragePrice),
+		rdsReservedIndex:    make(map[string]rdsInstancePrice),
+		rdsIOOptimizedIndex: make(map[string]rdsInstancePrice),
+	}
+
+	if _, err := client.parseRDSPricing(jsonData); err != nil {
+		t.Fatalf("parseRDSPricing failed: %v", err)
+	}
+
+	key := "db.r6g.large/Aurora PostgreSQL"
+	if got := client.rdsInstanceIndex[key].HourlyRate; got != 0.26 {
+		t.Errorf("on-demand rate = %v, want 0.26", got)
+	}
+	if got := client.rdsIOOptimizedIndex[key].HourlyRate; got != 0.338 {
+		t.Errorf("I/O-Optimized rate = %v, want 0.338", got)
+	}
+	if got := client.rdsReservedIndex[key].HourlyRate; got != 0.18 {
+		t.Errorf("reserved 1yr rate = %v, want 0.18", got)
+	}
+	if client.rdsAuroraIOPricing == nil || client.rdsAuroraIOPricing.RatePerRequest != 0.0000002 {
+		t.Errorf("Aurora I/O rate = %+v, want 0.0000002", client.rdsAuroraIOPricing)
+	}
+}
+
+// TestClient_parseEKSPricing_Fargate verifies Fargate pod rates are captured alongside the cluster rate.
+func TestClient_parseEKSPricing_Fargate(t *testing.T) {
+	jsonData := []byte(`{
+		"offerCode": "AmazonEKS",
+		"products": {
+			"SKU_CLUSTER": {
+				"sku": "SKU_CLUSTER",
+				"attributes": {"servicecode": "AmazonEKS", "operation": "CreateOperation", "usagetype": "USE1-AmazonEKS-Hours:perCluster", "regionCode": "us-test-1"}
+			},
+			"SKU_VCPU": {
+				"sku": "SKU_VCPU",
+				"attributes": {"servicecode": "AmazonEKS", "usagetype": "USE1-Fargate-vCPU-Hours:perCPU", "regionCode": "us-test-1"}
+			},
+			"SKU_GB": {
+				"sku": "SKU_GB",
+				"attributes": {"servicecode": "AmazonEKS", "usagetype": "USE1-Fargate-GB-Hours", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_CLUSTER": {"SKU_CLUSTER.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_VCPU": {"SKU_VCPU.OD": {"priceDimensions": {"R": {"unit": "hours", "pricePerUnit": {"USD": "0.04048"}}}}},
+				"SKU_GB": {"SKU_GB.OD": {"priceDimensions": {"R": {"unit": "GB-Hours", "pricePerUnit": {"USD": "0.004445"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseEKSPricing(jsonData); err != nil {
+		t.Fatalf("parseEKSPricing failed: %v", err)
+	}
+
+	if got := client.eksPricing.StandardHourlyRate; got != 0.10 {
+		t.Errorf("StandardHourlyRate = %v, want 0.10", got)
+	}
+	if got := client.eksPricing.FargateVCPURate; got != 0.04048 {
+		t.Errorf("FargateVCPURate = %v, want 0.04048", got)
+	}
+	if got := client.eksPricing.FargateGBRate; got != 0.004445 {
+		t.Errorf("FargateGBRate = %v, want 0.004445", got)
+	}
+}
+
+// TestClient_recordMetadata verifies per-service provenance is retained and stub files are skipped.
+func TestClient_recordMetadata(t *testing.T) {
+	client := &Client{logger: zerolog.Nop()}
+
+	if _, err := client.parseEKSPricing([]byte(`{"offerCode": "AmazonEKS", "version": "20251218235654", "publicationDate": "2025-12-18T23:56:54Z", "products": {}}`)); err != nil {
+		t.Fatalf("parseEKSPricing failed: %v", err)
+	}
+	if _, err := client.parseS3Pricing([]byte(`{}`)); err != nil {
+		t.Fatalf("parseS3Pricing failed: %v", err)
+	}
+
+	meta, ok := client.metadata["AmazonEKS"]
+	if !ok || meta.Version != "20251218235654" || meta.PublicationDate != "2025-12-18T23:56:54Z" {
+		t.Errorf("AmazonEKS metadata = %+v (found=%v), want version and publication date", meta, ok)
+	}
+	if _, ok := client.metadata["AmazonS3"]; ok {
+		t.Error("AmazonS3 metadata recorded for data without version or publication date")
+	}
+}
+
+// TestClient_parseECRPricing verifies ECR storage and first paid data transfer tier are captured.
+func TestClient_parseECRPricing(t *testing.T) {
+	jsonData := []byte(`{
+		"offerCode": "AmazonECR",
+		"products": {
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "EC2 Container Registry",
+				"attributes": {"usagetype": "USE1-TimedStorage-ByteHrs", "regionCode": "us-test-1"}
+			},
+			"SKU_DTO": {
+				"sku": "SKU_DTO",
+				"productFamily": "Data Transfer",
+				"attributes": {"usagetype": "USE1-DataTransfer-Out-Bytes", "transferType": "AWS Outbound"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_STORAGE": {"SKU_STORAGE.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_DTO": {"SKU_DTO.OD": {"priceDimensions": {
+					"T1": {"unit": "GB", "beginRange": "0", "endRange": "1", "pricePerUnit": {"USD": "0.00"}},
+					"T2": {"unit": "GB", "beginRange": "1", "endRange": "10240", "pricePerUnit": {"USD": "0.09"}},
+					"T3": {"unit": "GB", "beginRange": "10240", "endRange": "Inf", "pricePerUnit": {"USD": "0.085"}}
+				}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseECRPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseECRPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+	if got := client.ecrPricing.StorageRate; got != 0.10 {
+		t.Errorf("StorageRate = %v, want 0.10", got)
+	}
+	if got := client.ecrPricing.DataTransferOutRate; got != 0.09 {
+		t.Errorf("DataTransferOutRate = %v, want 0.09", got)
+	}
+}
+
+// TestClient_parseSecretsManagerAndKMSPricing verifies secret, key and request rates are captured.
+func TestClient_parseSecretsManagerAndKMSPricing(t *testing.T) {
+	secretsData := []byte(`{
+		"offerCode": "AWSSecretsManager",
+		"products": {
+			"SKU_SECRET": {"sku": "SKU_SECRET", "productFamily": "Secret", "attributes": {"usagetype": "USE1-AWSSecretsManager-Secrets", "regionCode": "us-test-1"}},
+			"SKU_API": {"sku": "SKU_API", "productFamily": "API Request", "attributes": {"usagetype": "USE1-AWSSecretsManager-APIRequest"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_SECRET": {"SKU_SECRET.OD": {"priceDimensions": {"R": {"unit": "Secrets", "pricePerUnit": {"USD": "0.40"}}}}},
+				"SKU_API": {"SKU_API.OD": {"priceDimensions": {"R": {"unit": "API Requests", "pricePerUnit": {"USD": "0.000005"}}}}}
+			}
+		}
+	}`)
+	kmsData := []byte(`{
+		"offerCode": "awskms",
+		"products": {
+			"SKU_KEY": {"sku": "SKU_KEY", "productFamily": "Encryption Key", "attributes": {"usagetype": "USE1-KMS-Keys", "regionCode": "us-test-1"}},
+			"SKU_REQ": {"sku": "SKU_REQ", "productFamily": "Encryption Key", "attributes": {"usagetype": "USE1-KMS-Requests"}},
+			"SKU_ASYM": {"sku": "SKU_ASYM", "productFamily": "Encryption Key", "attributes": {"usagetype": "USE1-KMS-Requests-Asymmetric"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_KEY": {"SKU_KEY.OD": {"priceDimensions": {"R": {"unit": "Keys", "pricePerUnit": {"USD": "1.00"}}}}},
+				"SKU_REQ": {"SKU_REQ.OD": {"priceDimensions": {
+					"T1": {"unit": "Requests", "beginRange": "0", "endRange": "20000", "pricePerUnit": {"USD": "0.00"}},
+					"T2": {"unit": "Requests", "beginRange": "20000", "endRange": "Inf", "pricePerUnit": {"USD": "0.000003"}}
+				}}},
+				"SKU_ASYM": {"SKU_ASYM.OD": {"priceDimensions": {"R": {"unit": "Requests", "pricePerUnit": {"USD": "0.000015"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseSecretsManagerPricing(secretsData); err != nil {
+		t.Fatalf("parseSecretsManagerPricing failed: %v", err)
+	}
+	if _, err := client.parseKMSPricing(kmsData); err != nil {
+		t.Fatalf("parseKMSPricing failed: %v", err)
+	}
+
+	if got := client.secretsManagerPricing.SecretMonthlyRate; got != 0.40 {
+		t.Errorf("SecretMonthlyRate = %v, want 0.40", got)
+	}
+	if got := client.secretsManagerPricing.APICallRate; got != 0.000005 {
+		t.Errorf("APICallRate = %v, want 0.000005", got)
+	}
+	if got := client.kmsPricing.KeyMonthlyRate; got != 1.00 {
+		t.Errorf("KeyMonthlyRate = %v, want 1.00", got)
+	}
+	if got := client.kmsPricing.RequestRate; got != 0.000003 {
+		t.Errorf("RequestRate = %v, want 0.000003 (symmetric, first paid tier)", got)
+	}
+}
+
+// TestClient_parseWAFPricing verifies web ACL, rule and request rates are captured and
+// add-on usage types sharing a suffix are ignored.
+func TestClient_parseWAFPricing(t *testing.T) {
+	wafData := []byte(`{
+		"offerCode": "AWSWAF",
+		"products": {
+			"SKU_ACL": {"sku": "SKU_ACL", "productFamily": "Web Application Firewall", "attributes": {"usagetype": "USE1-WebACL", "regionCode": "us-test-1"}},
+			"SKU_RULE": {"sku": "SKU_RULE", "productFamily": "Web Application Firewall", "attributes": {"usagetype": "USE1-Rule"}},
+			"SKU_REQ": {"sku": "SKU_REQ", "productFamily": "Web Application Firewall", "attributes": {"usagetype": "USE1-Request"}},
+			"SKU_BOT": {"sku": "SKU_BOT", "productFamily": "Web Application Firewall", "attributes": {"usagetype": "USE1-BotControl-Request"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_ACL": {"SKU_ACL.OD": {"priceDimensions": {"R": {"unit": "WebACL", "pricePerUnit": {"USD": "5.00"}}}}},
+				"SKU_RULE": {"SKU_RULE.OD": {"priceDimensions": {"R": {"unit": "Rule", "pricePerUnit": {"USD": "1.00"}}}}},
+				"SKU_REQ": {"SKU_REQ.OD": {"priceDimensions": {"R": {"unit": "Request", "pricePerUnit": {"USD": "0.0000006"}}}}},
+				"SKU_BOT": {"SKU_BOT.OD": {"priceDimensions": {"R": {"unit": "Request", "pricePerUnit": {"USD": "0.000001"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseWAFPricing(wafData)
+	if err != nil {
+		t.Fatalf("parseWAFPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+
+	if got := client.wafPricing.WebACLMonthlyRate; got != 5.00 {
+		t.Errorf("WebACLMonthlyRate = %v, want 5.00", got)
+	}
+	if got := client.wafPricing.RuleMonthlyRate; got != 1.00 {
+		t.Errorf("RuleMonthlyRate = %v, want 1.00", got)
+	}
+	if got := client.wafPricing.RequestRate; got != 0.0000006 {
+		t.Errorf("RequestRate = %v, want 0.0000006 (Bot Control excluded)", got)
+	}
+}
+
+// TestClient_parseAthenaPricing verifies the per-TB scanned rate is captured and Spark
+// DPU-hour usage types are ignored.
+func TestClient_parseAthenaPricing(t *testing.T) {
+	athenaData := []byte(`{
+		"offerCode": "AmazonAthena",
+		"products": {
+			"SKU_SCAN": {"sku": "SKU_SCAN", "productFamily": "Athena Queries", "attributes": {"usagetype": "USE1-DataScannedInTB", "regionCode": "us-test-1"}},
+			"SKU_SPARK": {"sku": "SKU_SPARK", "productFamily": "Athena Queries", "attributes": {"usagetype": "USE1-SparkDPU-Hour"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_SCAN": {"SKU_SCAN.OD": {"priceDimensions": {"R": {"unit": "Terabytes", "pricePerUnit": {"USD": "5.00"}}}}},
+				"SKU_SPARK": {"SKU_SPARK.OD": {"priceDimensions": {"R": {"unit": "DPU-Hour", "pricePerUnit": {"USD": "0.35"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseAthenaPricing(athenaData)
+	if err != nil {
+		t.Fatalf("parseAthenaPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+	if got := client.athenaPricing.DataScannedTBRate; got != 5.00 {
+		t.Errorf("DataScannedTBRate = %v, want 5.00", got)
+	}
+}
+
+// TestClient_parseGluePricing verifies ETL, Flex and crawler DPU-hour rates are captured
+// and Data Catalog usage types are ignored.
+func TestClient_parseGluePricing(t *testing.T) {
+	glueData := []byte(`{
+		"offerCode": "AWSGlue",
+		"products": {
+			"SKU_ETL": {"sku": "SKU_ETL", "productFamily": "AWS Glue", "attributes": {"usagetype": "USE1-ETL-DPU-Hour", "regionCode": "us-test-1"}},
+			"SKU_FLEX": {"sku": "SKU_FLEX", "productFamily": "AWS Glue", "attributes": {"usagetype": "USE1-ETL-Flex-DPU-Hour"}},
+			"SKU_CRAWL": {"sku": "SKU_CRAWL", "productFamily": "AWS Glue", "attributes": {"usagetype": "USE1-Crawler-DPU-Hour"}},
+			"SKU_CAT": {"sku": "SKU_CAT", "productFamily": "AWS Glue", "attributes": {"usagetype": "USE1-Catalog-Request"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_ETL": {"SKU_ETL.OD": {"priceDimensions": {"R": {"unit": "DPU-Hour", "pricePerUnit": {"USD": "0.44"}}}}},
+				"SKU_FLEX": {"SKU_FLEX.OD": {"priceDimensions": {"R": {"unit": "DPU-Hour", "pricePerUnit": {"USD": "0.29"}}}}},
+				"SKU_CRAWL": {"SKU_CRAWL.OD": {"priceDimensions": {"R": {"unit": "DPU-Hour", "pricePerUnit": {"USD": "0.44"}}}}},
+				"SKU_CAT": {"SKU_CAT.OD": {"priceDimensions": {"R": {"unit": "Request", "pricePerUnit": {"USD": "0.000001"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseGluePricing(glueData)
+	if err != nil {
+		t.Fatalf("parseGluePricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+
+	if got := client.gluePricing.ETLDPUHourRate; got != 0.44 {
+		t.Errorf("ETLDPUHourRate = %v, want 0.44", got)
+	}
+	if got := client.gluePricing.FlexDPUHourRate; got != 0.29 {
+		t.Errorf("FlexDPUHourRate = %v, want 0.29", got)
+	}
+	if got := client.gluePricing.CrawlerDPUHourRate; got != 0.44 {
+		t.Errorf("CrawlerDPUHourRate = %v, want 0.44", got)
+	}
+}
+
+// TestClient_parseSESPricing verifies the per-recipient rate skips the zero-rated free
+// tier, the attachment rate is captured and inbound usage types are ignored.
+func TestClient_parseSESPricing(t *testing.T) {
+	sesData := []byte(`{
+		"offerCode": "AmazonSES",
+		"products": {
+			"SKU_OUT": {"sku": "SKU_OUT", "productFamily": "Sending Email", "attributes": {"usagetype": "USE1-Recipients", "regionCode": "us-test-1"}},
+			"SKU_ATT": {"sku": "SKU_ATT", "productFamily": "Sending Attachments", "attributes": {"usagetype": "USE1-AttachmentsSize-Bytes"}},
+			"SKU_IN": {"sku": "SKU_IN", "productFamily": "Receiving Email", "attributes": {"usagetype": "USE1-Message"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_OUT": {"SKU_OUT.OD": {"priceDimensions": {
+					"FREE": {"unit": "Count", "beginRange": "0", "endRange": "3000", "pricePerUnit": {"USD": "0"}},
+					"PAID": {"unit": "Count", "beginRange": "3000", "endRange": "Inf", "pricePerUnit": {"USD": "0.0001"}}
+				}}},
+				"SKU_ATT": {"SKU_ATT.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.12"}}}}},
+				"SKU_IN": {"SKU_IN.OD": {"priceDimensions": {"R": {"unit": "Count", "pricePerUnit": {"USD": "0.0002"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseSESPricing(sesData)
+	if err != nil {
+		t.Fatalf("parseSESPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+
+	if got := client.sesPricing.EmailRate; got != 0.0001 {
+		t.Errorf("EmailRate = %v, want 0.0001", got)
+	}
+	if got := client.sesPricing.AttachmentGBRate; got != 0.12 {
+		t.Errorf("AttachmentGBRate = %v, want 0.12", got)
+	}
+}
+
+// TestClient_parseStepFunctionsPricing verifies the Standard transition rate skips the
+// free tier, the Express duration rate keeps the first tier, and other usage is ignored.
+func TestClient_parseStepFunctionsPricing(t *testing.T) {
+	sfnData := []byte(`{
+		"offerCode": "AmazonStates",
+		"products": {
+			"SKU_STD": {"sku": "SKU_STD", "productFamily": "AWS Step Functions", "attributes": {"usagetype": "USE1-StateTransition", "regionCode": "us-test-1"}},
+			"SKU_EXREQ": {"sku": "SKU_EXREQ", "productFamily": "AWS Step Functions", "attributes": {"usagetype": "USE1-StepFunctions-Express-Requests"}},
+			"SKU_EXDUR": {"sku": "SKU_EXDUR", "productFamily": "AWS Step Functions", "attributes": {"usagetype": "USE1-StepFunctions-Express-GB-Second"}},
+			"SKU_OTHER": {"sku": "SKU_OTHER", "productFamily": "AWS Step Functions", "attributes": {"usagetype": "USE1-ActivityTask"}}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_STD": {"SKU_STD.OD": {"priceDimensions": {
+					"FREE": {"unit": "StateTransitions", "beginRange": "0", "endRange": "4000", "pricePerUnit": {"USD": "0"}},
+					"PAID": {"unit": "StateTransitions", "beginRange": "4000", "endRange": "Inf", "pricePerUnit": {"USD": "0.000025"}}
+				}}},
+				"SKU_EXREQ": {"SKU_EXREQ.OD": {"priceDimensions": {"R": {"unit": "Requests", "pricePerUnit": {"USD": "0.000001"}}}}},
+				"SKU_EXDUR": {"SKU_EXDUR.OD": {"priceDimensions": {
+					"T1": {"unit": "GB-Second", "beginRange": "0", "endRange": "3600000", "pricePerUnit": {"USD": "0.00001667"}},
+					"T2": {"unit": "GB-Second", "beginRange": "3600000", "endRange": "Inf", "pricePerUnit": {"USD": "0.00000833"}}
+				}}},
+				"SKU_OTHER": {"SKU_OTHER.OD": {"priceDimensions": {"R": {"unit": "Count", "pricePerUnit": {"USD": "0.5"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseStepFunctionsPricing(sfnData)
+	if err != nil {
+		t.Fatalf("parseStepFunctionsPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("region = %q, want us-test-1", region)
+	}
+
+	if got := client.stepFunctionsPricing.StateTransitionRate; got != 0.000025 {
+		t.Errorf("StateTransitionRate = %v, want 0.000025", got)
+	}
+	if got := client.stepFunctionsPricing.ExpressRequestRate; got != 0.000001 {
+		t.Errorf("ExpressRequestRate = %v, want 0.000001", got)
+	}
+	if got := client.stepFunctionsPricing.ExpressGBSecondRate; got != 0.00001667 {
+		t.Errorf("ExpressGBSecondRate = %v, want 0.00001667", got)
+	}
+}
+
+// TestClient_WarmUp verifies warm-up builds the lazily initialized indexes before the
+// first lookup and reports the parse duration.
+func TestClient_WarmUp(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	initDuration, err := client.WarmUp()
+	if err != nil {
+		t.Fatalf("WarmUp failed: %v", err)
+	}
+	if initDuration <= 0 || initDuration != client.initDuration {
+		t.Errorf("WarmUp duration = %v, want the init duration %v", initDuration, client.initDuration)
+	}
+	if _, ok := client.ec2Index["m5.large/Linux/Shared"]; !ok {
+		t.Errorf("ec2Index not populated by WarmUp: %v", client.ec2Index)
+	}
+	if want := []string{"m5.large"}; !reflect.DeepEqual(client.ec2InstanceTypes, want) {
+		t.Errorf("ec2InstanceTypes = %v, want %v", client.ec2InstanceTypes, want)
+	}
+	if client.snapshots == nil {
+		t.Error("snapshot index not built by WarmUp")
+	}
+}
+
+// TestClient_EC2NetworkPerformance verifies network performance is indexed per instance type.
+func TestClient_EC2NetworkPerformance(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA",
+					"networkPerformance": "Up to 10 Gigabit"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	if got, ok := client.EC2NetworkPerformance("m5.large"); !ok || got != "Up to 10 Gigabit" {
+		t.Errorf("EC2NetworkPerformance(m5.large) = (%q, %v), want (\"Up to 10 Gigabit\", true)", got, ok)
+	}
+	if _, ok := client.EC2NetworkPerformance("m6g.large"); ok {
+		t.Error("EC2NetworkPerformance(m6g.large) found, want not found")
+	}
+}
+
+// TestClient_RegionDetection verifies the region comes from the EC2 products' regionCode,
+// and falls back to the build-time region when no product carries one.
+func TestClient_RegionDetection(t *testing.T) {
+	ec2JSON := func(regionAttr string) []byte {
+		return []byte(`{
+			"offerCode": "AmazonEC2",
+			"products": {
+				"SKU_M5": {
+					"sku": "SKU_M5",
+					"productFamily": "Compute Instance",
+					"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+						` + regionAttr + `"capacitystatus": "Used", "preInstalledSw": "NA"}
+				},
+				"SKU_GP3": {
+					"sku": "SKU_GP3",
+					"productFamily": "Storage",
+					"attributes": {` + regionAttr + `"volumeApiName": "gp3"}
+				}
+			},
+			"terms": {"OnDemand": {
+				"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+				"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+			}}
+		}`)
+	}
+
+	tests := []struct {
+		name       string
+		regionAttr string
+		wantRegion string
+	}{
+		{name: "from products", regionAttr: `"regionCode": "us-test-1", `, wantRegion: "us-test-1"},
+		{name: "missing regionCode uses build-time region", regionAttr: "", wantRegion: "us-east-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := newSnapshotPricing()
+			data.EC2 = ec2JSON(tt.regionAttr)
+			client := &Client{logger: zerolog.Nop(), data: data}
+
+			if _, ok := client.EC2OnDemandPricePerHour("m5.large", "Linux", "Shared"); !ok {
+				t.Fatalf("EC2 price not found, init error: %v", client.err)
+			}
+			if got := client.Region(); got != tt.wantRegion {
+				t.Errorf("Region() = %q, want %q", got, tt.wantRegion)
+			}
+		})
+	}
+}
+
+func TestClient_EC2InterAZDataTransferPricePerGB(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			},
+			"SKU_REGIONAL": {
+				"sku": "SKU_REGIONAL",
+				"productFamily": "Data Transfer",
+				"attributes": {"transferType": "IntraRegion", "usagetype": "USE1-DataTransfer-Regional-Bytes"}
+			},
+			"SKU_OUT": {
+				"sku": "SKU_OUT",
+				"productFamily": "Data Transfer",
+				"attributes": {"transferType": "AWS Outbound", "usagetype": "USE1-DataTransfer-Out-Bytes"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}},
+			"SKU_REGIONAL": {"SKU_REGIONAL.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.01"}}}}},
+			"SKU_OUT": {"SKU_OUT.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.09"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	if got, ok := client.EC2InterAZDataTransferPricePerGB(); !ok || got != 0.01 {
+		t.Errorf("EC2InterAZDataTransferPricePerGB() = (%v, %v), want (0.01, true)", got, ok)
+	}
+}
+
+func TestClient_EC2InstanceTypes(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_M5_WIN": {
+				"sku": "SKU_M5_WIN",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Windows", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_C7G": {
+				"sku": "SKU_C7G",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "c7g.xlarge", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_M5_WIN": {"SKU_M5_WIN.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.188"}}}}},
+			"SKU_C7G": {"SKU_C7G.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.145"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	got := client.EC2InstanceTypes()
+	want := []string{"c7g.xlarge", "m5.large"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EC2InstanceTypes() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_EBSSnapshotPricePerGBMonth(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			},
+			"SKU_SNAP": {
+				"sku": "SKU_SNAP",
+				"productFamily": "Storage Snapshot",
+				"attributes": {"usagetype": "EBS:SnapshotUsage", "regionCode": "us-test-1"}
+			},
+			"SKU_ARCHIVE": {
+				"sku": "SKU_ARCHIVE",
+				"productFamily": "Storage Snapshot",
+				"attributes": {"usagetype": "EBS:SnapshotArchiveStorage", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}},
+			"SKU_SNAP": {"SKU_SNAP.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.05"}}}}},
+			"SKU_ARCHIVE": {"SKU_ARCHIVE.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.0125"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	if got, ok := client.EBSSnapshotPricePerGBMonth(); !ok || got != 0.05 {
+		t.Errorf("EBSSnapshotPricePerGBMonth() = (%v, %v), want (0.05, true)", got, ok)
+	}
+}
+
+func TestClient_S3IntelligentTieringMonitoringPricePerObject(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+	data.S3 = []byte(`{
+		"offerCode": "AmazonS3",
+		"products": {
+			"SKU_INT": {
+				"sku": "SKU_INT",
+				"productFamily": "Fee",
+				"attributes": {"usagetype": "USE1-Monitoring-Automation-INT", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_INT": {"SKU_INT.OD": {"priceDimensions": {"R": {"unit": "1,000 Objects", "pricePerUnit": {"USD": "0.0025"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	if got, ok := client.S3IntelligentTieringMonitoringPricePerObject(); !ok || math.Abs(got-0.0000025) > 1e-12 {
+		t.Errorf("S3IntelligentTieringMonitoringPricePerObject() = (%v, %v), want (0.0000025, true)", got, ok)
+	}
+}
+
+// TestClient_S3StorageClasses verifies storage and retrieval prices are indexed under the
+// S3 API storage class names, keeping IA/one-zone and Glacier/Deep Archive apart even
+// though they share a storageClass attribute.
+func TestClient_S3StorageClasses(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": "us-test-1", "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": "us-test-1"}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+	data.S3 = []byte(`{
+		"offerCode": "AmazonS3",
+		"products": {
+			"SKU_SIA": {"sku": "SKU_SIA", "productFamily": "Storage",
+				"attributes": {"storageClass": "Infrequent Access", "volumeType": "Standard - Infrequent Access", "regionCode": "us-test-1"}},
+			"SKU_ZIA": {"sku": "SKU_ZIA", "productFamily": "Storage",
+				"attributes": {"storageClass": "Infrequent Access", "volumeType": "One Zone - Infrequent Access", "regionCode": "us-test-1"}},
+			"SKU_GIR": {"sku": "SKU_GIR", "productFamily": "Storage",
+				"attributes": {"storageClass": "Archive Instant Retrieval", "volumeType": "Glacier Instant Retrieval", "regionCode": "us-test-1"}},
+			"SKU_GFR": {"sku": "SKU_GFR", "productFamily": "Storage",
+				"attributes": {"storageClass": "Archive", "volumeType": "Amazon Glacier", "regionCode": "us-test-1"}},
+			"SKU_GDA": {"sku": "SKU_GDA", "productFamily": "Storage",
+				"attributes": {"storageClass": "Archive", "volumeType": "Glacier Deep Archive", "regionCode": "us-test-1"}},
+			"SKU_ZIA_RET": {"sku": "SKU_ZIA_RET", "productFamily": "Fee",
+				"attributes": {"usagetype": "USE1-Retrieval-ZIA", "volumeType": "One Zone - Infrequent Access", "regionCode": "us-test-1"}},
+			"SKU_GFR_RET": {"sku": "SKU_GFR_RET", "productFamily": "Fee",
+				"attributes": {"usagetype": "USE1-Glacier-Retrieval-Standard", "volumeType": "Amazon Glacier", "regionCode": "us-test-1"}},
+			"SKU_GFR_EXP": {"sku": "SKU_GFR_EXP", "productFamily": "Fee",
+				"attributes": {"usagetype": "USE1-Glacier-Retrieval-Expedited", "volumeType": "Amazon Glacier", "regionCode": "us-test-1"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_SIA": {"SKU_SIA.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.0125"}}}}},
+			"SKU_ZIA": {"SKU_ZIA.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.01"}}}}},
+			"SKU_GIR": {"SKU_GIR.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.004"}}}}},
+			"SKU_GFR": {"SKU_GFR.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.0036"}}}}},
+			"SKU_GDA": {"SKU_GDA.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.00099"}}}}},
+			"SKU_ZIA_RET": {"SKU_ZIA_RET.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.01"}}}}},
+			"SKU_GFR_RET": {"SKU_GFR_RET.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.01"}}}}},
+			"SKU_GFR_EXP": {"SKU_GFR_EXP.OD": {"priceDimensions": {"R": {"unit": "GB", "pricePerUnit": {"USD": "0.03"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+
+	storage := map[string]float64{
+		"STANDARD_IA":  0.0125,
+		"ONEZONE_IA":   0.01,
+		"GLACIER_IR":   0.004,
+		"GLACIER":      0.0036,
+		"DEEP_ARCHIVE": 0.00099,
+	}
+	for class, want := range storage {
+		if got, ok := client.S3PricePerGBMonth(class); !ok || got != want {
+			t.Errorf("S3PricePerGBMonth(%q) = (%v, %v), want (%v, true)", class, got, ok, want)
+		}
+	}
+
+	retrieval := map[string]float64{
+		"ONEZONE_IA": 0.01,
+		"GLACIER":    0.01, // Standard tier, not Expedited
+	}
+	for class, want := range retrieval {
+		if got, ok := client.S3RetrievalPricePerGB(class); !ok || got != want {
+			t.Errorf("S3RetrievalPricePerGB(%q) = (%v, %v), want (%v, true)", class, got, ok, want)
+		}
+	}
+	if got, ok := client.S3RetrievalPricePerGB("STANDARD"); ok {
+		t.Errorf("S3RetrievalPricePerGB(STANDARD) = (%v, true), want not found", got)
+	}
+}
+
+// TestClient_ELBOutposts verifies Outposts usage types are indexed apart from the
+// regional ELB rates instead of being dropped or overwriting them.
+func TestClient_ELBOutposts(t *testing.T) {
+	jsonData := []byte(`{
+		"offerCode": "AWSELB",
+		"products": {
+			"SKU_ALB_HR": {"sku": "SKU_ALB_HR", "productFamily": "Load Balancer-Application",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-LoadBalancerUsage"}},
+			"SKU_ALB_LCU": {"sku": "SKU_ALB_LCU", "productFamily": "Load Balancer-Application",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-LCUUsage"}},
+			"SKU_ALB_OP_HR": {"sku": "SKU_ALB_OP_HR", "productFamily": "Load Balancer-Application",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Outposts-LoadBalancerUsage"}},
+			"SKU_ALB_OP_LCU": {"sku": "SKU_ALB_OP_LCU", "productFamily": "Load Balancer-Application",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Outposts-LCUUsage"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_ALB_HR": {"SKU_ALB_HR.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0225"}}}}},
+			"SKU_ALB_LCU": {"SKU_ALB_LCU.OD": {"priceDimensions": {"R": {"unit": "LCU-Hrs", "pricePerUnit": {"USD": "0.008"}}}}},
+			"SKU_ALB_OP_HR": {"SKU_ALB_OP_HR.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.03"}}}}},
+			"SKU_ALB_OP_LCU": {"SKU_ALB_OP_LCU.OD": {"priceDimensions": {"R": {"unit": "LCU-Hrs", "pricePerUnit": {"USD": "0.011"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseELBPricing(jsonData); err != nil {
+		t.Fatalf("parseELBPricing failed: %v", err)
+	}
+
+	if client.elbPricing == nil || client.elbOutpostsPricing == nil {
+		t.Fatalf("elbPricing = %v, elbOutpostsPricing = %v; want both set", client.elbPricing, client.elbOutpostsPricing)
+	}
+	if client.elbPricing.ALBHourlyRate != 0.0225 || client.elbPricing.ALBLCURate != 0.008 {
+		t.Errorf("regional ALB = (%v, %v), want (0.0225, 0.008)",
+			client.elbPricing.ALBHourlyRate, client.elbPricing.ALBLCURate)
+	}
+	if client.elbOutpostsPricing.ALBHourlyRate != 0.03 || client.elbOutpostsPricing.ALBLCURate != 0.011 {
+		t.Errorf("Outposts ALB = (%v, %v), want (0.03, 0.011)",
+			client.elbOutpostsPricing.ALBHourlyRate, client.elbOutpostsPricing.ALBLCURate)
+	}
+	if client.elbOutpostsPricing.NLBNLCURate != 0 {
+		t.Errorf("Outposts NLB NLCU = %v, want 0", client.elbOutpostsPricing.NLBNLCURate)
+	}
+}
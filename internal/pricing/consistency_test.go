@@ -0,0 +1,99 @@
+package pricing
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// storageConsistencyPricing returns pricing data with an EBS gp3 rate of $0.08 and the
+// given RDS gp3 storage rate. It prices no EC2 instances, so the client keeps the
+// "unknown" region under any region build tag.
+func storageConsistencyPricing(rdsGP3Rate string) *rawPricingData {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"products": {
+			"SKU_GP3": {"sku": "SKU_GP3", "productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+	data.RDS = []byte(fmt.Sprintf(`{
+		"offerCode": "AmazonRDS",
+		"version": "20260101000000",
+		"products": {
+			"SKU_RDS_GP3": {"sku": "SKU_RDS_GP3", "productFamily": "Database Storage",
+				"attributes": {"volumeType": "General Purpose", "usagetype": "USE1-RDS:gp3-Storage"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_RDS_GP3": {"SKU_RDS_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": %q}}}}}
+		}}
+	}`, rdsGP3Rate))
+	return data
+}
+
+// TestClient_PriceInconsistencies verifies an RDS gp3 storage rate outside the plausible
+// range relative to EBS gp3 is logged and recorded, and a plausible one is not.
+func TestClient_PriceInconsistencies(t *testing.T) {
+	tests := []struct {
+		name       string
+		rdsGP3Rate string
+		wantFlag   bool
+	}{
+		{"single-AZ premium", "0.115", false},
+		{"multi-AZ premium", "0.23", false},
+		{"cheaper than EBS", "0.04", true},
+		{"far above EBS", "0.50", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Services are parsed concurrently, so the log buffer must be synchronized.
+			var logs bytes.Buffer
+			client := &Client{
+				logger: zerolog.New(zerolog.SyncWriter(&logs)),
+				data:   storageConsistencyPricing(tt.rdsGP3Rate),
+			}
+
+			got := client.PriceInconsistencies()
+			if !tt.wantFlag {
+				if len(got) != 0 {
+					t.Errorf("PriceInconsistencies() = %v, want none", got)
+				}
+				if strings.Contains(logs.String(), "PRICING INCONSISTENCY") {
+					t.Errorf("logs report an inconsistency for a plausible rate:\n%s", logs.String())
+				}
+				return
+			}
+
+			if len(got) != 1 || !strings.HasPrefix(got[0], "AmazonRDS: gp3 storage") {
+				t.Fatalf("PriceInconsistencies() = %v, want one AmazonRDS gp3 entry", got)
+			}
+			if !strings.Contains(logs.String(), "PRICING INCONSISTENCY") {
+				t.Errorf("logs missing inconsistency warning:\n%s", logs.String())
+			}
+			// The inconsistency record does not replace the file's provenance
+			if version, _, ok := client.PricingMetadata("AmazonRDS"); !ok || version != "20260101000000" {
+				t.Errorf("PricingMetadata(AmazonRDS) = (%q, %v), want version kept", version, ok)
+			}
+		})
+	}
+}
+
+// TestNewClient_NoPriceInconsistencies verifies the embedded data of this build passes
+// the cross-service sanity checks.
+func TestNewClient_NoPriceInconsistencies(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if found := client.PriceInconsistencies(); len(found) != 0 {
+		t.Errorf("PriceInconsistencies() = %v, want none", found)
+	}
+}
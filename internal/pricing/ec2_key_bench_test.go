@@ -0,0 +1,50 @@
+package pricing
+
+import (
+	"fmt"
+	"testing"
+)
+
+// ec2KeyCases are the lookups the benchmarks build keys for.
+var ec2KeyCases = [][3]string{
+	{"t3.micro", "Linux", "Shared"},
+	{"m5.large", "Windows", "Shared"},
+	{"r6g.2xlarge", "Linux", "Dedicated"},
+	{"c7i.48xlarge", "RHEL", "Host"},
+}
+
+// TestEC2Key verifies ec2Key produces the same key format the index has always used.
+func TestEC2Key(t *testing.T) {
+	for _, c := range ec2KeyCases {
+		want := fmt.Sprintf("%s/%s/%s", c[0], c[1], c[2])
+		if got := ec2Key(c[0], c[1], c[2]); got != want {
+			t.Errorf("ec2Key(%q, %q, %q) = %q, want %q", c[0], c[1], c[2], got, want)
+		}
+	}
+}
+
+var ec2KeySink string
+
+// BenchmarkEC2Key_Sprintf measures the previous fmt.Sprintf key construction used by
+// EC2OnDemandPricePerHour, as the baseline for BenchmarkEC2Key.
+//
+// Run with: go test -bench=BenchmarkEC2Key -benchmem ./internal/pricing/...
+func BenchmarkEC2Key_Sprintf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := ec2KeyCases[i%len(ec2KeyCases)]
+		ec2KeySink = fmt.Sprintf("%s/%s/%s", c[0], c[1], c[2])
+	}
+}
+
+// BenchmarkEC2Key measures ec2Key, which should show fewer allocations and lower
+// ns/op than BenchmarkEC2Key_Sprintf.
+//
+// Run with: go test -bench=BenchmarkEC2Key -benchmem ./internal/pricing/...
+func BenchmarkEC2Key(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := ec2KeyCases[i%len(ec2KeyCases)]
+		ec2KeySink = ec2Key(c[0], c[1], c[2])
+	}
+}
@@ -7,6 +7,10 @@ import _ "embed"
 // Per-service pricing data for ap-southeast-2.
 // Each file contains raw AWS Price List API response with preserved metadata.
 
+// embeddedRegion is the region this data was generated for. It is used when the
+// region cannot be detected from the pricing products themselves.
+const embeddedRegion = "ap-southeast-2"
+
 //go:embed data/ec2_ap-southeast-2.json
 var rawEC2JSON []byte
 
@@ -36,3 +40,27 @@ var rawCloudWatchJSON []byte
 
 //go:embed data/elasticache_ap-southeast-2.json
 var rawElastiCacheJSON []byte
+
+//go:embed data/ecr_ap-southeast-2.json
+var rawECRJSON []byte
+
+//go:embed data/secretsmanager_ap-southeast-2.json
+var rawSecretsManagerJSON []byte
+
+//go:embed data/kms_ap-southeast-2.json
+var rawKMSJSON []byte
+
+//go:embed data/waf_ap-southeast-2.json
+var rawWAFJSON []byte
+
+//go:embed data/athena_ap-southeast-2.json
+var rawAthenaJSON []byte
+
+//go:embed data/glue_ap-southeast-2.json
+var rawGlueJSON []byte
+
+//go:embed data/ses_ap-southeast-2.json
+var rawSESJSON []byte
+
+//go:embed data/stepfunctions_ap-southeast-2.json
+var rawStepFunctionsJSON []byte
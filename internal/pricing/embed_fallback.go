@@ -6,6 +6,10 @@ package pricing
 // Used when no region-specific build tag is provided.
 // The format matches the AWS Price List API structure to ensure the client can parse it.
 
+// embeddedRegion is empty: fallback data is not tied to a region, so the client
+// reports "unknown".
+const embeddedRegion = ""
+
 // rawEC2JSON contains minimal EC2 pricing data for development/testing.
 var rawEC2JSON = []byte(`{
   "formatVersion": "v1.0",
@@ -156,7 +160,9 @@ var rawEKSJSON = []byte(`{
       "productFamily": "Compute",
       "attributes": {
         "servicecode": "AmazonEKS",
-        "regionCode": "unknown"
+        "regionCode": "unknown",
+        "operation": "CreateOperation",
+        "usagetype": "AmazonEKS-Hours:perCluster"
       }
     }
   },
@@ -493,3 +499,91 @@ var rawElastiCacheJSON = []byte(`{
     }
   }
 }`)
+
+// rawECRJSON contains minimal ECR pricing data for development/testing.
+var rawECRJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonECR",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawSecretsManagerJSON contains minimal Secrets Manager pricing data for development/testing.
+var rawSecretsManagerJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSSecretsManager",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawKMSJSON contains minimal KMS pricing data for development/testing.
+var rawKMSJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "awskms",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawWAFJSON contains minimal WAF pricing data for development/testing.
+var rawWAFJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSWAF",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawAthenaJSON contains minimal Athena pricing data for development/testing.
+var rawAthenaJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonAthena",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawGlueJSON contains minimal Glue pricing data for development/testing.
+var rawGlueJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSGlue",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawSESJSON contains minimal SES pricing data for development/testing.
+var rawSESJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonSES",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
+
+// rawStepFunctionsJSON contains minimal Step Functions pricing data for development/testing.
+var rawStepFunctionsJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonStates",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {},
+  "terms": {"OnDemand": {}}
+}`)
@@ -7,6 +7,10 @@ import _ "embed"
 // Per-service pricing data for us-gov-east-1.
 // Each file contains raw AWS Price List API response with preserved metadata.
 
+// embeddedRegion is the region this data was generated for. It is used when the
+// region cannot be detected from the pricing products themselves.
+const embeddedRegion = "us-gov-east-1"
+
 //go:embed data/ec2_us-gov-east-1.json
 var rawEC2JSON []byte
 
@@ -36,3 +40,27 @@ var rawCloudWatchJSON []byte
 
 //go:embed data/elasticache_us-gov-east-1.json
 var rawElastiCacheJSON []byte
+
+//go:embed data/ecr_us-gov-east-1.json
+var rawECRJSON []byte
+
+//go:embed data/secretsmanager_us-gov-east-1.json
+var rawSecretsManagerJSON []byte
+
+//go:embed data/kms_us-gov-east-1.json
+var rawKMSJSON []byte
+
+//go:embed data/waf_us-gov-east-1.json
+var rawWAFJSON []byte
+
+//go:embed data/athena_us-gov-east-1.json
+var rawAthenaJSON []byte
+
+//go:embed data/glue_us-gov-east-1.json
+var rawGlueJSON []byte
+
+//go:embed data/ses_us-gov-east-1.json
+var rawSESJSON []byte
+
+//go:embed data/stepfunctions_us-gov-east-1.json
+var rawStepFunctionsJSON []byte
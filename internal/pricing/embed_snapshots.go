@@ -0,0 +1,11 @@
+//go:build pricing_snapshots
+
+package pricing
+
+import "embed"
+
+// snapshotFS holds dated pricing snapshots for historical lookups. Build with the
+// pricing_snapshots tag after placing files in data/snapshots (see snapshotDir).
+//
+//go:embed data/snapshots
+var snapshotFS embed.FS
@@ -0,0 +1,9 @@
+//go:build !pricing_snapshots
+
+package pricing
+
+import "embed"
+
+// snapshotFS is empty without the pricing_snapshots build tag: only the current
+// pricing data is available.
+var snapshotFS embed.FS
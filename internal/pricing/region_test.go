@@ -0,0 +1,54 @@
+package pricing
+
+import "testing"
+
+// TestResolveRegion verifies the build-time region is only used for data that priced EC2
+// instances without a regionCode; stubbed data keeps the tolerant "unknown" region.
+func TestResolveRegion(t *testing.T) {
+	tests := []struct {
+		name          string
+		productRegion string
+		buildRegion   string
+		hasEC2Prices  bool
+		wantRegion    string
+		wantSource    string
+	}{
+		{
+			name:          "from products",
+			productRegion: "us-test-1",
+			buildRegion:   "us-east-1",
+			hasEC2Prices:  true,
+			wantRegion:    "us-test-1",
+			wantSource:    regionSourcePricingData,
+		},
+		{
+			name:         "missing regionCode uses build-time region",
+			buildRegion:  "us-east-1",
+			hasEC2Prices: true,
+			wantRegion:   "us-east-1",
+			wantSource:   regionSourceBuildConstant,
+		},
+		{
+			name:        "stubbed EC2 data in a regional build stays unknown",
+			buildRegion: "us-east-1",
+			wantRegion:  "unknown",
+			wantSource:  regionSourceNone,
+		},
+		{
+			name:         "fallback build",
+			hasEC2Prices: true,
+			wantRegion:   "unknown",
+			wantSource:   regionSourceNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			region, source := resolveRegion(tt.productRegion, tt.buildRegion, tt.hasEC2Prices)
+			if region != tt.wantRegion || source != tt.wantSource {
+				t.Errorf("resolveRegion() = (%q, %q), want (%q, %q)",
+					region, source, tt.wantRegion, tt.wantSource)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package pricing
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestClient_SchemaDrift verifies that renamed product families are logged and recorded
+// instead of silently emptying the index.
+func TestClient_SchemaDrift(t *testing.T) {
+	data := newSnapshotPricing()
+	data.EC2 = []byte(`{
+		"offerCode": "AmazonEC2",
+		"version": "20260101000000",
+		"products": {
+			"SKU_M5": {"sku": "SKU_M5", "productFamily": "Compute Instances (Renamed)",
+				"attributes": {"instanceType": "m5.large", "operatingSystem": "Linux", "tenancy": "Shared",
+					"capacitystatus": "Used", "preInstalledSw": "NA"}},
+			"SKU_GP3": {"sku": "SKU_GP3", "productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_M5": {"SKU_M5.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.096"}}}}},
+			"SKU_GP3": {"SKU_GP3.OD": {"priceDimensions": {"R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`)
+	data.ELB = []byte(`{
+		"offerCode": "AWSELB",
+		"products": {
+			"SKU_ALB": {"sku": "SKU_ALB", "productFamily": "Load Balancer - Application",
+				"attributes": {"usagetype": "USE1-LoadBalancerUsage"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_ALB": {"SKU_ALB.OD": {"priceDimensions": {"R": {"unit": "Hrs", "pricePerUnit": {"USD": "0.0225"}}}}}
+		}}
+	}`)
+
+	// The services are parsed concurrently, so the log buffer must be synchronized.
+	// Without EC2 prices the client keeps the "unknown" region under any region tag.
+	var logs bytes.Buffer
+	client := &Client{logger: zerolog.New(zerolog.SyncWriter(&logs)), data: data}
+
+	want := []string{
+		"AWSELB/Load Balancer-Application",
+		"AWSELB/Load Balancer-Network",
+		"AmazonEC2/Compute Instance",
+	}
+	got := client.SchemaDrift()
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("SchemaDrift() = %v, want %v", got, want)
+	}
+
+	output := logs.String()
+	for _, family := range []string{"Compute Instance", "Load Balancer-Application"} {
+		if !strings.Contains(output, "PRICING SCHEMA DRIFT") || !strings.Contains(output, `"product_family":"`+family+`"`) {
+			t.Errorf("logs missing schema drift warning for %q:\n%s", family, output)
+		}
+	}
+	if strings.Contains(output, `"product_family":"Storage"`) {
+		t.Errorf("logs report drift for Storage, which still indexed entries:\n%s", output)
+	}
+
+	// Metadata for the parsed file is kept alongside the drift record, and a drift
+	// record alone does not count as provenance
+	if version, _, ok := client.PricingMetadata("AmazonEC2"); !ok || version != "20260101000000" {
+		t.Errorf("PricingMetadata(AmazonEC2) = (%q, %v), want version kept", version, ok)
+	}
+	if _, _, ok := client.PricingMetadata("AWSELB"); ok {
+		t.Error("PricingMetadata(AWSELB) found, want not found for a file without a version")
+	}
+}
+
+// TestClient_SchemaDrift_EmptyFiles verifies empty stub files are not reported as drift.
+func TestClient_SchemaDrift_EmptyFiles(t *testing.T) {
+	client := &Client{logger: zerolog.Nop(), data: newSnapshotPricing()}
+	if drift := client.SchemaDrift(); len(drift) != 0 {
+		t.Errorf("SchemaDrift() = %v, want none for empty files", drift)
+	}
+}
+
+// TestNewClient_NoSchemaDrift verifies the embedded data of this build has every
+// product family the parsers expect.
+func TestNewClient_NoSchemaDrift(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+	if drift := client.SchemaDrift(); len(drift) != 0 {
+		t.Errorf("SchemaDrift() = %v, want none", drift)
+	}
+}
+
+// TestClient_SchemaDrift_UsageTypes verifies parsers keyed on usage types report the
+// usage type that indexed nothing, and not the ones that still matched.
+func TestClient_SchemaDrift_UsageTypes(t *testing.T) {
+	data := newSnapshotPricing()
+	data.KMS = []byte(`{
+		"offerCode": "awskms",
+		"products": {
+			"SKU_KEY": {"sku": "SKU_KEY", "productFamily": "Encryption Key",
+				"attributes": {"usagetype": "USE1-KMS-Keys"}},
+			"SKU_REQ": {"sku": "SKU_REQ", "productFamily": "Encryption Key",
+				"attributes": {"usagetype": "USE1-KMS-Requests-Renamed"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_KEY": {"SKU_KEY.OD": {"priceDimensions": {"R": {"unit": "Keys", "pricePerUnit": {"USD": "1.00"}}}}},
+			"SKU_REQ": {"SKU_REQ.OD": {"priceDimensions": {"R": {"unit": "Requests", "pricePerUnit": {"USD": "0.000003"}}}}}
+		}}
+	}`)
+	data.Glue = []byte(`{
+		"offerCode": "AWSGlue",
+		"products": {
+			"SKU_CRAWLER": {"sku": "SKU_CRAWLER", "productFamily": "AWS Glue",
+				"attributes": {"usagetype": "USE1-Crawler-DPU-Hour"}}
+		},
+		"terms": {"OnDemand": {
+			"SKU_CRAWLER": {"SKU_CRAWLER.OD": {"priceDimensions": {"R": {"unit": "DPU-Hour", "pricePerUnit": {"USD": "0.44"}}}}}
+		}}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), data: data}
+	want := []string{"AWSGlue/ETL-DPU-Hour", "awskms/KMS-Requests"}
+	if got := client.SchemaDrift(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("SchemaDrift() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,67 @@
+package pricing
+
+import (
+	"reflect"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestClient_SKULists verifies the SKU listings are non-empty, sorted, and distinct for
+// the embedded data of this build.
+func TestClient_SKULists(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	lists := map[string][]string{
+		"EC2InstanceTypes":     client.EC2InstanceTypes(),
+		"EBSVolumeTypes":       client.EBSVolumeTypes(),
+		"ElastiCacheNodeTypes": client.ElastiCacheNodeTypes(),
+	}
+	for name, list := range lists {
+		if len(list) == 0 {
+			t.Errorf("%s() is empty", name)
+			continue
+		}
+		if !sort.StringsAreSorted(list) {
+			t.Errorf("%s() = %v, want sorted", name, list)
+		}
+		if len(slices.Compact(slices.Clone(list))) != len(list) {
+			t.Errorf("%s() = %v, want distinct entries", name, list)
+		}
+	}
+
+	if !slices.Contains(lists["EBSVolumeTypes"], "gp3") {
+		t.Errorf("EBSVolumeTypes() = %v, want gp3", lists["EBSVolumeTypes"])
+	}
+}
+
+// TestIndexKeys verifies composite index keys are cut at the separator and deduplicated.
+func TestIndexKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		index map[string]int
+		sep   string
+		want  []string
+	}{
+		{"plain keys", map[string]int{"gp3": 1, "gp2": 2, "io2": 3}, "", []string{"gp2", "gp3", "io2"}},
+		{
+			"composite keys",
+			map[string]int{"db.t3.micro/mysql": 1, "db.t3.micro/postgres": 2, "db.m5.large/mysql": 3},
+			"/",
+			[]string{"db.m5.large", "db.t3.micro"},
+		},
+		{"empty index", nil, ":", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := indexKeys(tt.index, tt.sep); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("indexKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package pricing
+
+import (
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+)
+
+// LatestVintage selects the current embedded pricing data rather than a dated snapshot.
+const LatestVintage = "latest"
+
+// snapshotDir is the directory, relative to the package, holding dated pricing snapshots.
+// Files are named <service>_<region>_<YYYY-MM>.json, e.g., ec2_us-east-1_2024-01.json,
+// where <service> is the same short name used by the current data files.
+const snapshotDir = "data/snapshots"
+
+// snapshotFileRE splits a snapshot file name into service, region and vintage.
+var snapshotFileRE = regexp.MustCompile(`^([a-z0-9]+)_([a-z0-9-]+)_(\d{4}-\d{2})\.json$`)
+
+// emptyPricingJSON stands in for services a snapshot does not include, so their
+// lookups report not found rather than failing initialization.
+var emptyPricingJSON = []byte(`{}`)
+
+// rawPricingData holds one vintage of raw AWS Price List files, one per service.
+type rawPricingData struct {
+	EC2            []byte
+	S3             []byte
+	RDS            []byte
+	EKS            []byte
+	Lambda         []byte
+	DynamoDB       []byte
+	ELB            []byte
+	VPC            []byte
+	CloudWatch     []byte
+	ElastiCache    []byte
+	ECR            []byte
+	SecretsManager []byte
+	KMS            []byte
+	WAF            []byte
+	Athena         []byte
+	Glue           []byte
+	SES            []byte
+	StepFunctions  []byte
+}
+
+// embeddedRawPricing returns the current pricing data embedded for the build's region.
+func embeddedRawPricing() *rawPricingData {
+	return &rawPricingData{
+		EC2:            rawEC2JSON,
+		S3:             rawS3JSON,
+		RDS:            rawRDSJSON,
+		EKS:            rawEKSJSON,
+		Lambda:         rawLambdaJSON,
+		DynamoDB:       rawDynamoDBJSON,
+		ELB:            rawELBJSON,
+		VPC:            rawVPCJSON,
+		CloudWatch:     rawCloudWatchJSON,
+		ElastiCache:    rawElastiCacheJSON,
+		ECR:            rawECRJSON,
+		SecretsManager: rawSecretsManagerJSON,
+		KMS:            rawKMSJSON,
+		WAF:            rawWAFJSON,
+		Athena:         rawAthenaJSON,
+		Glue:           rawGlueJSON,
+		SES:            rawSESJSON,
+		StepFunctions:  rawStepFunctionsJSON,
+	}
+}
+
+// newSnapshotPricing returns rawPricingData with every service empty.
+func newSnapshotPricing() *rawPricingData {
+	return &rawPricingData{
+		EC2:            emptyPricingJSON,
+		S3:             emptyPricingJSON,
+		RDS:            emptyPricingJSON,
+		EKS:            emptyPricingJSON,
+		Lambda:         emptyPricingJSON,
+		DynamoDB:       emptyPricingJSON,
+		ELB:            emptyPricingJSON,
+		VPC:            emptyPricingJSON,
+		CloudWatch:     emptyPricingJSON,
+		ElastiCache:    emptyPricingJSON,
+		ECR:            emptyPricingJSON,
+		SecretsManager: emptyPricingJSON,
+		KMS:            emptyPricingJSON,
+		WAF:            emptyPricingJSON,
+		Athena:         emptyPricingJSON,
+		Glue:           emptyPricingJSON,
+		SES:            emptyPricingJSON,
+		StepFunctions:  emptyPricingJSON,
+	}
+}
+
+// set stores data for the service named by its data file prefix (e.g., "ec2", "vpc").
+// It returns false for unknown services.
+func (d *rawPricingData) set(service string, data []byte) bool {
+	switch service {
+	case "ec2":
+		d.EC2 = data
+	case "s3":
+		d.S3 = data
+	case "rds":
+		d.RDS = data
+	case "eks":
+		d.EKS = data
+	case "lambda":
+		d.Lambda = data
+	case "dynamodb":
+		d.DynamoDB = data
+	case "elb":
+		d.ELB = data
+	case "vpc":
+		d.VPC = data
+	case "cloudwatch":
+		d.CloudWatch = data
+	case "elasticache":
+		d.ElastiCache = data
+	case "ecr":
+		d.ECR = data
+	case "secretsmanager":
+		d.SecretsManager = data
+	case "kms":
+		d.KMS = data
+	case "waf":
+		d.WAF = data
+	case "athena":
+		d.Athena = data
+	case "glue":
+		d.Glue = data
+	case "ses":
+		d.SES = data
+	case "stepfunctions":
+		d.StepFunctions = data
+	default:
+		return false
+	}
+	return true
+}
+
+// rawData returns the data init should parse.
+func (c *Client) rawData() *rawPricingData {
+	if c.data != nil {
+		return c.data
+	}
+	return embeddedRawPricing()
+}
+
+// loadSnapshots indexes the snapshot files for this client's region by vintage.
+// Unreadable files and unknown services are logged and skipped.
+func (c *Client) loadSnapshots() {
+	c.snapshotsOnce.Do(func() {
+		c.snapshots = make(map[string]*rawPricingData)
+		if c.snapshotFS == nil {
+			return
+		}
+
+		files, err := fs.Glob(c.snapshotFS, path.Join(snapshotDir, "*.json"))
+		if err != nil {
+			c.logger.Warn().Err(err).Msg("failed to list pricing snapshots")
+			return
+		}
+
+		region := c.Region()
+		for _, file := range files {
+			m := snapshotFileRE.FindStringSubmatch(path.Base(file))
+			if m == nil || m[2] != region {
+				continue
+			}
+			service, vintage := m[1], m[3]
+
+			data, err := fs.ReadFile(c.snapshotFS, file)
+			if err != nil {
+				c.logger.Warn().Err(err).Str("file", file).Msg("failed to read pricing snapshot")
+				continue
+			}
+
+			snapshot, ok := c.snapshots[vintage]
+			if !ok {
+				snapshot = newSnapshotPricing()
+			}
+			if !snapshot.set(service, data) {
+				c.logger.Warn().Str("file", file).Str("service", service).Msg("unknown service in pricing snapshot")
+				continue
+			}
+			c.snapshots[vintage] = snapshot
+		}
+	})
+}
+
+// Vintages returns the dated pricing snapshots embedded for this region, oldest first.
+func (c *Client) Vintages() []string {
+	c.loadSnapshots()
+
+	vintages := make([]string, 0, len(c.snapshots))
+	for vintage := range c.snapshots {
+		vintages = append(vintages, vintage)
+	}
+	sort.Strings(vintages)
+	return vintages
+}
+
+// ForVintage returns a Client for the snapshot vintage, parsing it on first use.
+// An empty vintage or LatestVintage returns c itself.
+func (c *Client) ForVintage(vintage string) (PricingClient, bool) {
+	if vintage == "" || vintage == LatestVintage {
+		return c, true
+	}
+
+	c.loadSnapshots()
+	data, ok := c.snapshots[vintage]
+	if !ok {
+		return nil, false
+	}
+
+	c.vintageMu.Lock()
+	defer c.vintageMu.Unlock()
+	if vc, ok := c.vintageClients[vintage]; ok {
+		return vc, true
+	}
+
+	vc := &Client{
+		logger:              c.logger.With().Str("pricing_vintage", vintage).Logger(),
+		slowLookupThreshold: c.slowLookupThreshold,
+		data:                data,
+	}
+	if err := vc.init(); err != nil {
+		c.logger.Error().Err(err).Str("pricing_vintage", vintage).Msg("failed to load pricing snapshot")
+		return nil, false
+	}
+	if c.vintageClients == nil {
+		c.vintageClients = make(map[string]*Client)
+	}
+	c.vintageClients[vintage] = vc
+	return vc, true
+}
@@ -0,0 +1,94 @@
+package pricing
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/rs/zerolog"
+)
+
+// snapshotEC2JSON returns minimal EC2 pricing for one t3.micro and gp3 volume in region.
+func snapshotEC2JSON(region, version, t3MicroRate string) []byte {
+	return []byte(fmt.Sprintf(`{
+		"offerCode": "AmazonEC2",
+		"version": %q,
+		"products": {
+			"SKU_T3MICRO": {
+				"sku": "SKU_T3MICRO",
+				"productFamily": "Compute Instance",
+				"attributes": {"instanceType": "t3.micro", "operatingSystem": "Linux", "tenancy": "Shared",
+					"regionCode": %q, "capacitystatus": "Used", "preInstalledSw": "NA"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Storage",
+				"attributes": {"volumeApiName": "gp3", "regionCode": %q}
+			}
+		},
+		"terms": {"OnDemand": {
+			"SKU_T3MICRO": {"SKU_T3MICRO.T": {"priceDimensions": {"SKU_T3MICRO.T.R": {"unit": "Hrs", "pricePerUnit": {"USD": %q}}}}},
+			"SKU_GP3": {"SKU_GP3.T": {"priceDimensions": {"SKU_GP3.T.R": {"unit": "GB-Mo", "pricePerUnit": {"USD": "0.08"}}}}}
+		}}
+	}`, version, region, region, t3MicroRate))
+}
+
+// TestClient_ForVintage verifies snapshots are indexed per vintage for the client's region
+// and that lookups route to the selected vintage.
+func TestClient_ForVintage(t *testing.T) {
+	current := newSnapshotPricing()
+	current.EC2 = snapshotEC2JSON("us-test-1", "current", "0.0104")
+
+	client := &Client{
+		logger: zerolog.Nop(),
+		data:   current,
+		snapshotFS: fstest.MapFS{
+			"data/snapshots/ec2_us-test-1_2024-01.json": {Data: snapshotEC2JSON("us-test-1", "2024-01", "0.0094")},
+			"data/snapshots/s3_us-test-1_2023-07.json":  {Data: []byte(`{}`)},
+			"data/snapshots/ec2_eu-test-1_2022-01.json": {Data: snapshotEC2JSON("eu-test-1", "2022-01", "0.02")},
+			"data/snapshots/foo_us-test-1_2021-01.json": {Data: []byte(`{}`)},
+			"data/snapshots/README.md":                  {Data: []byte("not a snapshot")},
+		},
+	}
+
+	if got, want := client.Vintages(), []string{"2023-07", "2024-01"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Vintages() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		vintage   string
+		wantFound bool
+		wantRate  float64
+		wantPrice bool
+	}{
+		{vintage: "", wantFound: true, wantRate: 0.0104, wantPrice: true},
+		{vintage: LatestVintage, wantFound: true, wantRate: 0.0104, wantPrice: true},
+		{vintage: "2024-01", wantFound: true, wantRate: 0.0094, wantPrice: true},
+		{vintage: "2023-07", wantFound: true}, // snapshot without EC2 data
+		{vintage: "2022-01"},                  // other region
+		{vintage: "2021-01"},                  // unknown service only
+	}
+
+	for _, tt := range tests {
+		t.Run("vintage "+tt.vintage, func(t *testing.T) {
+			vc, found := client.ForVintage(tt.vintage)
+			if found != tt.wantFound {
+				t.Fatalf("ForVintage(%q) found = %v, want %v", tt.vintage, found, tt.wantFound)
+			}
+			if !found {
+				return
+			}
+			rate, ok := vc.EC2OnDemandPricePerHour("t3.micro", "Linux", "Shared")
+			if ok != tt.wantPrice || rate != tt.wantRate {
+				t.Errorf("t3.micro rate = (%v, %v), want (%v, %v)", rate, ok, tt.wantRate, tt.wantPrice)
+			}
+		})
+	}
+
+	first, _ := client.ForVintage("2024-01")
+	second, _ := client.ForVintage("2024-01")
+	if first != second {
+		t.Error("ForVintage() should reuse the parsed snapshot client")
+	}
+}
@@ -27,6 +27,7 @@ type term struct {
 	Sku             string                    `json:"sku"`
 	EffectiveDate   string                    `json:"effectiveDate"`
 	PriceDimensions map[string]priceDimension `json:"priceDimensions"`
+	TermAttributes  map[string]string         `json:"termAttributes"` // Reserved only: LeaseContractLength, PurchaseOption, OfferingClass
 }
 
 // priceDimension represents a specific pricing dimension within a term.
@@ -57,6 +58,15 @@ type ebsPrice struct {
 	Currency       string
 }
 
+// ebsProvisionedPrice represents the monthly cost per provisioned unit for EBS
+// performance add-ons (IOPS-month or MiB/s-month). Distilled from the EC2 pricing
+// file's "System Operation" and "Provisioned Throughput" product families.
+type ebsProvisionedPrice struct {
+	Unit             string
+	RatePerUnitMonth float64
+	Currency         string
+}
+
 // s3Price represents the per-GB-month storage cost for S3 buckets.
 // Distilled from raw AWS pricing JSON for fast lookups.
 type s3Price struct {
@@ -79,6 +89,13 @@ type rdsStoragePrice struct {
 	Currency       string
 }
 
+// rdsIOPrice represents the per-request cost of Aurora Standard storage I/O
+type rdsIOPrice struct {
+	Unit           string
+	RatePerRequest float64
+	Currency       string
+}
+
 // eksPrice represents the hourly cost for EKS cluster control plane.
 // EKS offers two support tiers with different pricing:
 //   - Standard support: ~$0.10/cluster-hour
@@ -87,6 +104,8 @@ type eksPrice struct {
 	Unit               string
 	StandardHourlyRate float64 // Standard support hourly rate
 	ExtendedHourlyRate float64 // Extended support hourly rate
+	FargateVCPURate    float64 // Fargate pod rate per vCPU-hour
+	FargateGBRate      float64 // Fargate pod rate per GB-hour of memory
 	Currency           string
 }
 
@@ -171,8 +190,138 @@ type NATGatewayPrice struct {
 	Currency string
 }
 
+// ecrPrice represents the regional pricing for Amazon ECR.
+// Derived from AWS Pricing API for service AmazonECR.
+type ecrPrice struct {
+	// StorageRate is the cost per GB-month of stored image data.
+	// Source: usageType containing "TimedStorage-ByteHrs"
+	StorageRate float64
+
+	// DataTransferOutRate is the first paid tier rate per GB transferred to the internet.
+	// Source: Product Family "Data Transfer", usageType containing "DataTransfer-Out-Bytes"
+	DataTransferOutRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// secretsManagerPrice represents the regional pricing for AWS Secrets Manager.
+// Derived from AWS Pricing API for service AWSSecretsManager.
+type secretsManagerPrice struct {
+	// SecretMonthlyRate is the cost per secret per month.
+	// Source: usageType containing "AWSSecretsManager-Secrets"
+	SecretMonthlyRate float64
+
+	// APICallRate is the cost per API call (AWS publishes it per 10,000 calls).
+	// Source: usageType containing "AWSSecretsManager-APIRequest"
+	APICallRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// kmsPrice represents the regional pricing for AWS KMS customer managed keys.
+// Derived from AWS Pricing API for service awskms.
+type kmsPrice struct {
+	// KeyMonthlyRate is the cost per customer managed key per month.
+	// Source: usageType ending in "KMS-Keys"
+	KeyMonthlyRate float64
+
+	// RequestRate is the first paid tier rate per symmetric request, after the free tier.
+	// Source: usageType ending in "KMS-Requests"
+	RequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// wafPrice represents the regional pricing for AWS WAF web ACLs.
+// Derived from AWS Pricing API for service AWSWAF.
+type wafPrice struct {
+	// WebACLMonthlyRate is the cost per web ACL per month.
+	// Source: usageType "WebACL" (after the region prefix)
+	WebACLMonthlyRate float64
+
+	// RuleMonthlyRate is the cost per rule per web ACL per month.
+	// Source: usageType "Rule" (after the region prefix)
+	RuleMonthlyRate float64
+
+	// RequestRate is the cost per inspected web request (AWS publishes it per million).
+	// Source: usageType "Request" (after the region prefix)
+	RequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// athenaPrice represents the regional pricing for Amazon Athena SQL queries.
+// Derived from AWS Pricing API for service AmazonAthena.
+type athenaPrice struct {
+	// DataScannedTBRate is the cost per TB of data scanned by queries.
+	// Source: usageType "DataScannedInTB" (after the region prefix)
+	DataScannedTBRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// gluePrice represents the regional pricing for AWS Glue jobs and crawlers.
+// Derived from AWS Pricing API for service AWSGlue.
+type gluePrice struct {
+	// ETLDPUHourRate is the cost per DPU-hour for standard Spark and Python shell jobs.
+	// Source: usageType "ETL-DPU-Hour" (after the region prefix)
+	ETLDPUHourRate float64
+
+	// FlexDPUHourRate is the cost per DPU-hour for jobs using the Flex execution class.
+	// Source: usageType "ETL-Flex-DPU-Hour" (after the region prefix)
+	FlexDPUHourRate float64
+
+	// CrawlerDPUHourRate is the cost per DPU-hour for crawlers.
+	// Source: usageType "Crawler-DPU-Hour" (after the region prefix)
+	CrawlerDPUHourRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// sesPrice represents the regional pricing for Amazon SES outbound email.
+// Derived from AWS Pricing API for service AmazonSES.
+type sesPrice struct {
+	// EmailRate is the cost per outbound email recipient (AWS publishes it per thousand).
+	// Source: usageType ending in "Recipients"
+	EmailRate float64
+
+	// AttachmentGBRate is the cost per GB of attachments sent.
+	// Source: usageType ending in "AttachmentsSize-Bytes"
+	AttachmentGBRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// stepFunctionsPrice represents the regional pricing for AWS Step Functions workflows.
+// Derived from AWS Pricing API for service AmazonStates.
+type stepFunctionsPrice struct {
+	// StateTransitionRate is the cost per Standard workflow state transition
+	// (AWS publishes it per thousand).
+	// Source: usageType ending in "StateTransition"
+	StateTransitionRate float64
+
+	// ExpressRequestRate is the cost per Express workflow execution.
+	// Source: usageType containing "Express" and ending in "Requests"
+	ExpressRequestRate float64
+
+	// ExpressGBSecondRate is the first-tier cost per GB-second of Express workflow duration.
+	// Source: usageType containing "Express" and ending in "GB-Second"
+	ExpressGBSecondRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
 // pricingMetadata holds AWS pricing data metadata for debugging and traceability (T034).
-// Captured from the embedded pricing JSON during initialization.
+// Captured from the embedded pricing JSON during initialization, one per service,
+// and exposed via Client.PricingMetadata for response provenance.
 type pricingMetadata struct {
 	// Version is the AWS pricing data version (timestamp-based, e.g., "20251218235654").
 	Version string
@@ -180,12 +329,19 @@ type pricingMetadata struct {
 	PublicationDate string
 	// OfferCode identifies the AWS service (e.g., "AmazonEC2", "AWSELB").
 	OfferCode string
+	// MissingFamilies lists expected product families that indexed no entries,
+	// a sign AWS renamed them (schema drift).
+	MissingFamilies []string
+	// Inconsistencies describes rates that failed a cross-service sanity check,
+	// a sign one of the parsers picked the wrong product.
+	Inconsistencies []string
 }
 
 // TierRate represents a single tier in AWS's tiered pricing structure.
 // Used for services with volume-based pricing like CloudWatch logs and metrics.
 type TierRate struct {
-	// UpTo is the upper bound of this tier in GB (for logs) or count (for metrics).
+	// UpTo is the upper bound of this tier in GB (for logs), count (for metrics) or
+	// provisioned IOPS (for io2 volumes).
 	// Use math.MaxFloat64 for the final tier with no upper bound.
 	UpTo float64
 	// Rate is the price per unit ($/GB for logs, $/metric for metrics).
@@ -87,13 +87,13 @@ func TestCarbonEstimation_EC2_WithGPU(t *testing.T) {
 
 			// Verify GPU breakdown if applicable
 			if tt.hasGPU {
-				cpuCarbon, gpuCarbon, breakdownOK := estimator.EstimateCarbonGramsWithBreakdown(
+				cpuCarbon, gpuCarbon, storageCarbon, breakdownOK := estimator.EstimateCarbonGramsWithBreakdown(
 					tt.instanceType, tt.region, tt.utilization, tt.hours)
 				require.True(t, breakdownOK)
 				assert.Greater(t, gpuCarbon, 0.0, "GPU carbon should be positive for GPU instance")
 				assert.Greater(t, cpuCarbon, 0.0, "CPU carbon should be positive")
-				assert.InDelta(t, carbonGrams, cpuCarbon+gpuCarbon, 1.0,
-					"Total should equal CPU + GPU")
+				assert.InDelta(t, carbonGrams, cpuCarbon+gpuCarbon+storageCarbon, 1.0,
+					"Total should equal CPU + GPU + instance store")
 			}
 		})
 	}
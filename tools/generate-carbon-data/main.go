@@ -3,26 +3,32 @@
 //
 // The tool downloads the AWS instances CSV from the cloud-carbon-coefficients
 // repository and saves it to internal/carbon/data/ccf_instance_specs.csv for
-// embedding at build time.
+// embedding at build time. The CCF data has no usable memory column, so instance
+// memory is read from the "memory" attribute of the EC2 price list and saved to
+// internal/carbon/data/instance_memory.csv.
 //
 // Usage:
 //
-//	go run ./tools/generate-carbon-data [--out-dir DIR] [--validate]
+//	go run ./tools/generate-carbon-data [--out-dir DIR] [--validate] [--ec2-price-list FILE]
 //
 // Flags:
 //
-//	--out-dir   Output directory (default: ./internal/carbon/data)
-//	--validate  Validate the downloaded CSV has expected columns and row count
+//	--out-dir         Output directory (default: ./internal/carbon/data)
+//	--validate        Validate the downloaded CSV has expected columns and row count
+//	--ec2-price-list  Read instance memory from a local EC2 price list JSON instead of
+//	                  downloading the us-east-1 offer file
 package main
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +43,16 @@ const (
 	// outputFileName is the name of the generated CSV file.
 	outputFileName = "ccf_instance_specs.csv"
 
+	// ec2PriceListURL is the EC2 price list whose product attributes give instance memory.
+	// Instance sizes are the same in every region, so one region's offer file suffices.
+	ec2PriceListURL = "https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/AmazonEC2/current/us-east-1/index.json"
+
+	// memoryFileName is the name of the generated instance memory CSV file.
+	memoryFileName = "instance_memory.csv"
+
+	// expectedMinMemoryRows is the minimum number of instance types with memory expected.
+	expectedMinMemoryRows = 400
+
 	// expectedMinRows is the minimum number of instance types expected.
 	// CCF data contains 500+ instance types; fewer indicates a problem.
 	expectedMinRows = 400
@@ -54,6 +70,7 @@ const (
 func main() {
 	outDir := flag.String("out-dir", "./internal/carbon/data", "Output directory for the CSV file")
 	validate := flag.Bool("validate", true, "Validate the downloaded CSV has expected structure")
+	ec2PriceList := flag.String("ec2-price-list", "", "Local EC2 price list JSON to read instance memory from (default: download)")
 	flag.Parse()
 
 	fmt.Println("Fetching Cloud Carbon Footprint AWS instance specs...")
@@ -90,6 +107,12 @@ func main() {
 
 	fmt.Printf("Successfully wrote %s (%d bytes)\n", outPath, len(data))
 
+	// Write instance memory from the EC2 price list
+	if err := writeInstanceMemory(*outDir, *ec2PriceList, *validate); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing instance memory: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Write static GPU and storage specs
 	if err := writeStaticSpecs(*outDir); err != nil {
 		fmt.Fprintf(os.Stderr, "Error writing static specs: %v\n", err)
@@ -121,6 +144,132 @@ func fetchCCFData() ([]byte, error) {
 	return data, nil
 }
 
+// openEC2PriceList opens the local EC2 price list at path, or downloads the us-east-1
+// offer file when path is empty.
+func openEC2PriceList(path string) (io.ReadCloser, error) {
+	if path != "" {
+		return os.Open(path)
+	}
+
+	fmt.Printf("Fetching EC2 price list: %s\n", ec2PriceListURL)
+	// The offer file is large; allow well beyond the CCF download timeout
+	client := &http.Client{Timeout: 30 * time.Minute}
+	resp, err := client.Get(ec2PriceListURL)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// ec2Product is the subset of an EC2 price list product read for instance memory.
+type ec2Product struct {
+	ProductFamily string `json:"productFamily"`
+	Attributes    struct {
+		InstanceType string `json:"instanceType"`
+		Memory       string `json:"memory"`
+	} `json:"attributes"`
+}
+
+// readInstanceMemory streams the "products" object of an EC2 price list and returns the
+// memory in GiB of each instance type. Decoding stops after the products, so the much
+// larger "terms" section is never read.
+func readInstanceMemory(r io.Reader) (map[string]float64, error) {
+	dec := json.NewDecoder(r)
+	if _, err := dec.Token(); err != nil { // opening brace
+		return nil, fmt.Errorf("read price list: %w", err)
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("read price list: %w", err)
+		}
+		if key != "products" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, fmt.Errorf("read price list %v: %w", key, err)
+			}
+			continue
+		}
+
+		if _, err := dec.Token(); err != nil { // opening brace of products
+			return nil, fmt.Errorf("read products: %w", err)
+		}
+		memory := make(map[string]float64)
+		for dec.More() {
+			if _, err := dec.Token(); err != nil { // SKU
+				return nil, fmt.Errorf("read products: %w", err)
+			}
+			var product ec2Product
+			if err := dec.Decode(&product); err != nil {
+				return nil, fmt.Errorf("read product: %w", err)
+			}
+			if !strings.HasPrefix(product.ProductFamily, "Compute Instance") || product.Attributes.InstanceType == "" {
+				continue
+			}
+			if gib, ok := parseMemoryGiB(product.Attributes.Memory); ok {
+				memory[product.Attributes.InstanceType] = gib
+			}
+		}
+		return memory, nil
+	}
+	return nil, fmt.Errorf("price list has no products")
+}
+
+// parseMemoryGiB parses a price list memory attribute such as "8 GiB" or "1,024 GiB".
+func parseMemoryGiB(s string) (float64, bool) {
+	value, ok := strings.CutSuffix(strings.TrimSpace(s), " GiB")
+	if !ok {
+		return 0, false
+	}
+	gib, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+	if err != nil || gib <= 0 {
+		return 0, false
+	}
+	return gib, true
+}
+
+// writeInstanceMemory reads instance memory from the EC2 price list and writes it to
+// outDir as an instance_type,memory_gib CSV sorted by instance type.
+func writeInstanceMemory(outDir, priceListPath string, validate bool) error {
+	body, err := openEC2PriceList(priceListPath)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = body.Close() }()
+
+	memory, err := readInstanceMemory(body)
+	if err != nil {
+		return err
+	}
+	if validate && len(memory) < expectedMinMemoryRows {
+		return fmt.Errorf("only %d instance types with memory found, expected at least %d", len(memory), expectedMinMemoryRows)
+	}
+
+	instanceTypes := make([]string, 0, len(memory))
+	for instanceType := range memory {
+		instanceTypes = append(instanceTypes, instanceType)
+	}
+	sort.Strings(instanceTypes)
+
+	var b strings.Builder
+	b.WriteString("instance_type,memory_gib\n")
+	for _, instanceType := range instanceTypes {
+		fmt.Fprintf(&b, "%s,%s\n", instanceType, strconv.FormatFloat(memory[instanceType], 'f', -1, 64))
+	}
+
+	memoryPath := filepath.Join(outDir, memoryFileName)
+	if err := os.WriteFile(memoryPath, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write instance memory: %w", err)
+	}
+	fmt.Printf("Successfully wrote %s (%d instance types)\n", memoryPath, len(memory))
+	return nil
+}
+
 // validateCSV checks that the CSV has the expected structure and content.
 func validateCSV(data []byte) error {
 	reader := csv.NewReader(strings.NewReader(string(data)))
@@ -234,6 +383,7 @@ inf2.48xlarge,Inferentia2,12,175
 trn1.2xlarge,Trainium,1,175
 trn1.32xlarge,Trainium,16,175
 trn1n.32xlarge,Trainium,16,175
+trn2.48xlarge,Trainium2,16,500
 `
 
 // storageSpecsCSV is the static storage specifications data.
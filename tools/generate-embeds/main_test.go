@@ -34,10 +34,11 @@ func TestGenerateEmbedFile(t *testing.T) {
 				Tag:  "region_use1",
 			},
 			wantFile: "embed_use1.go",
-			// All 10 services must be present to catch template/fallback sync issues
+			// All 18 services must be present to catch template/fallback sync issues
 			wantConts: []string{
 				"//go:build region_use1",
 				"package pricing",
+				`const embeddedRegion = "us-east-1"`,
 				"//go:embed data/ec2_us-east-1.json",
 				"var rawEC2JSON []byte",
 				"//go:embed data/s3_us-east-1.json",
@@ -58,6 +59,22 @@ func TestGenerateEmbedFile(t *testing.T) {
 				"var rawCloudWatchJSON []byte",
 				"//go:embed data/elasticache_us-east-1.json",
 				"var rawElastiCacheJSON []byte",
+				"//go:embed data/ecr_us-east-1.json",
+				"var rawECRJSON []byte",
+				"//go:embed data/secretsmanager_us-east-1.json",
+				"var rawSecretsManagerJSON []byte",
+				"//go:embed data/kms_us-east-1.json",
+				"var rawKMSJSON []byte",
+				"//go:embed data/waf_us-east-1.json",
+				"var rawWAFJSON []byte",
+				"//go:embed data/athena_us-east-1.json",
+				"var rawAthenaJSON []byte",
+				"//go:embed data/glue_us-east-1.json",
+				"var rawGlueJSON []byte",
+				"//go:embed data/ses_us-east-1.json",
+				"var rawSESJSON []byte",
+				"//go:embed data/stepfunctions_us-east-1.json",
+				"var rawStepFunctionsJSON []byte",
 			},
 		},
 		{
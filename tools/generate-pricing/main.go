@@ -26,6 +26,14 @@ var serviceConfig = map[string]string{
 	"AmazonVPC":         "vpc",
 	"AmazonCloudWatch":  "cloudwatch",
 	"AmazonElastiCache": "elasticache",
+	"AmazonECR":         "ecr",
+	"AWSSecretsManager": "secretsmanager",
+	"awskms":            "kms",
+	"AWSWAF":            "waf",
+	"AmazonAthena":      "athena",
+	"AWSGlue":           "glue",
+	"AmazonSES":         "ses",
+	"AmazonStates":      "stepfunctions",
 }
 
 // main is the program entry point that fetches AWS pricing data per service.
@@ -39,7 +47,7 @@ var serviceConfig = map[string]string{
 func main() {
 	regions := flag.String("regions", "us-east-1", "Comma-separated regions")
 	outDir := flag.String("out-dir", "./data", "Output directory")
-	service := flag.String("service", "AmazonEC2,AmazonS3,AWSLambda,AmazonRDS,AmazonEKS,AmazonDynamoDB,AWSELB,AmazonVPC,AmazonCloudWatch,AmazonElastiCache", "AWS Service Codes (comma-separated)")
+	service := flag.String("service", "AmazonEC2,AmazonS3,AWSLambda,AmazonRDS,AmazonEKS,AmazonDynamoDB,AWSELB,AmazonVPC,AmazonCloudWatch,AmazonElastiCache,AmazonECR,AWSSecretsManager,awskms,AWSWAF,AmazonAthena,AWSGlue,AmazonSES,AmazonStates", "AWS Service Codes (comma-separated)")
 	dummy := flag.Bool("dummy", false, "DEPRECATED: ignored, real data is always fetched")
 
 	flag.Parse()
@@ -136,8 +144,9 @@ type awsPricingResponse struct {
 }
 
 // fetchServicePricingRaw retrieves AWS pricing data for the specified service and region.
-// It filters out Reserved Instance and Savings Plans terms to reduce file size,
-// while preserving all products (including all OS values) and OnDemand terms.
+// It filters out Savings Plans and Reserved Instance terms to reduce file size
+// (keeping 1yr No Upfront Reserved terms for RDS), while preserving all products
+// (including all OS values) and OnDemand terms.
 //
 // region is the AWS region code (for example, "us-east-1").
 // service is the AWS service code (for example, "AmazonEC2", "AWSELB").
@@ -188,11 +197,13 @@ func fetchServicePricingRaw(region, service string) ([]byte, error) {
 		return nil, fmt.Errorf("no products in response for %s/%s", service, region)
 	}
 
-	// Filter terms: keep only OnDemand, remove Reserved and Savings Plans.
+	// Filter terms: keep OnDemand, remove Savings Plans and most Reserved terms.
 	// AWS Price List API returns multiple term types:
 	//
 	// KEPT:
 	//   - "OnDemand" - Pay-as-you-go pricing with no commitment (what we use)
+	//   - "Reserved" (AmazonRDS only) - narrowed to 1yr No Upfront standard terms,
+	//                  which back the RDS pricing_model=reserved-1yr tag.
 	//
 	// FILTERED OUT:
 	//   - "Reserved" - Reserved Instance pricing (1yr, 3yr upfront commitments)
@@ -202,12 +213,16 @@ func fetchServicePricingRaw(region, service string) ([]byte, error) {
 	//                     Flexible discount program that applies across services.
 	//
 	// Why filter? Reduces file size from ~400MB to ~154MB for EC2 alone.
-	// The plugin only supports on-demand pricing for v1.
 	filteredTerms := make(map[string]map[string]interface{})
 	for termType, skuTerms := range pricing.Terms {
-		if termType == "OnDemand" {
+		switch {
+		case termType == "OnDemand":
 			filteredTerms[termType] = skuTerms
-		} else {
+		case termType == "Reserved" && service == "AmazonRDS":
+			kept := filterReservedTerms(skuTerms)
+			fmt.Printf("  Keeping %d of %d Reserved SKUs (1yr No Upfront)\n", len(kept), len(skuTerms))
+			filteredTerms[termType] = kept
+		default:
 			fmt.Printf("  Filtering out term type: %s (%d SKUs)\n", termType, len(skuTerms))
 		}
 	}
@@ -222,6 +237,37 @@ func fetchServicePricingRaw(region, service string) ([]byte, error) {
 	return filteredBody, nil
 }
 
+// filterReservedTerms keeps only standard 1yr No Upfront Reserved terms, dropping
+// SKUs that have none. Terms are keyed by SKU, then by offer term code.
+func filterReservedTerms(skuTerms map[string]interface{}) map[string]interface{} {
+	kept := make(map[string]interface{})
+	for sku, raw := range skuTerms {
+		offers, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		keptOffers := make(map[string]interface{})
+		for code, rawTerm := range offers {
+			term, ok := rawTerm.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			attrs, _ := term["termAttributes"].(map[string]interface{})
+			if attrs["LeaseContractLength"] != "1yr" || attrs["PurchaseOption"] != "No Upfront" {
+				continue
+			}
+			if class, ok := attrs["OfferingClass"]; ok && class != "standard" {
+				continue
+			}
+			keptOffers[code] = term
+		}
+		if len(keptOffers) > 0 {
+			kept[sku] = keptOffers
+		}
+	}
+	return kept
+}
+
 // writeRawPricingFile writes raw pricing data to a file atomically.
 // The data is written verbatim without any processing or modification.
 // Uses write-to-temp-then-rename pattern to prevent partial writes on failure.